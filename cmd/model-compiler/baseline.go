@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// existsAtGitRef returns true if the directory at path is tracked at the git reference
+func existsAtGitRef(path string, ref string) bool {
+	cmd := exec.Command("git", "cat-file", "-e", fmt.Sprintf("%s:./", ref))
+	cmd.Dir = path
+	return cmd.Run() == nil
+}
+
+// extractGitBaseline writes the directory at path, as of the git reference, to a new temporary directory
+func extractGitBaseline(path string, ref string) (string, error) {
+	var archive, stderr bytes.Buffer
+	cmd := exec.Command("git", "archive", "--format=tar", ref)
+	cmd.Dir = path
+	cmd.Stdout = &archive
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("unable to archive %s at %s: %s", path, ref, strings.TrimSpace(stderr.String()))
+	}
+
+	dir, err := os.MkdirTemp("", "model-baseline")
+	if err != nil {
+		return "", err
+	}
+	if err := untar(&archive, dir); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+func untar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.Clean(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(file, tr); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
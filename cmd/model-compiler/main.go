@@ -5,18 +5,39 @@
 package main
 
 import (
+	"fmt"
 	"github.com/onosproject/config-models/pkg/compiler"
 	"github.com/spf13/cobra"
+	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"text/tabwriter"
+	"time"
 )
 
 const (
 	defaultModelPath = "/config-model"
+	yangBaseFlag     = "yang-base"
+	baselineFlag     = "baseline"
+	gitRefFlag       = "git-ref"
+	verifyFlag       = "verify"
+	strictFlag       = "strict"
+	outputFlag       = "output"
+	dryRunFlag       = "dry-run"
+	noFormatFlag     = "no-format"
+	parallelFlag     = "parallel"
+	forceFlag        = "force"
+	yangFlag         = "yang"
+	nameFlag         = "name"
+	versionFlag      = "version"
+	artifactNameFlag = "artifact-name"
+	goPackageFlag    = "go-package"
+	fullFlag         = "full"
 )
 
 func main() {
 	if err := getCmd().Execute(); err != nil {
-		println(err)
 		os.Exit(1)
 	}
 }
@@ -31,8 +52,285 @@ func getCmd() *cobra.Command {
 			if len(args) > 0 {
 				path = args[0]
 			}
-			return compiler.NewCompiler().Compile(path)
+			if verify, _ := cmd.Flags().GetBool(verifyFlag); verify {
+				return verifyModel(cmd, path)
+			}
+
+			c := newCompiler(cmd)
+			output, _ := cmd.Flags().GetString(outputFlag)
+			c.SetOutputDirectory(output)
+			dryRun, _ := cmd.Flags().GetBool(dryRunFlag)
+			c.SetDryRun(dryRun)
+			noFormat, _ := cmd.Flags().GetBool(noFormatFlag)
+			c.SetNoFormat(noFormat)
+			force, _ := cmd.Flags().GetBool(forceFlag)
+			c.SetForce(force)
+			if err := c.Compile(path); err != nil {
+				return err
+			}
+			if dryRun {
+				for _, artifact := range c.Artifacts() {
+					fmt.Fprintf(cmd.OutOrStdout(), "%-9s %s\n", artifact.Status, artifact.File)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(verifyFlag, false, "compile without writing and fail if the committed artifacts differ")
+	cmd.Flags().Bool(strictFlag, false, "with --verify, also fail if some artifacts cannot be verified, such as the YANG tree without pyang")
+	cmd.Flags().String(outputFlag, "", "directory to write the artifacts to instead of the model directory")
+	cmd.Flags().Bool(dryRunFlag, false, "list the artifacts that would be written without writing them")
+	cmd.Flags().Bool(noFormatFlag, false, "report YANG formatting differences instead of rewriting the YANG files")
+	cmd.Flags().Bool(forceFlag, false, "run every compilation stage, even those whose inputs did not change")
+	cmd.PersistentFlags().String(yangBaseFlag, compiler.DefaultYangBaseDirectory, "directory of the common YANG modules")
+	cmd.AddCommand(getBuildAllCmd())
+	cmd.AddCommand(getCompatCmd())
+	cmd.AddCommand(getVersionCheckCmd())
+	cmd.AddCommand(getVerifyManifestCmd())
+	cmd.AddCommand(getInitCmd())
+	cmd.AddCommand(getSampleCmd())
+	return cmd
+}
+
+func getBuildAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "build-all <dir>",
+		Short:        "Compiles in parallel every config model found under the specified directory",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := args[0]
+			output, _ := cmd.Flags().GetString(outputFlag)
+			parallel, _ := cmd.Flags().GetInt(parallelFlag)
+			force, _ := cmd.Flags().GetBool(forceFlag)
+			results, err := compiler.BuildAll(dir, parallel, func(path string) *compiler.ModelCompiler {
+				c := newCompiler(cmd)
+				c.SetForce(force)
+				if output != "" {
+					rel, _ := filepath.Rel(dir, path)
+					c.SetOutputDirectory(filepath.Join(output, rel))
+				}
+				return c
+			})
+			if results != nil {
+				printBuildResults(cmd.OutOrStdout(), results)
+			}
+			return err
+		},
+	}
+	cmd.Flags().String(outputFlag, "", "directory to write the artifacts to, in a sub-directory per model")
+	cmd.Flags().Int(parallelFlag, runtime.NumCPU(), "maximum number of models compiled at once")
+	cmd.Flags().Bool(forceFlag, false, "run every compilation stage, even those whose inputs did not change")
+	return cmd
+}
+
+func printBuildResults(out io.Writer, results []*compiler.BuildResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODEL\tRESULT\tDURATION")
+	for _, result := range results {
+		status := "ok"
+		if !result.Succeeded() {
+			status = "FAILED"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", result.Path, status, result.Duration.Round(time.Millisecond))
+	}
+	w.Flush()
+}
+
+func getCompatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "compat <old-model-path> <new-model-path>",
+		Short:        "Reports backwards incompatible changes between two versions of a config model",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldEntries, err := newCompiler(cmd).LoadModelEntries(args[0])
+			if err != nil {
+				return err
+			}
+			newEntries, err := newCompiler(cmd).LoadModelEntries(args[1])
+			if err != nil {
+				return err
+			}
+
+			breaking := 0
+			for _, change := range compiler.CompareEntries(oldEntries, newEntries) {
+				if change.IsBreaking() {
+					breaking++
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), change)
+			}
+			if breaking > 0 {
+				return fmt.Errorf("%d breaking change(s) found", breaking)
+			}
+			return nil
 		},
 	}
 	return cmd
 }
+
+func getVersionCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "version-check <model-path>",
+		Short:        "Verifies that the model versions are bumped as required by its YANG changes",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			baselinePath, _ := cmd.Flags().GetString(baselineFlag)
+			gitRef, _ := cmd.Flags().GetString(gitRefFlag)
+			if (baselinePath == "") == (gitRef == "") {
+				return fmt.Errorf("exactly one of --%s or --%s must be given", baselineFlag, gitRefFlag)
+			}
+			if gitRef != "" {
+				if !existsAtGitRef(path, gitRef) {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s does not exist at %s; nothing to check\n", path, gitRef)
+					return nil
+				}
+				dir, err := extractGitBaseline(path, gitRef)
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+				baselinePath = dir
+			}
+
+			check, err := newCompiler(cmd).CheckVersion(path, baselinePath)
+			if err != nil {
+				return err
+			}
+			for _, change := range check.Changes {
+				fmt.Fprintln(cmd.OutOrStdout(), change)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Required version bump: %s\n", check.Required)
+			for _, problem := range check.Problems {
+				fmt.Fprintln(cmd.OutOrStdout(), problem)
+			}
+			if len(check.Problems) > 0 {
+				return fmt.Errorf("versions of %s are not bumped as required", path)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(baselineFlag, "", "directory holding the previous version of the model")
+	cmd.Flags().String(gitRefFlag, "", "git reference holding the previous version of the model")
+	return cmd
+}
+
+func getVerifyManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "verify-manifest <model-path>",
+		Short:        "Verifies that the inputs and artifacts of a compiled model match its build manifest",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			c := newCompiler(cmd)
+			output, _ := cmd.Flags().GetString(outputFlag)
+			c.SetOutputDirectory(output)
+			manifest, mismatches, err := c.VerifyManifest(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s-%s compiled by model-compiler %s\n", manifest.Name, manifest.Version, manifest.CompilerVersion)
+			for _, mismatch := range mismatches {
+				fmt.Fprintln(cmd.OutOrStdout(), mismatch)
+			}
+			if len(mismatches) > 0 {
+				return fmt.Errorf("%d file(s) of %s do not match its build manifest", len(mismatches), path)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(outputFlag, "", "directory the artifacts were written to instead of the model directory")
+	return cmd
+}
+
+func getInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "init <model-path> --yang <files...>",
+		Short:        "Scaffolds a new config model from its YANG files",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options := compiler.ScaffoldOptions{}
+			options.YangFiles, _ = cmd.Flags().GetStringSlice(yangFlag)
+			options.Name, _ = cmd.Flags().GetString(nameFlag)
+			options.Version, _ = cmd.Flags().GetString(versionFlag)
+			options.ArtifactName, _ = cmd.Flags().GetString(artifactNameFlag)
+			options.GoPackage, _ = cmd.Flags().GetString(goPackageFlag)
+			c := newCompiler(cmd)
+			dryRun, _ := cmd.Flags().GetBool(dryRunFlag)
+			c.SetDryRun(dryRun)
+			metaData, err := c.Scaffold(args[0], options)
+			if err != nil {
+				return err
+			}
+			for _, artifact := range c.Artifacts() {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-9s %s\n", artifact.Status, artifact.File)
+			}
+			for _, module := range metaData.Modules {
+				fmt.Fprintf(cmd.OutOrStdout(), "Root module %s@%s\n", module.Name, module.Revision)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice(yangFlag, nil, "YANG files of the model, its root modules being the ones no other file imports")
+	cmd.Flags().String(nameFlag, "", "name of the model, by default the directory name without its version suffix")
+	cmd.Flags().String(versionFlag, "", "version of the model, by default the version suffix of the directory name or 1.0.x")
+	cmd.Flags().String(artifactNameFlag, "", "artifact name of the model, by default the directory name")
+	cmd.Flags().String(goPackageFlag, "", "Go package of the model, by default its import path in the enclosing Go module")
+	cmd.Flags().Bool(dryRunFlag, false, "list the files that would be written without writing them")
+	_ = cmd.MarkFlagRequired(yangFlag)
+	return cmd
+}
+
+func getSampleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "sample <model-path>",
+		Short:        "Prints a valid JSON config of the specified config model",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			full, _ := cmd.Flags().GetBool(fullFlag)
+			config, err := newCompiler(cmd).SampleConfig(args[0], full)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(config)
+			return err
+		},
+	}
+	cmd.Flags().Bool(fullFlag, false, "populate every optional config node rather than only the mandatory ones")
+	return cmd
+}
+
+func verifyModel(cmd *cobra.Command, path string) error {
+	cmd.SilenceUsage = true
+	c := newCompiler(cmd)
+	diffs, err := c.Verify(path)
+	if err != nil {
+		return err
+	}
+	for _, diff := range diffs {
+		fmt.Fprintln(cmd.OutOrStdout(), diff)
+	}
+	for _, skipped := range c.Skipped() {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s (not verified)\n", skipped)
+	}
+	if len(diffs) > 0 {
+		return fmt.Errorf("%d generated artifact(s) of %s differ from the committed ones", len(diffs), path)
+	}
+	if strict, _ := cmd.Flags().GetBool(strictFlag); strict && len(c.Skipped()) > 0 {
+		return fmt.Errorf("%d check(s) or artifact(s) of %s could not be verified", len(c.Skipped()), path)
+	}
+	return nil
+}
+
+func newCompiler(cmd *cobra.Command) *compiler.ModelCompiler {
+	c := compiler.NewCompiler()
+	if yangBase, err := cmd.Flags().GetString(yangBaseFlag); err == nil {
+		c.SetYangBaseDirectory(yangBase)
+	}
+	return c
+}
@@ -8,6 +8,7 @@
 package api
 
 import (
+	_ "embed" // embed
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
@@ -16,14 +17,55 @@ var modelData = []*gnmi.ModelData{
 	{Name: "openconfig-openflow", Organization: "OpenConfig working group", Version: "2017-06-01"},
 	{Name: "openconfig-platform", Organization: "OpenConfig working group", Version: "2016-12-22"},
 	{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "ietf-inet-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
+	{Name: "ietf-interfaces", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2014-05-08"},
+	{Name: "ietf-yang-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
+	{Name: "openconfig-aaa", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-aaa-types", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-extensions", Organization: "OpenConfig working group", Version: "2017-04-11"},
+	{Name: "openconfig-inet-types", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-openflow-types", Organization: "OpenConfig working group", Version: "2017-06-01"},
+	{Name: "openconfig-platform-types", Organization: "OpenConfig working group", Version: "2016-12-22"},
+	{Name: "openconfig-procmon", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-system-logging", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-system-terminal", Organization: "OpenConfig working group", Version: "2017-07-06"},
+	{Name: "openconfig-types", Organization: "OpenConfig working group", Version: "2017-08-16"},
+	{Name: "openconfig-yang-types", Organization: "OpenConfig working group", Version: "2017-07-30"},
 }
 
 var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
 
+// features are the enabled YANG features, as module:feature
+var features = []string{
+	"ietf-interfaces:arbitrary-names",
+	"ietf-interfaces:if-mib",
+	"ietf-interfaces:pre-provisioning",
+}
+
+// deviations are the names of the deviation modules applied to the model
+var deviations = []string{}
+
+// manifest records the compiler, the inputs and the artifacts the model plugin was generated from
+//
+//go:embed manifest.json
+var manifest []byte
+
 func ModelData() []*gnmi.ModelData {
 	return modelData
 }
 
+func Features() []string {
+	return features
+}
+
+func Deviations() []string {
+	return deviations
+}
+
 func Encodings() []gnmi.Encoding {
 	return encodings
 }
+
+func Manifest() []byte {
+	return manifest
+}
@@ -8,20 +8,45 @@
 package api
 
 import (
+	_ "embed" // embed
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
 var modelData = []*gnmi.ModelData{
 	{Name: "kpimon-xapp", Organization: "Open Networking Foundation", Version: "2020-12-04"},
 	{Name: "xapp", Organization: "Open Networking Foundation", Version: "2020-11-24"},
+	{Name: "ietf-inet-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
 }
 
 var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
 
+// features are the enabled YANG features, as module:feature
+var features = []string{}
+
+// deviations are the names of the deviation modules applied to the model
+var deviations = []string{}
+
+// manifest records the compiler, the inputs and the artifacts the model plugin was generated from
+//
+//go:embed manifest.json
+var manifest []byte
+
 func ModelData() []*gnmi.ModelData {
 	return modelData
 }
 
+func Features() []string {
+	return features
+}
+
+func Deviations() []string {
+	return deviations
+}
+
 func Encodings() []gnmi.Encoding {
 	return encodings
 }
+
+func Manifest() []byte {
+	return manifest
+}
@@ -9,22 +9,40 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
 	"github.com/onosproject/config-models/models/ric/api"
     "github.com/onosproject/config-models/pkg/path"
 	"github.com/onosproject/config-models/pkg/xpath/navigator"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/onosproject/onos-lib-go/pkg/certs"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
-	"github.com/onosproject/onos-lib-go/pkg/northbound"
 	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/pflag"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+	"net"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
 var log = logging.GetLogger("plugin")
 
 type modelPlugin struct {
+	health *health.Server
 }
 
 type server struct {
@@ -35,65 +53,286 @@ var roPaths []*admin.ReadOnlyPath
 var rwPaths []*admin.ReadWritePath
 var namespaceMappings []*admin.Namespace
 
+// The model info has no room for the enabled features and the deviations, which are advertised as response headers
+const (
+	featuresHeader   = "model-features"
+	deviationsHeader = "model-deviations"
+	// manifestHeader carries the build manifest, which spans several lines
+	manifestHeader = "model-manifest-bin"
+)
+
+const (
+	// modelPluginService is the name the health of the model plugin service is reported under, along with ""
+	modelPluginService = "onos.config.admin.ModelPluginService"
+	// drainTimeout bounds the time the in-flight requests are given to complete on shutdown
+	drainTimeout = 10 * time.Second
+)
+
 func (p *modelPlugin) Register(gs *grpc.Server) {
 	log.Info("Registering model plugin service")
 	server := &server{}
 	admin.RegisterModelPluginServiceServer(gs, server)
+	healthpb.RegisterHealthServer(gs, p.health)
 }
 
-func main() {
-	ready := make(chan bool)
+// Command line flags; the gRPC port may also be given as the sole argument, as in the CMD of the image
+const (
+	portFlag     = "port"
+	bindFlag     = "bind"
+	tlsCertFlag  = "tls-cert"
+	tlsKeyFlag   = "tls-key"
+	caFlag       = "ca"
+	mtlsFlag     = "mtls"
+	noTLSFlag    = "no-tls"
+	logLevelFlag = "log-level"
+	configFlag   = "config"
+)
 
-	if len(os.Args) < 2 {
-		log.Fatal("gRPC port argument is required")
-		os.Exit(1)
-	}
+const defaultPort = 5152
+
+// options are the settings of the model plugin, from its command line and its config file
+type options struct {
+	port     int
+	bind     string
+	tlsCert  string
+	tlsKey   string
+	ca       string
+	mtls     bool
+	noTLS    bool
+	logLevel string
+}
 
-	i, err := strconv.ParseInt(os.Args[1], 10, 16)
+func main() {
+	opts, err := parseOptions(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatal("Invalid arguments: ", err)
+	}
+	level, err := parseLevel(opts.logLevel)
 	if err != nil {
-		log.Fatal("specified gRPC port is invalid", err)
-		os.Exit(1)
+		log.Fatal("Invalid arguments: ", err)
 	}
-	port := int16(i)
+	logging.SetLevel(level)
 
 	entries, err := api.UnzipSchema()
 	if err != nil {
 		log.Fatalf("Unable to extract model schema: %+v", err)
 	}
 	roPaths, rwPaths, namespaceMappings = path.ExtractPaths(entries)
+	logManifest()
 
-	// Start gRPC server
+	// Start gRPC server, reporting the plugin as serving once the paths are extracted from the schema
 	log.Info("Starting model plugin")
-	p := modelPlugin{}
-	if err := p.startNorthboundServer(port); err != nil {
-		log.Fatal("Unable to start model plugin service", err)
+	p := modelPlugin{health: health.NewServer()}
+	p.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	p.health.SetServingStatus(modelPluginService, healthpb.HealthCheckResponse_NOT_SERVING)
+	s, stopped, err := p.startServer(opts)
+	if err != nil {
+		log.Fatal("Unable to start model plugin service ", err)
 	}
+	p.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	p.health.SetServingStatus(modelPluginService, healthpb.HealthCheckResponse_SERVING)
 
-	// Serve
-	<-ready
+	// Serve until a termination signal, then drain
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case sig := <-signals:
+		log.Infof("Received %s, draining the model plugin service", sig)
+		p.health.Shutdown()
+		p.drain(s, stopped)
+	case err := <-stopped:
+		log.Fatal("Model plugin service stopped unexpectedly ", err)
+	}
 }
 
-func (p *modelPlugin) startNorthboundServer(port int16) error {
-	cfg := northbound.NewServerConfig("", "", "", port, true)
-	s := northbound.NewServer(cfg)
+// logManifest logs the compiler and the metadata the plugin was generated with, and the whole build manifest at
+// debug level, since it is also advertised as a response header
+func logManifest() {
+	var manifest struct {
+		CompilerVersion string `json:"compilerVersion"`
+		Inputs          []struct {
+			File   string `json:"file"`
+			SHA256 string `json:"sha256"`
+		} `json:"inputs"`
+	}
+	if err := json.Unmarshal(api.Manifest(), &manifest); err != nil {
+		log.Warnf("Unable to read the build manifest: %+v", err)
+		return
+	}
+	metaDataHash := ""
+	for _, input := range manifest.Inputs {
+		if input.File == "metadata.yaml" {
+			metaDataHash = input.SHA256
+		}
+	}
+	log.Infof("Model plugin ric-1.0.0 built by model-compiler %s from metadata.yaml sha256 %s",
+		manifest.CompilerVersion, metaDataHash)
+	log.Debugf("Build manifest:\n%s", api.Manifest())
+}
 
-	s.AddService(p)
+// parseOptions parses the command line, then the config file it names, if any, for the options it leaves unset
+func parseOptions(name string, args []string) (*options, error) {
+	opts := &options{}
+	flags := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] [port]\n%s", name, flags.FlagUsages())
+	}
+	flags.IntVar(&opts.port, portFlag, defaultPort, "gRPC port of the model plugin service")
+	flags.StringVar(&opts.bind, bindFlag, "", "address to listen on; all the interfaces by default")
+	flags.StringVar(&opts.tlsCert, tlsCertFlag, "", "PEM certificate of the service; a built-in localhost certificate by default")
+	flags.StringVar(&opts.tlsKey, tlsKeyFlag, "", "PEM private key of the --tls-cert certificate")
+	flags.StringVar(&opts.ca, caFlag, "", "PEM certificate authority verifying the client certificates with --mtls; the ONF CA by default")
+	flags.BoolVar(&opts.mtls, mtlsFlag, false, "require and verify client certificates (mutual TLS)")
+	flags.BoolVar(&opts.noTLS, noTLSFlag, false, "serve in plaintext")
+	flags.StringVar(&opts.logLevel, logLevelFlag, strings.ToLower(logging.InfoLevel.String()), "log level: debug, info, warn or error")
+	config := flags.String(configFlag, "", "YAML or JSON file of options, keyed by flag name; the flags take precedence")
+	if err := flags.Parse(args); err == pflag.ErrHelp {
+		os.Exit(0)
+	} else if err != nil {
+		return nil, err
+	}
 
-	doneCh := make(chan error)
-	go func() {
-		err := s.Serve(func(started string) {
-			log.Info("Started NBI on ", started)
-			close(doneCh)
-		})
-		if err != nil {
-			doneCh <- err
+	switch positional := flags.Args(); {
+	case len(positional) > 1:
+		return nil, fmt.Errorf("unexpected arguments %v", positional[1:])
+	case len(positional) == 1 && flags.Changed(portFlag):
+		return nil, fmt.Errorf("port given both as an argument and by --%s", portFlag)
+	case len(positional) == 1:
+		if err := flags.Set(portFlag, positional[0]); err != nil {
+			return nil, fmt.Errorf("invalid port %s: %v", positional[0], err)
+		}
+	}
+	if *config != "" {
+		if err := loadConfigFile(flags, *config); err != nil {
+			return nil, err
 		}
+	}
+
+	if opts.port < 1 || opts.port > 65535 {
+		return nil, fmt.Errorf("port %d is out of range 1..65535", opts.port)
+	}
+	if (opts.tlsCert == "") != (opts.tlsKey == "") {
+		return nil, fmt.Errorf("--%s and --%s go together", tlsCertFlag, tlsKeyFlag)
+	}
+	if opts.noTLS && (opts.tlsCert != "" || opts.ca != "" || opts.mtls) {
+		return nil, fmt.Errorf("--%s excludes the other TLS flags", noTLSFlag)
+	}
+	return opts, nil
+}
+
+// loadConfigFile sets the flags the command line leaves unset from a YAML or JSON file keyed by flag name
+func loadConfigFile(flags *pflag.FlagSet, file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]interface{})
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return fmt.Errorf("unable to parse %s: %v", file, err)
+	}
+	for name, value := range values {
+		flag := flags.Lookup(name)
+		if flag == nil || name == configFlag {
+			return fmt.Errorf("unknown option %s in %s", name, file)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := flags.Set(name, fmt.Sprint(value)); err != nil {
+			return fmt.Errorf("invalid %s in %s: %v", name, file, err)
+		}
+	}
+	return nil
+}
+
+func parseLevel(name string) (logging.Level, error) {
+	for _, level := range []logging.Level{logging.DebugLevel, logging.InfoLevel, logging.WarnLevel, logging.ErrorLevel} {
+		if strings.EqualFold(name, level.String()) {
+			return level, nil
+		}
+	}
+	return logging.ErrorLevel, fmt.Errorf("unknown log level %s", name)
+}
+
+// serverCredentials returns the TLS credentials of the gRPC server, or nil to serve in plaintext. The built-in
+// localhost certificate and the ONF CA are used by default, and client certificates are requested but not verified
+// unless mutual TLS is required, as the plugin always did.
+func serverCredentials(opts *options) (credentials.TransportCredentials, error) {
+	if opts.noTLS {
+		return nil, nil
+	}
+	var cert tls.Certificate
+	var err error
+	if opts.tlsCert == "" {
+		cert, err = tls.X509KeyPair([]byte(certs.DefaultLocalhostCrt), []byte(certs.DefaultLocalhostKey))
+	} else {
+		log.Infof("Loading certificate %s and key %s", opts.tlsCert, opts.tlsKey)
+		cert, err = tls.LoadX509KeyPair(opts.tlsCert, opts.tlsKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{cert}, ClientAuth: tls.RequestClientCert}
+	if opts.mtls {
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	if opts.ca == "" {
+		tlsCfg.ClientCAs, err = certs.GetCertPoolDefault()
+	} else {
+		tlsCfg.ClientCAs, err = certs.GetCertPool(opts.ca)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// startServer starts the gRPC server and returns it once it listens, along with a channel receiving the outcome of
+// its Serve when it stops
+func (p *modelPlugin) startServer(opts *options) (*grpc.Server, <-chan error, error) {
+	creds, err := serverCredentials(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	serverOpts := make([]grpc.ServerOption, 0, 1)
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	lis, err := net.Listen("tcp", net.JoinHostPort(opts.bind, strconv.Itoa(opts.port)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := grpc.NewServer(serverOpts...)
+	p.Register(s)
+
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- s.Serve(lis)
 	}()
-	return <-doneCh
+	log.Infof("Started NBI on %s (TLS: %t, mutual TLS: %t)", lis.Addr(), creds != nil, opts.mtls)
+	return s, stopped, nil
+}
+
+// drain stops the gRPC server once the in-flight requests complete, or once drainTimeout expires
+func (p *modelPlugin) drain(s *grpc.Server, stopped <-chan error) {
+	go s.GracefulStop()
+	select {
+	case <-stopped:
+		log.Info("Model plugin service stopped")
+	case <-time.After(drainTimeout):
+		log.Warnf("Stopping the model plugin service, whose requests did not complete within %s", drainTimeout)
+		s.Stop()
+	}
 }
 
 func (s server) GetModelInfo(ctx context.Context, request *admin.ModelInfoRequest) (*admin.ModelInfoResponse, error) {
 	log.Infof("Received model info request: %+v", request)
+	header := metadata.MD{featuresHeader: api.Features(), deviationsHeader: api.Deviations()}
+	header.Set(manifestHeader, string(api.Manifest()))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Debugf("Unable to advertise features, deviations and manifest: %+v", err)
+	}
 	return &admin.ModelInfoResponse{
 		ModelInfo: &admin.ModelInfo{
 			Name:               "ric",
@@ -111,19 +350,40 @@ func (s server) GetModelInfo(ctx context.Context, request *admin.ModelInfoReques
 
 func (s server) ValidateConfig(ctx context.Context, request *admin.ValidateConfigRequest) (*admin.ValidateConfigResponse, error) {
 	log.Infof("Received validate config request: %s", request.String())
-	gostruct, err := s.unmarshallConfigValues(request.Json)
+	config := request.Json
+	violations, err := api.ValidateConfig(config)
 	if err != nil {
-		return nil, errors.Status(err).Err()
+		return nil, errors.Status(errors.NewInvalid("Unable to validate config: %+v", err)).Err()
 	}
-
-	if err := s.validate(gostruct); err != nil {
-		return nil, errors.Status(err).Err()
+	if len(violations) > 0 {
+		return nil, violationsStatus(violations).Err()
 	}
+	return &admin.ValidateConfigResponse{Valid: true}, nil
+}
 
-	if err := s.validateMust(*gostruct); err != nil {
-		return nil, errors.Status(err).Err()
+// violationsStatus returns an InvalidArgument status listing the violations of a config in its message, and
+// carrying each one as an ErrorInfo detail, whose reason is the kind of the violation in upper case
+func violationsStatus(violations api.Violations) *status.Status {
+	st := status.New(codes.InvalidArgument, violations.Error())
+	for _, violation := range violations {
+		detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: strings.ToUpper(string(violation.Kind)),
+			Domain: modelPluginService,
+			Metadata: map[string]string{
+				"path":          violation.Path,
+				"message":       violation.Message,
+				"error-message": violation.ErrorMessage,
+				"error-app-tag": violation.ErrorAppTag,
+				"value":         violation.Value,
+			},
+		})
+		if err != nil {
+			log.Warnf("Unable to detail violation %s: %+v", violation, err)
+			continue
+		}
+		st = detailed
 	}
-	return &admin.ValidateConfigResponse{Valid: true}, nil
+	return st
 }
 
 func (s server) GetPathValues(ctx context.Context, request *admin.PathValuesRequest) (*admin.PathValuesResponse, error) {
@@ -173,28 +433,3 @@ func (s server) unmarshallConfigValues(jsonTree []byte) (*ygot.ValidatedGoStruct
 	}
 	return &vgs, nil
 }
-
-func (s server) validate(ygotModel *ygot.ValidatedGoStruct, opts ...ygot.ValidationOption) error {
-	deviceDeref := *ygotModel
-	device, ok := deviceDeref.(*api.Device)
-	if !ok {
-		return errors.NewInvalid("Unable to convert model ric-1.0.0")
-	}
-	return device.Validate()
-}
-
-func (s server) validateMust(device ygot.ValidatedGoStruct) error {
-	log.Infof("Received validateMust request for device: %v", device)
-	schema, err := api.Schema()
-	if err != nil {
-		return errors.NewInvalid("Unable to get schema: %+v", err)
-	}
-
-	nn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
-	ynn, ok := nn.(*navigator.YangNodeNavigator)
-	if !ok {
-		return errors.NewInvalid("Cannot cast NodeNavigator to YangNodeNavigator")
-	}
-	return ynn.WalkAndValidateMust()
-}
-
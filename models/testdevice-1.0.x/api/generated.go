@@ -5,17 +5,17 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.26.0/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/onf-test1@2018-02-20.yang
-	- /config-model/yang/onf-test1-extra@2021-04-01.yang
-	- /config-model/yang/switch/onf-switch-model@2023-03-07.yang
-	- /config-model/yang/switch/onf-switch@2023-03-07.yang
-	- /config-model/yang/onf-test1-choice@2023-03-07.yang
+	- yang/onf-test1@2018-02-20.yang
+	- yang/onf-test1-extra@2021-04-01.yang
+	- yang/switch/onf-switch-model@2023-03-07.yang
+	- yang/switch/onf-switch@2023-03-07.yang
+	- yang/onf-test1-choice@2023-03-07.yang
 Imported modules were sourced from:
-	- /var/model-compiler/yang-base/...
-	- /config-model/yang/...
-	- /config-model/yang/switch/...
+	- yang-base
+	- yang
+	- yang/switch
 */
 package api
 
@@ -1676,600 +1676,610 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xff, 0x73, 0xdb, 0x36,
-		0xb6, 0xef, 0xef, 0xf9, 0x2b, 0xce, 0x68, 0xde, 0x9b, 0xd8, 0x59, 0x53, 0x96, 0x54, 0x3b, 0x9b,
-		0x7a, 0xa6, 0xd3, 0xba, 0x4d, 0xda, 0xcd, 0x6c, 0xb3, 0xed, 0xa4, 0xc9, 0xbe, 0x7b, 0x1b, 0xb9,
-		0xb9, 0x10, 0x09, 0x49, 0xb8, 0xa1, 0x00, 0x95, 0x00, 0x6d, 0x6b, 0x9b, 0xfc, 0xef, 0x6f, 0x00,
-		0x90, 0x14, 0xa9, 0x6f, 0x04, 0x48, 0x4a, 0xa6, 0x6d, 0xec, 0xec, 0x54, 0x8e, 0x44, 0x80, 0x24,
-		0x70, 0x70, 0xbe, 0x7e, 0xce, 0x39, 0x7f, 0x3d, 0x01, 0x00, 0xe8, 0xfc, 0x0b, 0xcd, 0x70, 0xe7,
-		0x02, 0x3a, 0x01, 0xbe, 0x26, 0x3e, 0xee, 0x9c, 0xe8, 0x6f, 0xff, 0x49, 0x68, 0xd0, 0xb9, 0x80,
-		0x7e, 0xf2, 0xcf, 0x1f, 0x18, 0x1d, 0x93, 0x49, 0xe7, 0x02, 0x7a, 0xc9, 0x17, 0x2f, 0x49, 0xd4,
-		0xb9, 0x00, 0x3d, 0x85, 0xfa, 0xc2, 0x67, 0x54, 0xf4, 0x51, 0xe1, 0xbb, 0xc2, 0xf4, 0xc9, 0xef,
-		0x27, 0xc5, 0x5f, 0x5f, 0x62, 0xee, 0x47, 0x64, 0x2e, 0x08, 0xa3, 0xf2, 0xa2, 0x77, 0x53, 0x0c,
-		0x82, 0xcd, 0x21, 0xc4, 0xd7, 0x38, 0x04, 0x39, 0x04, 0x11, 0x8a, 0xa3, 0xd5, 0x51, 0xc5, 0x87,
-		0xcb, 0xbe, 0x5e, 0x7d, 0xc8, 0xec, 0x87, 0x5f, 0x23, 0x3c, 0x26, 0xb7, 0x6b, 0xcf, 0x56, 0x78,
-		0x3e, 0xd1, 0x5f, 0xb9, 0x8b, 0xfa, 0xf5, 0x37, 0x16, 0x47, 0x3e, 0xde, 0x38, 0x52, 0x3f, 0x09,
-		0x5e, 0xdc, 0xb0, 0x48, 0x3e, 0x4c, 0x67, 0xae, 0x6f, 0x72, 0xb2, 0xf9, 0xc2, 0x7f, 0x20, 0x7e,
-		0x19, 0x4d, 0xe2, 0x19, 0xa6, 0xa2, 0x73, 0x01, 0x22, 0x8a, 0xf1, 0x96, 0x0b, 0x73, 0x57, 0xc9,
-		0x67, 0x5a, 0xbb, 0xe8, 0x4b, 0xe1, 0x9b, 0x2f, 0xab, 0xeb, 0xb9, 0xb2, 0x2d, 0x85, 0xed, 0x19,
-		0xa0, 0xed, 0x2f, 0x92, 0xdf, 0xa6, 0x01, 0xda, 0xf6, 0x16, 0x1b, 0xb6, 0x6b, 0x40, 0x83, 0x92,
-		0xed, 0x2a, 0xd9, 0xb6, 0xd2, 0xed, 0x33, 0xd9, 0x46, 0xb3, 0xed, 0x34, 0xdd, 0x56, 0xeb, 0xed,
-		0xb5, 0xde, 0x66, 0xe3, 0xed, 0xde, 0xbc, 0xed, 0x5b, 0xb6, 0xbf, 0x94, 0x0c, 0xb2, 0x0b, 0x42,
-		0x8c, 0xc6, 0x3b, 0xc8, 0x61, 0x6d, 0x39, 0x93, 0xeb, 0x4b, 0x5e, 0x66, 0x85, 0x3c, 0xfe, 0x15,
-		0xcf, 0x70, 0x44, 0x7c, 0x90, 0x83, 0x81, 0x50, 0x4e, 0x02, 0x0c, 0x3f, 0xa4, 0x44, 0x02, 0x26,
-		0xd3, 0x8d, 0x51, 0x1c, 0xca, 0xa5, 0xf9, 0xb0, 0xf3, 0x42, 0x75, 0xf1, 0xa0, 0xb3, 0xf3, 0x9a,
-		0xab, 0x92, 0x7b, 0x25, 0xb4, 0xd9, 0x2b, 0xb9, 0xac, 0x8c, 0x46, 0x6d, 0x68, 0xd5, 0x8e, 0x66,
-		0x6d, 0x69, 0xb7, 0x32, 0x0d, 0x57, 0xa6, 0x65, 0x6b, 0x9a, 0xde, 0x4d, 0xdb, 0x25, 0x34, 0x9e,
-		0xdd, 0xed, 0xdd, 0x62, 0x8e, 0xed, 0xd6, 0x39, 0x26, 0x54, 0xbc, 0x30, 0x59, 0xea, 0x84, 0x28,
-		0xce, 0x0d, 0x2e, 0x7d, 0x8b, 0xe8, 0x04, 0x1b, 0x51, 0xaa, 0xfc, 0x9f, 0xd9, 0xd6, 0xa9, 0x89,
-		0xdf, 0x10, 0x6a, 0xbc, 0xd7, 0xd9, 0xa0, 0x7f, 0xa3, 0x30, 0xc6, 0xdb, 0x59, 0xed, 0xd6, 0x71,
-		0x3f, 0x46, 0xc8, 0x97, 0xa7, 0xf7, 0x25, 0x99, 0x10, 0xc1, 0xcb, 0xc9, 0x7c, 0x7d, 0x89, 0xf1,
-		0x04, 0x09, 0x72, 0x2d, 0xef, 0x3d, 0x46, 0x21, 0xc7, 0xc6, 0xa3, 0xbf, 0x9c, 0x58, 0x2c, 0x09,
-		0xba, 0xad, 0xbe, 0x24, 0x5f, 0xdd, 0x9f, 0x25, 0x79, 0xd2, 0xe0, 0xc2, 0x1d, 0x8c, 0xe2, 0x1c,
-		0xc9, 0xad, 0xaf, 0xc9, 0x83, 0xa3, 0xb9, 0xd2, 0xab, 0xae, 0x6a, 0xb1, 0x74, 0x7c, 0x2b, 0x22,
-		0xe4, 0xc5, 0x94, 0x0b, 0x34, 0x0a, 0x0d, 0x99, 0x7b, 0x84, 0xc7, 0x38, 0xc2, 0xd4, 0xdf, 0x0b,
-		0x13, 0x4e, 0x25, 0xc7, 0xdb, 0x1f, 0x7f, 0x80, 0xe7, 0xbd, 0xb3, 0x5e, 0xc7, 0x82, 0x74, 0x2c,
-		0xe5, 0xf5, 0x26, 0xb9, 0xbd, 0x7c, 0x37, 0x4b, 0x3a, 0xa8, 0x2a, 0xc2, 0x37, 0x8a, 0xf2, 0xec,
-		0xe5, 0xdb, 0x46, 0x4d, 0x4f, 0x2a, 0xd0, 0x99, 0xd6, 0x68, 0x47, 0x96, 0x1a, 0xf0, 0xc8, 0x52,
-		0x03, 0xfe, 0x37, 0x0b, 0x05, 0x9a, 0xe0, 0xca, 0x1a, 0xb0, 0xd3, 0x4a, 0xef, 0xab, 0x56, 0xfa,
-		0x06, 0xd1, 0x00, 0x09, 0x16, 0x2d, 0xca, 0xb5, 0x30, 0xa7, 0xc1, 0xee, 0x90, 0x9d, 0x3d, 0xa7,
-		0x4e, 0xac, 0x2e, 0xc9, 0xc0, 0x69, 0x13, 0x4d, 0xf1, 0x7f, 0xdf, 0x92, 0xff, 0xfb, 0x96, 0xfc,
-		0xff, 0x2d, 0x46, 0x01, 0x30, 0x1a, 0x2e, 0x0e, 0x26, 0x01, 0x06, 0x4e, 0x02, 0xdc, 0x5b, 0xbf,
-		0x04, 0x17, 0x11, 0xa1, 0x13, 0x0b, 0xb6, 0xde, 0x7f, 0xb1, 0xaf, 0x83, 0x11, 0x58, 0x1e, 0x8c,
-		0xc0, 0xf2, 0x60, 0x5c, 0x52, 0x26, 0xa6, 0x38, 0x02, 0x42, 0x05, 0x9e, 0xa8, 0x4f, 0xa7, 0x1b,
-		0xb9, 0x93, 0xe1, 0xf4, 0x1d, 0xa7, 0xef, 0x38, 0x7d, 0x67, 0x8f, 0xfa, 0x0e, 0xb6, 0x64, 0xeb,
-		0xd8, 0x92, 0xad, 0x2b, 0x2d, 0x27, 0x24, 0x5c, 0x38, 0x86, 0xee, 0x18, 0xfa, 0xae, 0x75, 0x26,
-		0x54, 0xf4, 0x9f, 0x5b, 0x30, 0xf4, 0xc1, 0xfd, 0x0d, 0xc1, 0xf4, 0xee, 0x92, 0xa5, 0x4b, 0x92,
-		0x69, 0x21, 0x47, 0xef, 0xf5, 0x1c, 0x4f, 0xb7, 0x3a, 0x61, 0x3f, 0x13, 0x2e, 0x2e, 0x85, 0x88,
-		0xcc, 0x4e, 0xd9, 0x1b, 0x42, 0x5f, 0x85, 0x58, 0x9e, 0x7f, 0xc3, 0xa5, 0x92, 0xdb, 0x99, 0x1b,
-		0xd1, 0x7f, 0x71, 0x76, 0xf6, 0xfc, 0xef, 0x67, 0x67, 0xbd, 0xbf, 0x7f, 0xf5, 0xf7, 0xde, 0xd7,
-		0xe7, 0xe7, 0xfd, 0xe7, 0x7d, 0x13, 0x8d, 0xea, 0x97, 0x28, 0xc0, 0x11, 0x0e, 0xbe, 0x5f, 0x74,
-		0x2e, 0x80, 0xc6, 0x61, 0xb8, 0x2f, 0x29, 0x36, 0xb6, 0x94, 0x62, 0x63, 0x4b, 0x29, 0x36, 0x22,
-		0x14, 0x45, 0x45, 0x93, 0xdd, 0x77, 0x72, 0xcc, 0xc9, 0xb1, 0x0d, 0xeb, 0xac, 0x49, 0xc5, 0x42,
-		0x90, 0x7d, 0x6d, 0x70, 0xe9, 0xcf, 0x98, 0x4e, 0xc4, 0xb4, 0x75, 0x92, 0x6c, 0xe0, 0x6c, 0x93,
-		0x7b, 0xbd, 0x26, 0x6d, 0x37, 0x4e, 0x26, 0x96, 0x6c, 0x7d, 0x62, 0xc9, 0xd6, 0xbf, 0x67, 0x2c,
-		0xc4, 0x88, 0xba, 0x60, 0x9c, 0xe3, 0xeb, 0xe5, 0x7c, 0x5d, 0xd3, 0x8a, 0x8d, 0x2f, 0xb6, 0xbf,
-		0xaf, 0x73, 0x31, 0xb5, 0x3c, 0x17, 0x53, 0xcb, 0x73, 0xf1, 0xfe, 0xfd, 0xeb, 0x97, 0xee, 0x50,
-		0xb8, 0x43, 0x51, 0xee, 0x85, 0x8d, 0x49, 0x60, 0x15, 0x9d, 0x30, 0xb8, 0xf6, 0x57, 0x24, 0x04,
-		0x8e, 0xa8, 0xb1, 0xb2, 0xd3, 0xf9, 0xd0, 0xf3, 0xbe, 0x46, 0xde, 0xf8, 0xd2, 0xfb, 0xf1, 0xea,
-		0xaf, 0x17, 0x5f, 0xbc, 0xfc, 0x3f, 0xcf, 0x6c, 0xfe, 0xd9, 0x1f, 0x7c, 0xe9, 0xdc, 0x9d, 0xac,
-		0x23, 0x96, 0x67, 0x9a, 0x58, 0x9e, 0xe9, 0x97, 0x48, 0x60, 0x40, 0x34, 0x80, 0x77, 0x64, 0xe6,
-		0xe0, 0x27, 0xee, 0x70, 0x97, 0xaf, 0x73, 0x80, 0x04, 0xf6, 0x10, 0x0d, 0x3c, 0x41, 0x66, 0xf8,
-		0xce, 0x4f, 0xf9, 0x70, 0x18, 0xc8, 0x03, 0x2b, 0x3f, 0x06, 0xe9, 0xc7, 0x3b, 0xfd, 0x71, 0x51,
-		0xf8, 0x38, 0x1a, 0x0e, 0xbb, 0xc3, 0x61, 0xf0, 0xb7, 0xe3, 0x6f, 0x8f, 0x7e, 0xff, 0xfc, 0x61,
-		0x38, 0xfc, 0xdb, 0x70, 0xe8, 0x5d, 0x15, 0xae, 0x38, 0xbe, 0xc3, 0x63, 0xfe, 0xbf, 0x96, 0xc7,
-		0xfc, 0x7f, 0x2d, 0x8f, 0xf9, 0x02, 0xd1, 0x89, 0x47, 0x02, 0x4c, 0x05, 0x19, 0x13, 0x1c, 0xb9,
-		0x83, 0xee, 0x0e, 0x7a, 0xe9, 0x3a, 0xaf, 0xd0, 0x4c, 0xd3, 0x47, 0xbd, 0xa5, 0xce, 0x0b, 0x07,
-		0x4b, 0x5f, 0x5f, 0x92, 0x4a, 0x5e, 0xde, 0x87, 0xe6, 0xcd, 0xd8, 0x8b, 0x8e, 0x8a, 0xbc, 0xff,
-		0x5c, 0x7a, 0xbf, 0x7f, 0xbc, 0x4a, 0xfe, 0xe8, 0x79, 0x5f, 0x0f, 0x87, 0xde, 0xc7, 0xee, 0xd5,
-		0x33, 0x53, 0xee, 0xd3, 0xfd, 0xdc, 0xed, 0x7e, 0xfe, 0xf0, 0xc7, 0xed, 0x7f, 0x5d, 0x75, 0x9f,
-		0x7d, 0xee, 0x7e, 0xf8, 0x63, 0xf6, 0x46, 0xfd, 0xd1, 0xfd, 0xf0, 0x47, 0xf8, 0xf3, 0x55, 0xf7,
-		0xd9, 0x1e, 0x45, 0x9a, 0x55, 0x9e, 0xe1, 0x25, 0xa5, 0x4c, 0xa0, 0x44, 0x1a, 0xed, 0x48, 0x37,
-		0xe4, 0xfe, 0x14, 0xcf, 0xd0, 0x1c, 0x29, 0xc6, 0xd0, 0x39, 0x65, 0x74, 0xec, 0x09, 0xcc, 0x45,
-		0xff, 0x54, 0x67, 0x05, 0x9f, 0xee, 0xcc, 0x3a, 0xd5, 0x33, 0x88, 0x28, 0xf6, 0x05, 0x4d, 0x78,
-		0xd8, 0x2f, 0x74, 0xfc, 0x4e, 0x8e, 0xff, 0x28, 0x85, 0x5c, 0xff, 0x52, 0x7d, 0x0c, 0x2e, 0x37,
-		0xaf, 0xca, 0xfa, 0x1b, 0x6d, 0x78, 0x1b, 0x25, 0x7e, 0xfb, 0x06, 0xf9, 0xb1, 0xc9, 0x75, 0x66,
-		0xf9, 0xb1, 0x3f, 0x6f, 0x14, 0xc7, 0xdb, 0x87, 0xef, 0x16, 0xc3, 0x2e, 0x41, 0xb6, 0xb9, 0x04,
-		0xd9, 0x52, 0xb1, 0x69, 0x0e, 0xca, 0x33, 0x91, 0x8e, 0xa6, 0x52, 0xd1, 0x2c, 0xb6, 0x67, 0xae,
-		0xfd, 0xa4, 0xac, 0xde, 0x90, 0xaf, 0x57, 0xe6, 0xe7, 0xf6, 0x7c, 0xfc, 0x8b, 0x59, 0x50, 0xd2,
-		0xfe, 0x55, 0xfb, 0xbd, 0xf6, 0xbd, 0x6b, 0x45, 0x5e, 0x7c, 0x55, 0x87, 0x9f, 0x11, 0x6e, 0x94,
-		0xef, 0x9f, 0x5c, 0x67, 0xc6, 0xcf, 0x2e, 0x81, 0x93, 0xd9, 0x3c, 0xc4, 0x1a, 0xe2, 0xc3, 0xc6,
-		0xe0, 0x2b, 0x8e, 0x14, 0x47, 0x4a, 0x04, 0x00, 0x11, 0x78, 0xc6, 0x5d, 0xf2, 0x7f, 0xeb, 0x93,
-		0xff, 0x13, 0x29, 0x6a, 0x68, 0x98, 0xaa, 0xab, 0xed, 0xcc, 0xd2, 0x77, 0xd3, 0x84, 0x44, 0x08,
-		0x87, 0x4f, 0x78, 0x81, 0x03, 0x18, 0x2d, 0xc0, 0x64, 0x1e, 0x67, 0x87, 0x3e, 0x1e, 0x3b, 0xb4,
-		0x02, 0xda, 0xfd, 0xfe, 0x9a, 0x9f, 0x67, 0xce, 0xfc, 0x5c, 0x5d, 0x92, 0x17, 0x2e, 0x72, 0xde,
-		0x80, 0x9b, 0x31, 0x42, 0x74, 0x82, 0xbd, 0x99, 0xc1, 0x46, 0x64, 0x07, 0x6f, 0x39, 0xc4, 0x32,
-		0x67, 0x03, 0x66, 0xe8, 0x16, 0xae, 0xe5, 0xf6, 0xc1, 0x98, 0x45, 0x20, 0xa6, 0x18, 0xd4, 0x5c,
-		0x8e, 0xab, 0x3b, 0xae, 0xee, 0x32, 0x35, 0x1c, 0x4b, 0x87, 0xc1, 0xf9, 0xb9, 0x63, 0xea, 0xcd,
-		0x31, 0x75, 0x03, 0xea, 0x5c, 0x65, 0xea, 0x84, 0x5a, 0x33, 0xf5, 0xc4, 0xa4, 0x53, 0x13, 0x80,
-		0x60, 0x20, 0x30, 0x17, 0x10, 0xc5, 0x21, 0xe6, 0x40, 0x28, 0xfc, 0xf7, 0xe5, 0xbf, 0x7e, 0xea,
-		0xc2, 0x1b, 0x42, 0x61, 0x16, 0x73, 0x01, 0x23, 0x0c, 0xc3, 0xb8, 0xd7, 0xfb, 0xca, 0xff, 0x06,
-		0x0c, 0x04, 0x88, 0x63, 0xfc, 0xf7, 0x95, 0xf1, 0xbb, 0xf2, 0x05, 0x4e, 0x48, 0x38, 0x21, 0xd1,
-		0x6e, 0x21, 0x81, 0xad, 0xd2, 0xb4, 0xf5, 0xe5, 0xb6, 0xc2, 0x21, 0xab, 0x3c, 0x24, 0x25, 0x83,
-		0xce, 0xf4, 0x96, 0x52, 0x41, 0xaa, 0xfe, 0x03, 0x54, 0x5a, 0xf1, 0xd3, 0x09, 0x82, 0x47, 0x68,
-		0x01, 0x48, 0x2a, 0x89, 0xf0, 0xd8, 0xc6, 0xb1, 0xf3, 0x77, 0xb3, 0x20, 0xac, 0x0e, 0x1e, 0x16,
-		0x42, 0x86, 0xa7, 0x49, 0xf5, 0x81, 0x3d, 0x9c, 0x2f, 0x71, 0xeb, 0xcd, 0xd9, 0x0d, 0x8e, 0xcc,
-		0x8f, 0x58, 0x36, 0xc2, 0xd2, 0x5b, 0x1a, 0x21, 0xca, 0x67, 0x44, 0x80, 0xd1, 0x60, 0x77, 0x94,
-		0x1e, 0x97, 0x31, 0x6d, 0x95, 0x26, 0xfb, 0xdc, 0x55, 0x2a, 0x7d, 0x40, 0x8a, 0x92, 0xcb, 0x2d,
-		0x32, 0xe6, 0xe3, 0x56, 0x01, 0xb2, 0x7f, 0xe2, 0x45, 0x49, 0x60, 0xcb, 0x2c, 0xdf, 0xd6, 0x3c,
-		0xcf, 0x76, 0x25, 0xbf, 0x76, 0x47, 0x34, 0xc0, 0x2c, 0x89, 0x76, 0xdb, 0x9b, 0x59, 0x14, 0xce,
-		0xec, 0x48, 0x7b, 0xbe, 0x09, 0x40, 0x42, 0x16, 0x27, 0x8c, 0x67, 0x23, 0x1c, 0x1d, 0x75, 0x4f,
-		0x33, 0x57, 0xc4, 0x71, 0xe6, 0x2b, 0x58, 0xfd, 0x0d, 0xdd, 0x1e, 0x9b, 0x70, 0xb5, 0xa2, 0xa0,
-		0x34, 0x14, 0x23, 0x99, 0x90, 0x9a, 0x12, 0x0e, 0x84, 0x03, 0xd2, 0x9e, 0x0b, 0x2e, 0x90, 0x50,
-		0x1b, 0x60, 0x2a, 0x55, 0x2a, 0xd4, 0xed, 0xcc, 0x8b, 0xb0, 0x20, 0xf7, 0xec, 0x16, 0x1c, 0xa3,
-		0x4e, 0xc5, 0xce, 0xa2, 0x3c, 0xdb, 0xf6, 0xfa, 0x0d, 0x1d, 0x55, 0x13, 0x00, 0xc7, 0xab, 0x28,
-		0x62, 0xd1, 0xe5, 0x7c, 0xfe, 0x0e, 0x4d, 0xec, 0xf7, 0x4f, 0x3b, 0xa4, 0x14, 0x95, 0x1e, 0x66,
-		0xc7, 0xb0, 0x7c, 0x5a, 0x0f, 0xcd, 0xe7, 0x9e, 0x40, 0x93, 0x3b, 0xd9, 0xb3, 0xdc, 0x2b, 0x1f,
-		0x7a, 0x97, 0xde, 0x60, 0xce, 0xd1, 0x04, 0x57, 0xdc, 0x26, 0x79, 0xda, 0x33, 0x07, 0x61, 0x88,
-		0x39, 0x07, 0x31, 0x45, 0x14, 0x58, 0x04, 0xf8, 0xcf, 0x18, 0x85, 0xd2, 0x78, 0x34, 0x8d, 0x3a,
-		0x35, 0xbb, 0x9b, 0xb3, 0xe4, 0xb5, 0xee, 0x6c, 0x37, 0xad, 0x56, 0xa6, 0xa9, 0x4d, 0x6f, 0x16,
-		0x6f, 0xb4, 0x67, 0xec, 0xe7, 0x4e, 0x04, 0x12, 0x94, 0x63, 0x3f, 0xa5, 0xa8, 0xae, 0x89, 0xfd,
-		0x24, 0x5c, 0x9c, 0x99, 0x41, 0xa5, 0xce, 0x8c, 0x91, 0x52, 0x0a, 0xff, 0x72, 0x43, 0xc4, 0x14,
-		0x10, 0x24, 0x26, 0x31, 0x10, 0x1a, 0xe0, 0xdb, 0x76, 0x00, 0xa4, 0xf0, 0x7d, 0x44, 0x48, 0xe1,
-		0x83, 0x41, 0xa4, 0x88, 0x85, 0x67, 0x8d, 0xd8, 0xba, 0xd5, 0x7e, 0x26, 0xf4, 0x93, 0xf2, 0xa7,
-		0x29, 0xca, 0x57, 0xc0, 0x28, 0x7e, 0x3f, 0xcc, 0x7e, 0xfc, 0x10, 0xed, 0x7e, 0xfc, 0xf8, 0x7c,
-		0x68, 0xa2, 0x7f, 0x91, 0x70, 0x5f, 0xd1, 0xbf, 0xd0, 0x64, 0x28, 0xff, 0x52, 0x2c, 0x76, 0x4f,
-		0xb9, 0x70, 0x67, 0x96, 0xb5, 0xd6, 0xcf, 0x46, 0x55, 0x6a, 0xcf, 0x9d, 0x21, 0x60, 0x54, 0x1d,
-		0xac, 0x33, 0x04, 0x38, 0xb5, 0xb8, 0xdc, 0xd9, 0x72, 0x67, 0xeb, 0x71, 0xe2, 0x0e, 0x9d, 0x5b,
-		0xcd, 0xb9, 0xd5, 0xf6, 0x94, 0xdf, 0xac, 0x78, 0xac, 0x05, 0x4f, 0xd7, 0xd7, 0xdb, 0x06, 0x20,
-		0x33, 0x35, 0x9a, 0x50, 0xa9, 0x48, 0x67, 0x4a, 0xf5, 0x00, 0x3e, 0xe1, 0x05, 0x07, 0xc4, 0x75,
-		0xce, 0x73, 0x84, 0xc7, 0xa6, 0x6c, 0xbe, 0xef, 0xd8, 0xfc, 0xfd, 0x63, 0xf3, 0x65, 0x0a, 0x7b,
-		0x76, 0x61, 0x40, 0xf8, 0x3c, 0x44, 0x0b, 0xa3, 0x14, 0x87, 0xb5, 0xdd, 0xc9, 0x0f, 0x36, 0x5c,
-		0x87, 0x15, 0x82, 0x95, 0xa6, 0xbd, 0xfa, 0x07, 0x0a, 0x21, 0x99, 0x4d, 0xe9, 0xf7, 0x80, 0x84,
-		0x88, 0xc8, 0x28, 0x16, 0x38, 0x25, 0xdf, 0x80, 0x8c, 0x55, 0x68, 0x5d, 0x40, 0xa8, 0x44, 0x87,
-		0x76, 0x03, 0x70, 0xd3, 0xfb, 0x9a, 0xe9, 0x2c, 0xd6, 0x44, 0x5d, 0x85, 0xb8, 0x2b, 0x12, 0x79,
-		0x1d, 0x47, 0x4f, 0x2d, 0xa2, 0x6f, 0xc4, 0xd3, 0x53, 0xed, 0x10, 0x58, 0x32, 0x76, 0xc3, 0xbd,
-		0x32, 0xd6, 0x81, 0xaa, 0xeb, 0x42, 0x55, 0x74, 0xa2, 0xaa, 0xba, 0x91, 0xbd, 0x8e, 0x54, 0x4b,
-		0x57, 0xaa, 0xab, 0x33, 0x35, 0xa6, 0x27, 0xd4, 0xd7, 0x17, 0x2a, 0xe8, 0x52, 0xb5, 0x74, 0xaa,
-		0xb5, 0xa5, 0x3b, 0xbf, 0xff, 0x4b, 0xf7, 0x64, 0x8f, 0x0b, 0x7d, 0x67, 0x14, 0xdd, 0x73, 0x24,
-		0x5d, 0x75, 0xed, 0x06, 0xbd, 0xc7, 0x46, 0xd3, 0xc6, 0x57, 0x5f, 0x1d, 0x30, 0x48, 0x35, 0xfe,
-		0x84, 0x17, 0x7d, 0x7b, 0x8d, 0x4e, 0x0f, 0xab, 0xa6, 0xcb, 0x8d, 0x59, 0x84, 0xc9, 0x84, 0x4a,
-		0x43, 0x03, 0xfa, 0xe0, 0x49, 0x1b, 0x23, 0xf5, 0xda, 0x9e, 0xa3, 0x53, 0x9b, 0x89, 0x9d, 0xb2,
-		0xe6, 0x94, 0xb5, 0xfa, 0xca, 0x9a, 0xb9, 0x53, 0xb8, 0x8a, 0x73, 0x78, 0xb7, 0x93, 0x18, 0x2b,
-		0x2f, 0xf1, 0xb9, 0xfa, 0x4b, 0x51, 0xfe, 0x81, 0x8f, 0xfe, 0xa0, 0xda, 0xd1, 0x1f, 0x34, 0x70,
-		0xf4, 0x07, 0x9b, 0x8e, 0xfe, 0xc0, 0x1d, 0x7d, 0x77, 0xf4, 0x1f, 0xe5, 0xd1, 0x1f, 0x1c, 0x08,
-		0xa5, 0x70, 0x52, 0x1a, 0x7a, 0xce, 0xe4, 0x3b, 0x98, 0x1c, 0xf5, 0x87, 0xd8, 0x42, 0x63, 0x0f,
-		0x6d, 0xb4, 0x8d, 0x50, 0x81, 0xf6, 0xf6, 0x4c, 0x46, 0xc9, 0x3e, 0xa3, 0x3e, 0x12, 0x47, 0x4f,
-		0x95, 0x7a, 0x0d, 0x4f, 0x4f, 0x40, 0xbb, 0x20, 0x8e, 0xba, 0xdd, 0xd3, 0xef, 0x48, 0x70, 0x7c,
-		0x02, 0x4f, 0xbd, 0xdc, 0x97, 0xa7, 0xdf, 0xa9, 0x0d, 0xde, 0xfc, 0xf5, 0xe0, 0xf8, 0x18, 0xbe,
-		0x59, 0x7e, 0x97, 0x73, 0xde, 0x1d, 0xdb, 0x1c, 0x94, 0x2a, 0x20, 0xc3, 0x75, 0x56, 0x9a, 0xa1,
-		0xed, 0x38, 0x52, 0xe9, 0x94, 0x95, 0x30, 0x87, 0x75, 0x59, 0x2d, 0x34, 0x82, 0x41, 0x6c, 0x8c,
-		0xef, 0xc2, 0x76, 0x4c, 0xe2, 0xe6, 0x55, 0xda, 0x93, 0x9d, 0x62, 0x13, 0x5a, 0xaa, 0x02, 0x59,
-		0xdc, 0xe5, 0x47, 0x86, 0x31, 0x8b, 0x66, 0xe8, 0xae, 0x08, 0xa0, 0x2a, 0xa4, 0x71, 0x7f, 0x24,
-		0xb0, 0x61, 0x69, 0xda, 0xb2, 0xeb, 0xb6, 0x10, 0xc8, 0x9d, 0xdb, 0x9e, 0x42, 0xfe, 0xf4, 0x3b,
-		0x0a, 0x1c, 0x24, 0x8c, 0x0a, 0x42, 0xf2, 0x09, 0x43, 0xc2, 0xfe, 0x34, 0x3c, 0xba, 0xdb, 0x3d,
-		0x25, 0x81, 0xfa, 0x13, 0x7b, 0xfa, 0x1b, 0xc5, 0xf4, 0xd6, 0xbe, 0x19, 0xe8, 0x6f, 0x9e, 0xde,
-		0x29, 0x2d, 0xd9, 0x03, 0x2a, 0x0f, 0x43, 0x4b, 0xfb, 0x5c, 0xef, 0x7d, 0x11, 0x68, 0x2b, 0x3a,
-		0x8b, 0x19, 0xc2, 0x38, 0xb3, 0xeb, 0x4d, 0xe0, 0x9c, 0x67, 0xa7, 0x46, 0xd1, 0x5e, 0x30, 0x03,
-		0x77, 0x9e, 0xe9, 0xff, 0x5e, 0x76, 0x0e, 0x99, 0x1c, 0xb2, 0x15, 0xd4, 0xb7, 0xe7, 0xd4, 0x10,
-		0x3b, 0xbd, 0xb1, 0x5e, 0xb6, 0x48, 0x93, 0x08, 0xde, 0xb3, 0x7a, 0x00, 0xde, 0xb3, 0xba, 0xf8,
-		0xdd, 0x73, 0x33, 0xfc, 0xee, 0x79, 0x05, 0xfc, 0xae, 0x86, 0x1a, 0x38, 0xd8, 0xee, 0x3d, 0x80,
-		0xed, 0x1a, 0x39, 0x89, 0xb3, 0xe5, 0x34, 0x70, 0xe1, 0xae, 0xd2, 0x85, 0x32, 0x37, 0x59, 0xd2,
-		0xe3, 0xf6, 0x1c, 0x3c, 0x30, 0x8a, 0xa0, 0x3a, 0x84, 0x61, 0x83, 0xea, 0x80, 0x43, 0x18, 0x3a,
-		0x84, 0xa1, 0x43, 0x18, 0x36, 0xb5, 0x26, 0x2d, 0x46, 0x18, 0x1a, 0xb9, 0xfd, 0xf3, 0xcc, 0x7c,
-		0x60, 0xcf, 0xcc, 0x07, 0x05, 0x66, 0xae, 0xd3, 0x54, 0x1d, 0x33, 0x77, 0xcc, 0xfc, 0x31, 0xd6,
-		0xaa, 0x1a, 0x38, 0x4e, 0xbe, 0x26, 0xdc, 0x1c, 0x27, 0x6f, 0x82, 0x93, 0x87, 0x18, 0x8d, 0xcf,
-		0x91, 0x5d, 0xfe, 0xcf, 0xb9, 0x2d, 0x56, 0x9c, 0x32, 0x1d, 0xaf, 0x5d, 0x22, 0x6d, 0x9d, 0x9e,
-		0xee, 0x58, 0xbb, 0xd3, 0xd3, 0x9d, 0x9e, 0xee, 0xf4, 0xf4, 0xfa, 0xdc, 0xbd, 0x8a, 0x0f, 0x55,
-		0x39, 0x4b, 0x76, 0xe8, 0xe5, 0x9d, 0x57, 0xb7, 0x6a, 0x15, 0xb7, 0x1f, 0x14, 0x73, 0x47, 0xd6,
-		0xad, 0xb8, 0x98, 0x44, 0x2c, 0x36, 0x09, 0x74, 0xd5, 0x73, 0x67, 0x05, 0x78, 0xc6, 0x28, 0x17,
-		0x11, 0x12, 0x98, 0xc3, 0xf2, 0x96, 0x96, 0x2b, 0x77, 0xf5, 0xe0, 0x9d, 0xcb, 0x77, 0x59, 0x8a,
-		0x28, 0x05, 0x19, 0x9c, 0x23, 0x28, 0xa0, 0x06, 0x36, 0x63, 0x09, 0xd6, 0xa1, 0x04, 0x5a, 0xff,
-		0x38, 0x64, 0x89, 0xa2, 0x3a, 0xa8, 0x81, 0x87, 0x53, 0xa9, 0xa8, 0x06, 0x2a, 0xa0, 0x05, 0x05,
-		0x8b, 0x34, 0xd5, 0xd8, 0x05, 0xfc, 0xef, 0x7f, 0xcd, 0xa2, 0xe2, 0x5b, 0xdf, 0x9b, 0xb2, 0x45,
-		0xc9, 0x63, 0x97, 0x84, 0x8d, 0xcf, 0x51, 0x12, 0x33, 0x5e, 0x0b, 0x10, 0xdb, 0xc7, 0xe3, 0xef,
-		0x7f, 0x41, 0xa3, 0x26, 0xd7, 0xec, 0xd1, 0x96, 0x3a, 0x3a, 0xaf, 0x17, 0x28, 0x3d, 0x37, 0x0e,
-		0x94, 0x3e, 0xd9, 0xf1, 0x66, 0xdb, 0x35, 0xaf, 0xbf, 0x9e, 0x34, 0xa0, 0x69, 0x19, 0x93, 0x5c,
-		0x25, 0xcd, 0xaa, 0xf8, 0x66, 0x2b, 0x9a, 0x54, 0xe7, 0x32, 0x9e, 0xc8, 0x09, 0x71, 0x60, 0xf3,
-		0x7a, 0x29, 0x57, 0x58, 0x02, 0x5f, 0x4d, 0xe3, 0xc4, 0x41, 0x00, 0x08, 0x7c, 0x16, 0x4b, 0xb9,
-		0xc5, 0xc6, 0x40, 0xf1, 0x8d, 0xf2, 0x39, 0x70, 0x10, 0x0c, 0x76, 0xcf, 0xe4, 0x02, 0xc7, 0x75,
-		0x7c, 0x06, 0x0d, 0x07, 0x8e, 0x77, 0x97, 0x00, 0x5b, 0x97, 0x1e, 0x25, 0x88, 0x07, 0x68, 0xa4,
-		0x24, 0x98, 0x21, 0xa9, 0x38, 0xb7, 0x54, 0x9b, 0xdd, 0x52, 0x65, 0x78, 0x22, 0x3b, 0xd3, 0xcf,
-		0xde, 0x04, 0x6c, 0xc4, 0x14, 0xb4, 0x33, 0x09, 0x6b, 0x3a, 0x8b, 0x77, 0xa2, 0x79, 0x36, 0x1e,
-		0xc5, 0xf3, 0x1a, 0x47, 0x71, 0x27, 0xba, 0xc7, 0x9d, 0xc0, 0x87, 0x73, 0x02, 0xcb, 0xbc, 0x51,
-		0xe6, 0x5e, 0x29, 0x3b, 0xd7, 0x6d, 0x45, 0x2f, 0x55, 0xb3, 0xcb, 0x6c, 0xeb, 0xb5, 0x32, 0x5b,
-		0xf9, 0x2b, 0x97, 0x72, 0x53, 0xdd, 0xeb, 0x65, 0xe7, 0xfd, 0xaa, 0x10, 0x2e, 0x38, 0xbc, 0x37,
-		0xac, 0x96, 0x57, 0x6c, 0x4f, 0xde, 0xb1, 0x3a, 0xe6, 0x77, 0x73, 0xde, 0xb2, 0x46, 0x8c, 0xf1,
-		0xfd, 0x79, 0xcf, 0xec, 0xc2, 0x0c, 0x70, 0x17, 0x39, 0x35, 0x55, 0xbc, 0x6b, 0x4d, 0xef, 0x7d,
-		0xfb, 0xd2, 0x69, 0x2a, 0x78, 0xdf, 0x0e, 0xb0, 0xd7, 0xb5, 0x33, 0x69, 0x0e, 0xef, 0x9d, 0xdb,
-		0x0f, 0xa9, 0xb4, 0x29, 0x5b, 0xe6, 0xe0, 0xde, 0xbb, 0x0a, 0x84, 0xd6, 0xce, 0x28, 0xa7, 0xa5,
-		0x07, 0xca, 0x40, 0x07, 0xd8, 0x2d, 0xf3, 0x0d, 0x1c, 0x3a, 0x94, 0x89, 0x23, 0x5d, 0xb7, 0xf7,
-		0x03, 0xc7, 0xc2, 0x4b, 0x1a, 0xb1, 0xf1, 0xa3, 0x31, 0x0b, 0x43, 0x76, 0x43, 0xe8, 0xc4, 0xe3,
-		0x64, 0x14, 0x12, 0x3a, 0xb9, 0xc8, 0xca, 0xfb, 0x26, 0xcd, 0xa9, 0x4e, 0x20, 0xfd, 0xeb, 0xf8,
-		0x6a, 0x97, 0x4c, 0xb7, 0x92, 0xe1, 0xd9, 0x73, 0x5d, 0xa3, 0x90, 0x04, 0xca, 0x53, 0x0a, 0x63,
-		0x44, 0x42, 0x0e, 0x64, 0x9c, 0xdd, 0x4f, 0x4a, 0x29, 0xca, 0x04, 0xc4, 0x94, 0xfc, 0x19, 0xe3,
-		0xb4, 0xa8, 0x63, 0x69, 0xf9, 0x77, 0xdb, 0x53, 0x59, 0x5d, 0x58, 0x57, 0x3a, 0x73, 0x85, 0x33,
-		0x56, 0xf9, 0xf5, 0xf7, 0x60, 0x47, 0xdb, 0x48, 0xdd, 0x82, 0x35, 0x3d, 0x40, 0x26, 0x5d, 0x37,
-		0xaa, 0x6e, 0x89, 0xad, 0x0c, 0xad, 0xbf, 0x29, 0xf9, 0x77, 0xda, 0xd7, 0x3a, 0x9b, 0x4a, 0xbc,
-		0xb5, 0x5e, 0x71, 0x9a, 0x1f, 0x4b, 0xba, 0x18, 0x61, 0x88, 0xf0, 0x1c, 0x23, 0xc9, 0x92, 0xf7,
-		0x7d, 0x2e, 0x6c, 0xa5, 0x53, 0xfd, 0x4d, 0x30, 0x7d, 0xdf, 0x3d, 0x73, 0xee, 0x0d, 0x16, 0x6b,
-		0x67, 0x1e, 0x61, 0x8e, 0xa9, 0x8f, 0xeb, 0xf0, 0xe3, 0x77, 0x6c, 0x0e, 0x21, 0xbe, 0xc6, 0xa1,
-		0x3c, 0xe5, 0x7a, 0x42, 0xd1, 0xbc, 0xc7, 0x5d, 0x3f, 0xe6, 0x3e, 0x7d, 0xee, 0x1b, 0xdf, 0xa3,
-		0xfe, 0xaa, 0xef, 0x8c, 0x45, 0x95, 0x44, 0xd7, 0xca, 0xa2, 0x6a, 0x1b, 0xd6, 0x63, 0x77, 0x1c,
-		0xad, 0xf8, 0x3e, 0xcb, 0xa7, 0xcd, 0x3d, 0x57, 0x47, 0x4d, 0x3d, 0xf2, 0x94, 0x19, 0xb5, 0xf6,
-		0x54, 0x79, 0xcb, 0x7a, 0x79, 0xd5, 0xca, 0x5b, 0xad, 0xf7, 0xd9, 0xc6, 0x3e, 0xa3, 0x01, 0x88,
-		0x6c, 0x85, 0xb3, 0xee, 0xa9, 0xe0, 0x81, 0xb2, 0xe2, 0x18, 0x55, 0x6a, 0x99, 0x36, 0xde, 0x96,
-		0x38, 0x56, 0xde, 0x85, 0x57, 0x01, 0x11, 0xc0, 0x17, 0xb3, 0x11, 0x0b, 0x81, 0x4f, 0x59, 0x1c,
-		0x06, 0xe9, 0x11, 0xba, 0x26, 0x52, 0xd0, 0xaf, 0xdd, 0x7d, 0xb3, 0xbf, 0x72, 0xe9, 0x9f, 0x5c,
-		0xc1, 0x7a, 0xef, 0xf2, 0x47, 0xee, 0xee, 0xfa, 0x58, 0x46, 0xc9, 0xc6, 0xee, 0xc5, 0x6a, 0x31,
-		0xc3, 0x4d, 0x5d, 0x1b, 0x77, 0x47, 0x3e, 0xb7, 0x85, 0x82, 0x3a, 0x49, 0x27, 0xd1, 0xf2, 0x34,
-		0x52, 0x7d, 0x9d, 0x69, 0x1e, 0xe9, 0xca, 0x66, 0x96, 0x44, 0x03, 0x7b, 0x65, 0xd1, 0xc0, 0x41,
-		0x23, 0xd1, 0xc0, 0xfb, 0x18, 0x0c, 0x6c, 0x2a, 0x16, 0x58, 0x8a, 0x13, 0x36, 0x6f, 0xbf, 0x69,
-		0xd0, 0x76, 0xd3, 0x30, 0xd7, 0xc3, 0xcc, 0x3d, 0x6a, 0xee, 0xd4, 0x5f, 0x26, 0x30, 0xf4, 0x7a,
-		0xa6, 0xa5, 0xc4, 0xaa, 0xa2, 0x5b, 0xed, 0x51, 0xad, 0x5f, 0xcc, 0x7c, 0xbb, 0xf6, 0xaf, 0x3b,
-		0x68, 0xe7, 0xeb, 0x36, 0x0b, 0x52, 0x31, 0xce, 0x89, 0x1f, 0x8c, 0xcc, 0x92, 0xe2, 0x07, 0x23,
-		0x73, 0x6e, 0x46, 0x94, 0x8f, 0x51, 0x85, 0xcf, 0xd8, 0x38, 0x61, 0x6e, 0x44, 0xe0, 0x59, 0xed,
-		0xfc, 0x78, 0xc7, 0xd8, 0xea, 0x32, 0xb6, 0xf2, 0xa6, 0x56, 0x0a, 0x6b, 0x60, 0xde, 0xd7, 0xca,
-		0x04, 0x9a, 0xb0, 0xda, 0xcb, 0x7a, 0x9a, 0x10, 0x87, 0x0d, 0xae, 0xc1, 0xf5, 0xb2, 0x6e, 0x38,
-		0xda, 0xd7, 0xd6, 0x5e, 0xd6, 0x8f, 0x23, 0x8f, 0xb2, 0xe7, 0x32, 0x6d, 0xd6, 0xe4, 0xf2, 0xf9,
-		0xb9, 0x4b, 0xb5, 0x69, 0xc0, 0xd7, 0x24, 0x6d, 0x8f, 0xaf, 0x7c, 0xbb, 0x44, 0xca, 0xaf, 0x7c,
-		0x6b, 0x74, 0x4c, 0x12, 0x11, 0x58, 0x66, 0x52, 0x12, 0x0a, 0x22, 0xe1, 0xec, 0x4d, 0xf3, 0xf4,
-		0x81, 0xe3, 0xe9, 0xf7, 0x96, 0xa7, 0xbb, 0x04, 0xca, 0x47, 0xce, 0xd6, 0x5d, 0x02, 0x65, 0x4d,
-		0x07, 0x75, 0x49, 0x11, 0xba, 0x5d, 0xfd, 0x82, 0x5d, 0x1d, 0xba, 0xa2, 0x23, 0x38, 0xf1, 0xc3,
-		0x9e, 0xee, 0xb4, 0x68, 0x4b, 0xbd, 0xc3, 0xdf, 0xff, 0x26, 0x27, 0xd1, 0x4d, 0xa5, 0xbf, 0x7f,
-		0xb8, 0xb9, 0x16, 0x2f, 0x97, 0x78, 0x40, 0x6d, 0xc4, 0x5b, 0xa7, 0x59, 0xd4, 0x72, 0xda, 0x6f,
-		0xf6, 0x99, 0x1b, 0x6e, 0x8e, 0x89, 0xff, 0x5e, 0x2a, 0x3e, 0x1e, 0x12, 0x9e, 0x60, 0x73, 0x4f,
-		0x79, 0xdb, 0xb7, 0x3b, 0xf1, 0xd7, 0x2f, 0x2d, 0xf3, 0xe4, 0xab, 0x8e, 0x83, 0x48, 0x28, 0x95,
-		0x68, 0xe9, 0xce, 0x3f, 0xa2, 0x4c, 0x40, 0x84, 0x7d, 0x36, 0x9b, 0x61, 0x1a, 0xe0, 0x00, 0x46,
-		0xb1, 0xc8, 0xd0, 0x16, 0x3c, 0x9e, 0xcf, 0x59, 0x24, 0x70, 0x70, 0xbc, 0xc5, 0x53, 0xdf, 0xdb,
-		0xe6, 0xa9, 0xef, 0x3d, 0x5a, 0x4f, 0xfd, 0x56, 0x7d, 0xa4, 0x5c, 0xff, 0xd8, 0xa5, 0x6f, 0x74,
-		0x7e, 0x45, 0x42, 0xe0, 0x88, 0x6e, 0x55, 0x30, 0x3a, 0x1f, 0x2e, 0xbd, 0xdf, 0xaf, 0xfe, 0xfa,
-		0xea, 0x8b, 0xf7, 0xa1, 0xe7, 0x7d, 0x7d, 0xf5, 0xac, 0x63, 0x19, 0xcf, 0x7a, 0x40, 0xb9, 0x55,
-		0x9b, 0xcf, 0x16, 0xe1, 0xa2, 0x8f, 0x76, 0x1c, 0x28, 0xfd, 0x7b, 0xe9, 0x29, 0x22, 0x5c, 0xac,
-		0x9d, 0x22, 0xdb, 0x38, 0x56, 0xcf, 0xc5, 0xb1, 0x36, 0x79, 0x7e, 0x3d, 0x12, 0x98, 0xb9, 0x7e,
-		0x3d, 0x62, 0x1a, 0xc9, 0x4a, 0x60, 0x2b, 0x24, 0xc0, 0x54, 0x90, 0x31, 0xc1, 0x91, 0x0a, 0x55,
-		0xa6, 0x56, 0x61, 0x69, 0x9a, 0x5b, 0xef, 0x30, 0x69, 0x6e, 0x2e, 0xb0, 0x65, 0xb2, 0x4e, 0xa5,
-		0x76, 0xdb, 0x92, 0x7f, 0xd6, 0x08, 0x41, 0xec, 0xec, 0xc1, 0xba, 0x44, 0xb2, 0x6d, 0x6f, 0xb6,
-		0xba, 0x4a, 0x82, 0x11, 0x56, 0x1d, 0x53, 0x7d, 0xc9, 0x2f, 0x14, 0xe5, 0xa9, 0xca, 0xdf, 0x98,
-		0x8a, 0x68, 0x51, 0x8a, 0x98, 0x71, 0x74, 0xd8, 0x42, 0x3a, 0x2c, 0x6f, 0x6b, 0x63, 0xd2, 0xc6,
-		0x66, 0xd9, 0xb6, 0x26, 0x97, 0x66, 0x3d, 0x40, 0xa7, 0x8a, 0xb2, 0x1a, 0xd1, 0xe0, 0x13, 0x73,
-		0x6c, 0x33, 0xc3, 0x6c, 0xbd, 0x7e, 0x2f, 0x6d, 0x19, 0x6e, 0xab, 0xdf, 0xef, 0xb4, 0x2e, 0xcb,
-		0xad, 0xca, 0x4a, 0xd6, 0xe4, 0x6e, 0x2b, 0xb2, 0x31, 0xd8, 0xd0, 0x56, 0x79, 0xb5, 0xcd, 0xf6,
-		0x90, 0x6b, 0x61, 0x06, 0x1b, 0xe2, 0x37, 0x44, 0xf8, 0xd3, 0xed, 0xaa, 0x51, 0xf2, 0x7b, 0x99,
-		0x6a, 0x34, 0x43, 0x14, 0x4d, 0x70, 0x00, 0x01, 0xbe, 0x26, 0x7e, 0xe6, 0x7d, 0x1d, 0xa3, 0x51,
-		0x44, 0xfc, 0x14, 0x2c, 0x44, 0x38, 0x20, 0x0a, 0xf8, 0x56, 0x27, 0x7d, 0xb0, 0x31, 0xa0, 0x9c,
-		0x2d, 0x92, 0xcf, 0x34, 0x0e, 0x58, 0x3c, 0x0a, 0x31, 0x7c, 0xc2, 0x0b, 0x1c, 0x00, 0x8f, 0x47,
-		0xea, 0xb7, 0x23, 0x69, 0x8d, 0x1c, 0x77, 0x87, 0xf4, 0x47, 0x16, 0x01, 0x67, 0x92, 0x8d, 0xde,
-		0x8a, 0x08, 0x81, 0xcf, 0xe4, 0x74, 0xb7, 0x44, 0x2c, 0xd4, 0x2d, 0xf5, 0xf3, 0xca, 0x7b, 0x29,
-		0x2b, 0x29, 0xc2, 0xe3, 0x31, 0x0e, 0x24, 0xf7, 0xd5, 0x3f, 0x78, 0x33, 0x16, 0xe0, 0x10, 0x6e,
-		0xa6, 0xc4, 0x9f, 0x42, 0x84, 0xa5, 0x58, 0xf1, 0x05, 0x57, 0x23, 0xfd, 0x29, 0x93, 0x8f, 0xce,
-		0xc6, 0x20, 0xef, 0xd4, 0x1d, 0xd2, 0xcb, 0x20, 0x20, 0xba, 0x31, 0x76, 0xb8, 0x58, 0xb9, 0xa2,
-		0x30, 0x19, 0xa2, 0x81, 0x1a, 0x92, 0xcd, 0xa7, 0x2e, 0x96, 0xdf, 0x78, 0x7c, 0x8e, 0xe5, 0xdd,
-		0xa7, 0x48, 0x80, 0x8f, 0xa8, 0x34, 0xab, 0xfc, 0x29, 0xe3, 0x98, 0x76, 0x87, 0x54, 0xc5, 0x1d,
-		0xd3, 0x46, 0xf0, 0x80, 0x42, 0x46, 0x31, 0x04, 0x4c, 0x81, 0xa4, 0x26, 0xe4, 0x5a, 0x0a, 0x09,
-		0x16, 0x4f, 0x96, 0xcf, 0x48, 0x18, 0x05, 0x46, 0xb3, 0x99, 0x81, 0xe3, 0x10, 0xeb, 0x6f, 0x8f,
-		0x90, 0x7a, 0xfe, 0x85, 0xbc, 0xc3, 0x53, 0x91, 0x62, 0xb4, 0x86, 0x74, 0x1e, 0xe1, 0x80, 0xf8,
-		0x52, 0x7d, 0x3e, 0x56, 0x8f, 0xc8, 0x99, 0x5c, 0x7e, 0x24, 0x5f, 0x4a, 0x35, 0xfb, 0x56, 0x86,
-		0x5e, 0x14, 0x87, 0x58, 0x2e, 0x96, 0xbc, 0x25, 0xd5, 0x0f, 0x8a, 0xe9, 0x98, 0x45, 0x3e, 0xd6,
-		0x8b, 0x92, 0x4d, 0x22, 0xd7, 0x83, 0x03, 0x82, 0x29, 0x0e, 0xe7, 0x38, 0x4a, 0xc5, 0xd9, 0xdb,
-		0x5f, 0x7e, 0xf0, 0x7e, 0x7a, 0xff, 0x5a, 0xfd, 0xfd, 0x3f, 0x6a, 0xc1, 0xb3, 0xe7, 0xfa, 0x1f,
-		0xb9, 0x3f, 0x98, 0x72, 0xf9, 0x88, 0xd9, 0x0d, 0x14, 0x5d, 0x10, 0x0e, 0x3e, 0xe2, 0x4a, 0x24,
-		0xea, 0xab, 0x87, 0x54, 0xa1, 0x90, 0x93, 0x3e, 0xe4, 0x5c, 0xa9, 0x69, 0x98, 0xc6, 0x33, 0x1c,
-		0x29, 0xa8, 0xe5, 0x35, 0x0a, 0x63, 0xcc, 0x53, 0x9a, 0x92, 0xef, 0x87, 0x6f, 0x85, 0xa6, 0x9f,
-		0xec, 0xf1, 0x0e, 0xa1, 0x85, 0xf3, 0x9b, 0xf6, 0x69, 0xe1, 0xfc, 0xa6, 0x31, 0x2d, 0x7c, 0x09,
-		0xf8, 0x2a, 0xd5, 0x80, 0x4a, 0xb1, 0x61, 0xab, 0x3d, 0xe6, 0x61, 0x86, 0xe6, 0x72, 0xc7, 0xf4,
-		0x91, 0x5d, 0xe2, 0x04, 0x2f, 0x92, 0xc0, 0x8d, 0xb7, 0x53, 0xbf, 0x3b, 0x68, 0xe1, 0x89, 0xb1,
-		0xb8, 0x7f, 0x9a, 0xd0, 0x58, 0x1c, 0x0c, 0x91, 0x91, 0xed, 0x9d, 0xf7, 0x49, 0xa9, 0x18, 0x86,
-		0x61, 0xbd, 0xe2, 0x30, 0xbb, 0xe8, 0x9e, 0x3c, 0xf5, 0x9f, 0xb0, 0x52, 0x98, 0x33, 0x42, 0x5a,
-		0x92, 0xd0, 0x7d, 0x40, 0x6c, 0x8c, 0xc5, 0xc3, 0x8b, 0xee, 0x8d, 0x45, 0xfb, 0xa2, 0x7b, 0x0b,
-		0x44, 0x27, 0xde, 0xd2, 0xde, 0x77, 0x61, 0xbe, 0xc7, 0x1a, 0xe6, 0x3b, 0x7b, 0x84, 0x61, 0x3e,
-		0x03, 0x02, 0x2e, 0xf3, 0x23, 0xaf, 0xfb, 0x95, 0x91, 0xf7, 0x9f, 0x4b, 0xef, 0xf7, 0x8f, 0x57,
-		0xc9, 0x1f, 0x3d, 0xef, 0xeb, 0xe1, 0xd0, 0xfb, 0xd8, 0xbd, 0x7a, 0x66, 0xca, 0x6b, 0xba, 0x9f,
-		0xbb, 0xdd, 0xcf, 0x1f, 0xfe, 0xb8, 0xfd, 0xaf, 0xab, 0xee, 0xb3, 0xcf, 0xdd, 0x0f, 0x7f, 0xcc,
-		0xde, 0xa8, 0x3f, 0xba, 0x1f, 0xfe, 0x08, 0x7f, 0xbe, 0xea, 0x3e, 0xeb, 0xdc, 0x09, 0x28, 0xe5,
-		0x3a, 0x21, 0x13, 0xf3, 0x14, 0xc1, 0x18, 0x57, 0x10, 0x5a, 0x6a, 0x5c, 0x41, 0x5a, 0x99, 0x48,
-		0x3f, 0x27, 0xb0, 0xee, 0xab, 0xc0, 0x7a, 0x83, 0x68, 0x80, 0x04, 0x8b, 0x16, 0x06, 0x75, 0x77,
-		0x1c, 0x74, 0xc5, 0xc9, 0x34, 0xbb, 0x25, 0x19, 0xf4, 0x1c, 0x76, 0xc5, 0x98, 0xf9, 0x57, 0xc1,
-		0xae, 0x98, 0x70, 0x69, 0x87, 0x61, 0x61, 0x74, 0xec, 0x69, 0xf7, 0xd7, 0x69, 0xf2, 0x51, 0xe6,
-		0x0d, 0xd8, 0xec, 0xa8, 0xfc, 0x4d, 0x0d, 0xfe, 0x98, 0x7c, 0x5c, 0x66, 0x73, 0xd4, 0x08, 0xdf,
-		0x04, 0x06, 0xf9, 0xff, 0xcb, 0xd6, 0xb7, 0xa5, 0x89, 0xf6, 0xab, 0x22, 0x3d, 0x64, 0x74, 0x02,
-		0xb9, 0x61, 0x30, 0x26, 0x38, 0x0c, 0xda, 0x10, 0xbd, 0x79, 0xdc, 0x3e, 0x8b, 0xbd, 0x44, 0x11,
-		0x77, 0x5c, 0x63, 0x28, 0x32, 0xf7, 0x96, 0x20, 0xf7, 0x78, 0x92, 0xe3, 0xfa, 0xbd, 0xc1, 0x99,
-		0xcb, 0x8d, 0x83, 0xac, 0x6f, 0xb4, 0x67, 0x16, 0xa0, 0x2e, 0x5c, 0x6d, 0xc6, 0xdb, 0x92, 0x21,
-		0x3a, 0x3e, 0x2d, 0x18, 0xc4, 0x5c, 0x05, 0x71, 0x7e, 0x7a, 0xff, 0x1a, 0x58, 0x04, 0x3f, 0xfc,
-		0xfc, 0xda, 0xb1, 0x39, 0xc7, 0xe6, 0x1c, 0x9b, 0xdb, 0x0f, 0x9b, 0x7b, 0xe1, 0x12, 0x80, 0xe5,
-		0x43, 0x4e, 0x19, 0x17, 0x5e, 0xc8, 0x7c, 0x14, 0x7a, 0x68, 0xa2, 0x8f, 0x4f, 0x09, 0xa3, 0x5b,
-		0x1b, 0x61, 0xaa, 0xc8, 0xf9, 0x28, 0x04, 0x35, 0x02, 0xde, 0xbf, 0x6d, 0x05, 0x6b, 0xdb, 0x18,
-		0x5b, 0x6c, 0x39, 0x6b, 0xdb, 0x14, 0x7b, 0xdc, 0x37, 0x6b, 0x8b, 0x23, 0xb2, 0x6f, 0x10, 0x98,
-		0x0a, 0xea, 0x1b, 0xc1, 0x11, 0xb3, 0x2b, 0x0d, 0xc9, 0x8e, 0xd0, 0x4f, 0x4b, 0x24, 0x02, 0xa8,
-		0xd1, 0x8e, 0xf6, 0xee, 0x9a, 0xf6, 0x0c, 0x9c, 0x76, 0x77, 0x88, 0x0f, 0xe3, 0xb3, 0x8b, 0x3c,
-		0xd6, 0x64, 0xf5, 0xdf, 0x92, 0xf8, 0x6a, 0x90, 0xfa, 0x9c, 0x45, 0x06, 0x5c, 0x56, 0x5d, 0x65,
-		0x5a, 0x6e, 0x41, 0x81, 0x53, 0x54, 0xa4, 0x56, 0x3f, 0x67, 0x17, 0xde, 0x4d, 0x09, 0x87, 0x02,
-		0x9a, 0x1b, 0x41, 0xc8, 0x04, 0xa0, 0xe0, 0x1a, 0x51, 0x1f, 0x07, 0x30, 0x8e, 0xa9, 0xaf, 0x71,
-		0x36, 0x44, 0x2c, 0x52, 0x98, 0x47, 0x82, 0x2c, 0xe9, 0x0e, 0xe9, 0x8f, 0x24, 0xe2, 0x02, 0x88,
-		0xd0, 0x25, 0x63, 0x0b, 0xd8, 0xa0, 0x90, 0x70, 0x71, 0xa2, 0x51, 0x43, 0x3e, 0x9a, 0x60, 0x4f,
-		0x77, 0x43, 0x86, 0x11, 0x56, 0xb9, 0xa0, 0x50, 0x40, 0x5f, 0x16, 0x96, 0x4d, 0x3e, 0xe5, 0x90,
-		0x1e, 0x8d, 0x16, 0x69, 0x57, 0x83, 0x63, 0x95, 0xfb, 0xf0, 0x67, 0x8c, 0x42, 0x32, 0x26, 0xea,
-		0x99, 0x22, 0x31, 0x95, 0x53, 0x65, 0xc8, 0x1f, 0x8e, 0x69, 0x7a, 0x72, 0xd5, 0x3b, 0x1e, 0xc5,
-		0x5c, 0xde, 0x65, 0x89, 0xa2, 0xd1, 0xe0, 0xa5, 0x1c, 0xee, 0xe6, 0x58, 0x83, 0x7c, 0x92, 0x2a,
-		0x4a, 0x2a, 0x82, 0x2d, 0xdf, 0x40, 0x3f, 0xe4, 0x89, 0xba, 0x21, 0xe1, 0x19, 0xc0, 0x07, 0x07,
-		0xd9, 0xcd, 0x72, 0xaf, 0x92, 0xdc, 0x18, 0xd1, 0x20, 0x8f, 0x6e, 0x52, 0x2f, 0x31, 0xa4, 0x62,
-		0x1a, 0x29, 0x8c, 0x10, 0x5a, 0x3e, 0x85, 0x5c, 0x6e, 0x0c, 0xe9, 0x60, 0x44, 0x29, 0x13, 0x80,
-		0x6f, 0x7d, 0x8d, 0x42, 0xc2, 0x30, 0x43, 0xb7, 0x9e, 0x3f, 0x45, 0x94, 0xe2, 0x90, 0x03, 0x1b,
-		0x27, 0x80, 0x9a, 0x28, 0xc2, 0x7c, 0xce, 0x68, 0x40, 0xe8, 0x64, 0x48, 0xd7, 0xd6, 0x29, 0xc1,
-		0x2a, 0x69, 0x28, 0x53, 0xf1, 0x81, 0x97, 0x0f, 0x90, 0x2c, 0xa3, 0x7e, 0x2b, 0x31, 0xc5, 0x14,
-		0x66, 0x2c, 0x52, 0x83, 0x7c, 0x32, 0x26, 0xbe, 0x82, 0x50, 0xe5, 0x06, 0xea, 0xb5, 0xd3, 0xcf,
-		0x3d, 0xa4, 0x6a, 0xf9, 0x3c, 0xb9, 0xc5, 0x69, 0xf2, 0x09, 0xa3, 0x38, 0x7d, 0x3c, 0x85, 0x2a,
-		0x52, 0x08, 0xb0, 0x55, 0x1c, 0x50, 0xee, 0xb1, 0x61, 0xd3, 0x53, 0x7f, 0x8f, 0x7d, 0xa4, 0xec,
-		0x98, 0x84, 0x74, 0x66, 0xf2, 0xa4, 0x07, 0x2c, 0x5a, 0x68, 0xc7, 0xcd, 0x49, 0xfe, 0x86, 0xc9,
-		0x83, 0xe2, 0x20, 0xc5, 0xf7, 0x73, 0x65, 0x08, 0x91, 0x19, 0x06, 0xc4, 0x97, 0xa8, 0x2b, 0xc2,
-		0x35, 0x71, 0x0d, 0xa9, 0x1f, 0xa9, 0x3a, 0x70, 0x7a, 0xbd, 0xa5, 0x99, 0x44, 0x31, 0x0e, 0x54,
-		0x0f, 0x95, 0x08, 0x8b, 0x88, 0xe0, 0x6b, 0x0c, 0x49, 0x08, 0x88, 0x04, 0xc0, 0xb1, 0xae, 0x3d,
-		0xa2, 0x96, 0x30, 0x45, 0x39, 0x8d, 0xf0, 0x58, 0x2e, 0x51, 0x36, 0x37, 0xbe, 0x25, 0x5c, 0x70,
-		0xb5, 0xda, 0x1a, 0x2c, 0x17, 0xc8, 0x55, 0x48, 0x97, 0x58, 0x5e, 0x76, 0xf9, 0xeb, 0xeb, 0x04,
-		0xb9, 0x26, 0x7f, 0x8d, 0x14, 0xa2, 0x2a, 0xa1, 0x99, 0x22, 0xf6, 0x6a, 0x09, 0xbd, 0x3a, 0x49,
-		0x49, 0x67, 0x48, 0x8b, 0x97, 0x78, 0x01, 0x1e, 0xa3, 0x38, 0x4c, 0x56, 0x55, 0x52, 0xb3, 0xdc,
-		0x4f, 0x3c, 0x9b, 0xb3, 0x08, 0x45, 0x24, 0x5c, 0x40, 0x10, 0xab, 0x8c, 0x6a, 0x39, 0x39, 0xa1,
-		0x44, 0x10, 0x14, 0xc2, 0x3c, 0x62, 0x23, 0xb5, 0x2f, 0x37, 0xd3, 0x64, 0x95, 0xd4, 0xeb, 0x0d,
-		0x69, 0xfa, 0x4a, 0x49, 0xa1, 0xaf, 0x11, 0x3e, 0x6e, 0x03, 0x92, 0xe7, 0x71, 0xcb, 0xb5, 0x52,
-		0x24, 0x4f, 0x8e, 0xcd, 0x98, 0x87, 0x42, 0xf3, 0x83, 0xec, 0x02, 0xa2, 0x6b, 0x50, 0xf8, 0x3c,
-		0x9b, 0x4b, 0xce, 0x7a, 0x2a, 0x3c, 0x56, 0x59, 0xdd, 0x7d, 0x08, 0x99, 0xee, 0xa4, 0xb6, 0x7b,
-		0x1a, 0x32, 0xdd, 0x45, 0x8d, 0xcd, 0x84, 0x4c, 0xed, 0xc3, 0xa0, 0xe5, 0x1a, 0x96, 0x8d, 0xa6,
-		0x65, 0xa4, 0x71, 0x49, 0xb2, 0x94, 0x9f, 0x79, 0xe2, 0xaf, 0xf5, 0xd6, 0xfb, 0xed, 0xd7, 0x51,
-		0x64, 0xf4, 0x07, 0x25, 0x98, 0xd5, 0xd5, 0xfb, 0xf0, 0xdd, 0xba, 0xc2, 0xfa, 0xcd, 0xff, 0x11,
-		0x53, 0xc2, 0x4f, 0xbb, 0x5d, 0xf9, 0xff, 0xf4, 0xcb, 0xab, 0x6c, 0x9d, 0xbf, 0xb3, 0x59, 0x68,
-		0x43, 0xb7, 0x4c, 0x03, 0xcb, 0x98, 0xca, 0xcb, 0x83, 0x2e, 0x67, 0x6f, 0xdf, 0xed, 0x4e, 0xda,
-		0xd8, 0xfc, 0xa3, 0x50, 0x1d, 0xbc, 0x79, 0x82, 0x3a, 0x81, 0xee, 0xe1, 0x1b, 0x83, 0x28, 0xc1,
-		0x96, 0x17, 0x78, 0x59, 0x4d, 0xfb, 0x88, 0xcd, 0x4a, 0xd4, 0x59, 0xd7, 0x35, 0x44, 0xd4, 0x5e,
-		0xc2, 0x07, 0xd3, 0x66, 0x62, 0xeb, 0x2a, 0x24, 0x95, 0x8f, 0xa5, 0xf6, 0xb4, 0x7d, 0x1d, 0x4e,
-		0x77, 0x98, 0xf2, 0xfb, 0xa6, 0xa7, 0xf6, 0xb5, 0x97, 0x68, 0x62, 0x2d, 0x1f, 0x6a, 0x5b, 0x89,
-		0x1d, 0x86, 0x53, 0xe2, 0x3f, 0xb0, 0x37, 0x1d, 0x8a, 0xe3, 0xea, 0x5a, 0x0f, 0x85, 0xd9, 0xca,
-		0x0c, 0x88, 0xc4, 0x87, 0xa1, 0x6d, 0xdd, 0x0a, 0x6e, 0x91, 0x0d, 0x13, 0x7b, 0xce, 0x32, 0x71,
-		0x96, 0x09, 0xb8, 0x7a, 0x91, 0xfb, 0x8c, 0x5b, 0x56, 0x8f, 0x5f, 0x56, 0x91, 0xd0, 0xb5, 0xd0,
-		0x99, 0xfd, 0xe7, 0x0e, 0x9c, 0x69, 0x75, 0xbe, 0xda, 0x68, 0x7a, 0x68, 0x61, 0x72, 0xd4, 0x3d,
-		0x4e, 0x3a, 0x4a, 0x7d, 0x93, 0x78, 0xd2, 0x1b, 0x30, 0x43, 0x3e, 0x7c, 0x97, 0xd3, 0x34, 0x96,
-		0x97, 0xe6, 0xbf, 0x55, 0xd7, 0xe6, 0x44, 0xd1, 0x5d, 0x99, 0x29, 0x45, 0xc9, 0x9a, 0x2a, 0x45,
-		0xf8, 0xcf, 0x18, 0x85, 0xc0, 0x22, 0x08, 0x31, 0xe7, 0x20, 0xa6, 0x88, 0xe6, 0xc5, 0x66, 0x7b,
-		0xf5, 0xce, 0x76, 0xda, 0x31, 0xfb, 0x5a, 0xe3, 0x07, 0x66, 0xe8, 0x14, 0x97, 0x49, 0x6b, 0x6c,
-		0xbc, 0xb0, 0x26, 0x6c, 0xec, 0xec, 0x9d, 0x1a, 0x94, 0x57, 0x71, 0x49, 0x1f, 0xa1, 0xd9, 0x13,
-		0x54, 0x69, 0x2e, 0x67, 0xce, 0x7c, 0x6a, 0x81, 0xcd, 0x5b, 0x65, 0x6e, 0xb8, 0xdc, 0xb1, 0xc3,
-		0x98, 0x1b, 0x2e, 0x1f, 0xec, 0x91, 0x5b, 0x1c, 0xc6, 0xe0, 0xf8, 0xc7, 0x63, 0x73, 0x54, 0xe3,
-		0xec, 0x26, 0xe0, 0xfa, 0x75, 0xd6, 0x5e, 0x0e, 0xb2, 0xdf, 0xc6, 0xdb, 0xab, 0x82, 0xed, 0x1d,
-		0x9b, 0x77, 0x6c, 0xde, 0xb1, 0xf9, 0x47, 0xc6, 0xe6, 0x5f, 0xb8, 0xac, 0xdf, 0x26, 0x98, 0xbc,
-		0xc2, 0xde, 0x99, 0x73, 0x77, 0x7d, 0xb9, 0x1d, 0x5b, 0xf7, 0x15, 0xbb, 0x8d, 0x23, 0x9c, 0x94,
-		0x83, 0x53, 0x73, 0x74, 0xe1, 0xdf, 0x0a, 0x02, 0x98, 0x80, 0x17, 0x95, 0xa5, 0xcf, 0x46, 0x78,
-		0x89, 0xda, 0x53, 0xa8, 0xc9, 0x04, 0xa4, 0xa7, 0xe3, 0x0b, 0x4b, 0x48, 0xa9, 0xa7, 0xe1, 0x7e,
-		0x43, 0x3a, 0xc3, 0x88, 0x72, 0x20, 0xba, 0x8a, 0x1c, 0xa3, 0xe1, 0x62, 0x05, 0x24, 0x99, 0xcc,
-		0xbe, 0x31, 0x74, 0xb1, 0x06, 0x8d, 0x74, 0x31, 0x8b, 0x87, 0x1a, 0xb3, 0xd8, 0x6f, 0x01, 0x8a,
-		0x3b, 0x44, 0x5e, 0xa9, 0xa3, 0xc4, 0x1d, 0xe8, 0x6a, 0x23, 0x5d, 0x1d, 0xce, 0x39, 0x6d, 0xb2,
-		0x0d, 0xf0, 0xb0, 0x21, 0x59, 0x6a, 0x09, 0x3c, 0x14, 0x0b, 0x46, 0xf1, 0xe4, 0x31, 0xc2, 0xb3,
-		0x1a, 0x84, 0x66, 0xd9, 0x91, 0xdd, 0x49, 0x52, 0x2b, 0xf2, 0x48, 0x5d, 0x78, 0x7c, 0x47, 0xd1,
-		0x11, 0x8d, 0xe1, 0x77, 0xf0, 0xad, 0x4a, 0xce, 0x67, 0xfb, 0xc5, 0x7b, 0x58, 0xf1, 0x8c, 0xe2,
-		0xfb, 0x3b, 0xc8, 0x56, 0x13, 0x54, 0xe4, 0xc0, 0x5a, 0x95, 0x8a, 0x1d, 0x15, 0x93, 0xcc, 0x0c,
-		0x90, 0x59, 0xae, 0xf2, 0xd1, 0x7a, 0xe5, 0xa3, 0x92, 0xb3, 0x59, 0x56, 0xf4, 0xe8, 0xd7, 0xad,
-		0x44, 0x69, 0x96, 0xbe, 0xa9, 0x3b, 0x52, 0x95, 0xe6, 0x6f, 0x6e, 0x6b, 0x5c, 0xb5, 0xc9, 0x86,
-		0xfd, 0x65, 0xbe, 0xda, 0xfe, 0xbf, 0x15, 0x6d, 0xb2, 0x5d, 0x2a, 0xd7, 0xee, 0x54, 0x2e, 0x46,
-		0x29, 0xf6, 0x85, 0x8d, 0x83, 0x63, 0x39, 0xc4, 0xce, 0xc9, 0x21, 0xa9, 0x23, 0xe6, 0x79, 0xbc,
-		0xe5, 0x05, 0xc4, 0xf3, 0xcf, 0x01, 0xbb, 0xa1, 0xce, 0xaf, 0xe0, 0xb0, 0x90, 0x35, 0xbc, 0xd6,
-		0xfb, 0x68, 0x3e, 0x8c, 0xb8, 0xca, 0x25, 0xb1, 0x3d, 0x1c, 0x2b, 0xe3, 0xec, 0x4e, 0xc8, 0xcf,
-		0x88, 0x0b, 0xf8, 0x44, 0xd9, 0x0d, 0xd5, 0xf9, 0xc3, 0x39, 0x2c, 0xf2, 0x0d, 0xe2, 0x60, 0x3c,
-		0xad, 0x3b, 0x2e, 0x8f, 0xe7, 0xb8, 0x04, 0x48, 0x60, 0x0f, 0xd1, 0xc0, 0x93, 0x14, 0xd3, 0x74,
-		0xac, 0xc7, 0xba, 0xea, 0xf3, 0x70, 0x18, 0xfc, 0x75, 0xf6, 0xc5, 0x93, 0x1f, 0x83, 0xf4, 0xe3,
-		0x9d, 0xfe, 0xb8, 0x28, 0x7c, 0x1c, 0x0d, 0x87, 0xdd, 0xe1, 0x30, 0xf8, 0xdb, 0xf1, 0xb7, 0x47,
-		0xbf, 0x7f, 0xfe, 0x30, 0x1c, 0xfe, 0x6d, 0x38, 0xf4, 0xae, 0x0a, 0x57, 0x1c, 0x77, 0x5a, 0xa2,
-		0xf6, 0x36, 0xa6, 0xf3, 0xed, 0xd2, 0xa6, 0x4c, 0x94, 0xbe, 0xdf, 0x36, 0xf7, 0x2e, 0xb5, 0xd0,
-		0xfa, 0xb4, 0x5d, 0x63, 0x52, 0xa0, 0x66, 0x79, 0x69, 0xdd, 0x8e, 0x79, 0x1b, 0xdb, 0x00, 0x19,
-		0xb2, 0x2a, 0x97, 0xd5, 0x7f, 0x17, 0x95, 0x92, 0x02, 0x36, 0x43, 0x84, 0x96, 0xc1, 0x13, 0x5c,
-		0x25, 0xb8, 0x56, 0x55, 0x82, 0x1b, 0x9c, 0x7f, 0xf5, 0x70, 0x4a, 0xc1, 0xed, 0xae, 0x6f, 0x64,
-		0x24, 0x92, 0x3a, 0x47, 0x47, 0x47, 0xcb, 0xd6, 0x03, 0x1f, 0xaf, 0x8e, 0x8a, 0x7d, 0x08, 0xae,
-		0x8e, 0xff, 0xea, 0x9d, 0x3c, 0xef, 0x7f, 0x39, 0xfe, 0x76, 0xf9, 0xfd, 0xd5, 0x70, 0xd8, 0x3d,
-		0x7e, 0x56, 0x65, 0xd4, 0xb7, 0xc7, 0x9f, 0x87, 0xc3, 0x6e, 0xa7, 0x7e, 0x6d, 0x3b, 0x93, 0xce,
-		0x7c, 0xdb, 0x58, 0x73, 0x2b, 0x7b, 0xf3, 0xbd, 0xa7, 0x01, 0x8e, 0x42, 0xb4, 0x70, 0x2d, 0xf9,
-		0x56, 0xd5, 0x01, 0xa3, 0x9e, 0x7c, 0x05, 0x05, 0xc0, 0xbc, 0x29, 0x5f, 0xe2, 0x02, 0x2f, 0x69,
-		0xcd, 0xb7, 0x31, 0xca, 0xb0, 0xa1, 0x41, 0x9f, 0x6a, 0x55, 0x97, 0xb5, 0xae, 0x03, 0x0f, 0xa6,
-		0x38, 0xc2, 0x6b, 0x89, 0x92, 0x1c, 0x50, 0x84, 0x21, 0x24, 0x9f, 0x30, 0x20, 0x08, 0xc9, 0x28,
-		0x42, 0xd1, 0x42, 0xb5, 0xc4, 0x8b, 0x70, 0x0e, 0xde, 0x90, 0x94, 0xf6, 0x52, 0x49, 0x95, 0x0b,
-		0x35, 0x42, 0xe5, 0x67, 0x46, 0xba, 0xd3, 0x5e, 0x52, 0x92, 0xe8, 0xa9, 0xbe, 0xea, 0x29, 0x10,
-		0xca, 0x05, 0xa2, 0x3e, 0xe6, 0x1a, 0xe7, 0xa0, 0xba, 0xdb, 0x21, 0x55, 0x6b, 0x28, 0x44, 0x22,
-		0x6b, 0xb8, 0xc7, 0x97, 0x7d, 0xf4, 0x74, 0xbc, 0x67, 0x48, 0x55, 0x3b, 0xba, 0x19, 0x52, 0x60,
-		0x07, 0x55, 0x9f, 0xe8, 0x66, 0x8a, 0x29, 0xa4, 0xcf, 0xb1, 0xf4, 0xf1, 0x62, 0xde, 0x1d, 0xd2,
-		0x7f, 0xc8, 0xd7, 0xb9, 0xc1, 0x7a, 0x7a, 0x22, 0x00, 0x15, 0xd0, 0xd3, 0x72, 0x6e, 0x04, 0x79,
-		0xcc, 0x9e, 0x7e, 0x18, 0x14, 0x72, 0xb6, 0xa9, 0xab, 0x94, 0x6a, 0x85, 0x37, 0xa4, 0xf9, 0x9b,
-		0x0b, 0x06, 0x28, 0x1a, 0x11, 0x81, 0x22, 0x12, 0x92, 0x70, 0x01, 0x88, 0x73, 0x32, 0xa1, 0x29,
-		0xf4, 0x02, 0x09, 0x88, 0x62, 0xba, 0x41, 0x7d, 0xdf, 0x4f, 0x2b, 0xba, 0x59, 0x0b, 0x5b, 0xd1,
-		0xcd, 0x5c, 0x2b, 0x3a, 0x57, 0xef, 0xd8, 0xb5, 0xa2, 0x6b, 0xb7, 0x47, 0xc7, 0xc1, 0x76, 0x0f,
-		0xe3, 0xd1, 0x71, 0xad, 0xe8, 0xf6, 0x69, 0xc5, 0x54, 0xb7, 0x66, 0x2c, 0x4c, 0xb8, 0x4a, 0xa6,
-		0xdc, 0xda, 0x92, 0xb8, 0x56, 0x74, 0xcd, 0x38, 0x25, 0x5d, 0x2b, 0x3a, 0xd7, 0x8a, 0xce, 0x09,
-		0x2c, 0x53, 0x81, 0xe5, 0x5a, 0xd1, 0x39, 0x99, 0xb6, 0x3f, 0x99, 0xe6, 0x5a, 0xd1, 0xed, 0x2b,
-		0x4c, 0xe5, 0x5a, 0xd1, 0x55, 0x0a, 0xce, 0xa5, 0x19, 0x03, 0xf9, 0x7f, 0xd4, 0x69, 0x4b, 0xf7,
-		0x46, 0xce, 0xf0, 0x31, 0xff, 0xb7, 0x6b, 0x50, 0xe7, 0x3c, 0x19, 0xfb, 0x0f, 0xda, 0xb9, 0xce,
-		0x4d, 0xf7, 0x2b, 0x5e, 0xe7, 0x1a, 0xd4, 0xa5, 0x9c, 0xcd, 0x35, 0xa8, 0x73, 0x6c, 0xce, 0xb1,
-		0xb9, 0x07, 0xca, 0xe6, 0x5c, 0x83, 0x3a, 0x38, 0x50, 0xbb, 0x24, 0xf0, 0x40, 0x6c, 0xea, 0x97,
-		0x44, 0xb8, 0x50, 0x0d, 0x86, 0xd4, 0xa5, 0xf2, 0x5f, 0x5d, 0x78, 0x85, 0xd2, 0x56, 0x44, 0x53,
-		0xc4, 0x8b, 0xe1, 0xce, 0x21, 0x5d, 0x8f, 0x77, 0xb6, 0xa2, 0x23, 0xcb, 0xec, 0x1e, 0x62, 0xb7,
-		0x66, 0x0f, 0xb5, 0x23, 0x4b, 0x0e, 0xe6, 0x97, 0x16, 0x0e, 0x40, 0x13, 0x0c, 0x1e, 0xcc, 0xa7,
-		0x0b, 0x4e, 0x7c, 0x14, 0x6a, 0xea, 0x62, 0x74, 0x37, 0xfa, 0xaf, 0x55, 0xde, 0xc2, 0x9d, 0x14,
-		0x76, 0x4f, 0xbd, 0x85, 0xbb, 0x28, 0xb0, 0x19, 0x6f, 0xa1, 0xab, 0x75, 0xbc, 0x5d, 0xf6, 0xb9,
-		0x5a, 0xc7, 0xeb, 0xee, 0xbf, 0xf3, 0x73, 0xe7, 0xfe, 0x6b, 0x20, 0xf6, 0xe3, 0x4a, 0x4a, 0xba,
-		0x18, 0x50, 0xab, 0xb8, 0xba, 0x8b, 0xeb, 0x3c, 0x6e, 0xc6, 0xee, 0x4a, 0x4a, 0x36, 0xc5, 0xd9,
-		0x5d, 0x49, 0x49, 0xc7, 0xe6, 0x1d, 0x9b, 0x77, 0x6c, 0xde, 0x95, 0x94, 0x7c, 0xb8, 0x4c, 0x3e,
-		0x57, 0x33, 0xdf, 0x9c, 0xc7, 0xe7, 0x07, 0xd9, 0xb1, 0xf8, 0x4b, 0xe0, 0xf3, 0x90, 0x08, 0xa1,
-		0x1b, 0x7b, 0x43, 0x40, 0xae, 0x49, 0x80, 0x8b, 0x5d, 0xaf, 0x58, 0x5a, 0xca, 0x85, 0x27, 0x0d,
-		0xaf, 0x8a, 0x4d, 0x9e, 0x7b, 0x40, 0xa8, 0x6e, 0x4d, 0x5e, 0x6c, 0x69, 0x4d, 0x59, 0xd6, 0x17,
-		0x80, 0xfc, 0x47, 0x55, 0x1a, 0x2b, 0x7d, 0x32, 0x5d, 0x78, 0xcd, 0xe4, 0xac, 0x97, 0x35, 0xb1,
-		0xbc, 0x72, 0x5e, 0x25, 0xe7, 0x55, 0x72, 0x5e, 0x25, 0x27, 0x95, 0x5c, 0x07, 0xad, 0x46, 0xa4,
-		0x52, 0x52, 0xa3, 0xd3, 0xae, 0xd2, 0x31, 0xb7, 0x94, 0x45, 0xb9, 0x3a, 0x6c, 0x9e, 0x14, 0x25,
-		0x1c, 0xab, 0x2c, 0x40, 0x14, 0x86, 0x3a, 0xa3, 0x30, 0x9e, 0xcb, 0x2b, 0x70, 0x90, 0xa4, 0xf2,
-		0x01, 0xa3, 0x3a, 0xac, 0x25, 0xbf, 0xd5, 0x89, 0x77, 0x2b, 0x01, 0xae, 0xb9, 0xda, 0x4b, 0x1f,
-		0x85, 0x43, 0x2a, 0x4d, 0x97, 0xb4, 0xec, 0x80, 0x2a, 0xf8, 0xa9, 0xc3, 0x5d, 0x6f, 0x91, 0x98,
-		0xe2, 0x48, 0x37, 0x45, 0x8a, 0x39, 0xa1, 0x13, 0xc0, 0x34, 0x9e, 0x01, 0x51, 0x12, 0x4c, 0x7f,
-		0x81, 0x28, 0xbc, 0x56, 0x31, 0x0d, 0xb1, 0xd0, 0x37, 0xb9, 0x21, 0x61, 0x08, 0xc8, 0xf7, 0xf1,
-		0x5c, 0x00, 0xa2, 0x8b, 0x21, 0x4d, 0x7f, 0x56, 0xe9, 0x7d, 0x72, 0xe0, 0x08, 0x71, 0x1c, 0x00,
-		0x1b, 0x8f, 0x93, 0x94, 0x45, 0xf9, 0xb8, 0x4f, 0x93, 0x02, 0x08, 0x42, 0xe5, 0xf9, 0xe4, 0x40,
-		0x66, 0x62, 0x31, 0xbf, 0x1f, 0x49, 0x3e, 0x4e, 0x5e, 0x1d, 0x46, 0x5e, 0xa5, 0x74, 0x62, 0x59,
-		0x15, 0xd9, 0x44, 0x6c, 0xa5, 0x94, 0xfa, 0x3d, 0xe2, 0x16, 0x5b, 0x64, 0x53, 0xbe, 0xbc, 0xc8,
-		0x80, 0xb9, 0xb1, 0x88, 0xb4, 0x13, 0x93, 0xeb, 0x8f, 0xe5, 0xf5, 0x7b, 0xbd, 0x49, 0x67, 0x1f,
-		0xf2, 0xa7, 0xe6, 0x53, 0xb5, 0xf1, 0xa1, 0x5a, 0xf8, 0x4c, 0x03, 0xef, 0xbc, 0x8d, 0x4f, 0xd5,
-		0xc6, 0x87, 0x3a, 0x6b, 0x25, 0xa5, 0x9f, 0xb5, 0xf1, 0xa1, 0xda, 0xb8, 0x7d, 0xc6, 0x55, 0xbe,
-		0xed, 0xf4, 0xc2, 0xab, 0x3d, 0xcb, 0x31, 0xa3, 0xbc, 0x80, 0xbc, 0x19, 0x93, 0x47, 0xfd, 0x9b,
-		0x41, 0xc8, 0x2a, 0xe7, 0x09, 0x64, 0x93, 0x18, 0xe5, 0x0b, 0x18, 0x68, 0xc2, 0x35, 0x6b, 0xdf,
-		0xba, 0xdc, 0x8a, 0xcc, 0x6e, 0xb0, 0xc9, 0xad, 0xa8, 0x58, 0xf8, 0x76, 0x3d, 0xad, 0xa2, 0x76,
-		0x09, 0xdc, 0x62, 0xbd, 0x79, 0xe3, 0x92, 0x68, 0xd9, 0x80, 0x07, 0x54, 0x18, 0xed, 0x51, 0x83,
-		0xeb, 0xcc, 0xc1, 0xc7, 0xe6, 0x99, 0xf9, 0x0e, 0x85, 0xdc, 0xb2, 0x64, 0x8b, 0x9e, 0x2b, 0x8e,
-		0x96, 0xbf, 0xb0, 0x72, 0x4a, 0x7c, 0xf5, 0x54, 0xf8, 0x7d, 0xd4, 0x40, 0xdb, 0xc2, 0x8b, 0x5d,
-		0x25, 0xb4, 0x9a, 0xfa, 0xc3, 0x81, 0x2a, 0xa1, 0x6d, 0xd0, 0x0f, 0x2c, 0xaa, 0xa2, 0xad, 0xa9,
-		0x04, 0x26, 0xf5, 0xd1, 0xae, 0xf1, 0x94, 0xf8, 0x1b, 0x1a, 0xe2, 0x2c, 0xcb, 0x24, 0x24, 0x17,
-		0x94, 0x55, 0x45, 0x53, 0xb8, 0x7a, 0x36, 0x86, 0xe4, 0x7a, 0x7e, 0x88, 0xda, 0x5d, 0xa2, 0xef,
-		0xb7, 0xaf, 0x78, 0x97, 0x7c, 0xa8, 0xa6, 0xaa, 0x77, 0x99, 0xa8, 0x61, 0xc6, 0x9a, 0xd7, 0x25,
-		0x6c, 0xd7, 0xbd, 0x92, 0x5d, 0xd3, 0x2e, 0x68, 0x05, 0x79, 0x61, 0x63, 0x78, 0xff, 0xfe, 0xf5,
-		0x4b, 0x5d, 0xdb, 0x8e, 0x4f, 0x59, 0x1c, 0x06, 0x30, 0x8f, 0xd8, 0x6c, 0x2e, 0xd4, 0x25, 0xef,
-		0x5f, 0x83, 0x60, 0x30, 0x45, 0xd7, 0x18, 0xd0, 0x90, 0xfe, 0x84, 0x29, 0x8e, 0x90, 0xc0, 0x7a,
-		0xc8, 0x28, 0x16, 0x62, 0x7b, 0xa6, 0xed, 0x21, 0x15, 0xb9, 0xcd, 0x04, 0xd2, 0x72, 0x4d, 0x6e,
-		0x23, 0x01, 0xed, 0x5b, 0x95, 0x8b, 0x63, 0x12, 0xd4, 0xd5, 0xdf, 0xcc, 0xa5, 0x6d, 0xcf, 0xfb,
-		0x1a, 0x79, 0xe3, 0x4b, 0xef, 0xc7, 0xab, 0xbf, 0x5e, 0x7c, 0xf1, 0xf2, 0xff, 0x3c, 0xb3, 0xf9,
-		0x67, 0x7f, 0xf0, 0xa5, 0xb3, 0xa7, 0xf4, 0xa4, 0x1b, 0x1c, 0x79, 0xfe, 0x94, 0x11, 0x1f, 0x9b,
-		0xa4, 0x29, 0xe5, 0xae, 0x36, 0xad, 0x9d, 0xa7, 0x2f, 0xd7, 0x25, 0x1b, 0x6f, 0x70, 0x04, 0x2a,
-		0x22, 0x02, 0x5e, 0x31, 0x9a, 0x93, 0x5c, 0x44, 0x28, 0x27, 0x01, 0x56, 0x2c, 0xb6, 0xe4, 0x54,
-		0x9d, 0xbb, 0x53, 0xb5, 0xff, 0x53, 0x55, 0x9a, 0x7d, 0xa4, 0xda, 0xea, 0x45, 0xc4, 0xf7, 0x7c,
-		0x93, 0x90, 0x43, 0xb6, 0xae, 0xc5, 0x61, 0x76, 0x61, 0xc4, 0x57, 0xc9, 0x58, 0x4d, 0x4d, 0x38,
-		0x80, 0xcd, 0x72, 0x7b, 0x1b, 0xdd, 0x9c, 0xdd, 0x65, 0x8c, 0x6d, 0x37, 0x3d, 0xdd, 0xd3, 0x20,
-		0xdb, 0x4e, 0x7a, 0x6b, 0xc6, 0x3b, 0x59, 0x46, 0x87, 0xd9, 0x85, 0x23, 0xc5, 0x98, 0x17, 0xf6,
-		0xc1, 0xaf, 0x74, 0xa0, 0xe1, 0xfb, 0xaf, 0xd0, 0xe4, 0xf7, 0x7a, 0x74, 0x56, 0x66, 0x16, 0xd9,
-		0xf4, 0xf3, 0xdc, 0x9d, 0x4c, 0x59, 0x99, 0x40, 0xab, 0x10, 0x6a, 0x45, 0x82, 0xad, 0x4a, 0xb8,
-		0xb5, 0x09, 0xb8, 0x36, 0x21, 0x57, 0x27, 0x68, 0x3b, 0xf7, 0xfd, 0x17, 0x53, 0xba, 0x32, 0x24,
-		0xf4, 0x6c, 0x80, 0x8f, 0xe6, 0xc8, 0x27, 0x62, 0x51, 0xbd, 0xc9, 0x60, 0x36, 0x83, 0xe5, 0x8a,
-		0x6d, 0x28, 0x18, 0x37, 0x4a, 0x8f, 0x41, 0xc5, 0x29, 0xcd, 0x60, 0x10, 0xb5, 0x4f, 0x44, 0x9d,
-		0x93, 0x51, 0xf3, 0x84, 0xd4, 0x3d, 0x29, 0x8d, 0x9d, 0x98, 0xc6, 0x4e, 0x4e, 0xfd, 0x13, 0x64,
-		0x77, 0x92, 0x2a, 0xc4, 0xf3, 0xc0, 0xb2, 0xb8, 0x9d, 0xbd, 0x71, 0x51, 0x6e, 0x74, 0x10, 0x2a,
-		0xfa, 0xcf, 0xab, 0x10, 0x4a, 0x72, 0x26, 0x9e, 0x57, 0x18, 0x6a, 0x87, 0x62, 0xb4, 0x77, 0x3e,
-		0x37, 0xe2, 0x94, 0x2e, 0xf5, 0xe0, 0xf6, 0x4e, 0xea, 0xcd, 0x53, 0x17, 0xf1, 0x57, 0xdf, 0xdf,
-		0xdb, 0x10, 0x09, 0x57, 0x76, 0x86, 0x97, 0x2e, 0xf1, 0xf3, 0xf3, 0xf3, 0xaf, 0xce, 0x1f, 0xee,
-		0x32, 0x3f, 0x39, 0xcc, 0xa8, 0xab, 0x16, 0xf4, 0x1d, 0x9e, 0x21, 0x81, 0x23, 0x82, 0xc2, 0xea,
-		0xea, 0x40, 0x36, 0x43, 0x3d, 0x75, 0x20, 0xd5, 0x88, 0xd3, 0xe9, 0xd2, 0xde, 0x11, 0x37, 0x58,
-		0x9a, 0xfa, 0x8c, 0x67, 0x09, 0x67, 0x88, 0x2a, 0x4c, 0x26, 0xd6, 0x3a, 0xb3, 0xea, 0xfb, 0x80,
-		0x51, 0x30, 0xa4, 0x6c, 0x0c, 0x99, 0xeb, 0xe0, 0x86, 0x88, 0x29, 0x48, 0x53, 0x91, 0x03, 0xd2,
-		0x59, 0x08, 0xa9, 0xbb, 0x80, 0xc3, 0xb5, 0xbc, 0x0b, 0x27, 0xb3, 0x79, 0x98, 0x5c, 0x47, 0x19,
-		0xfc, 0x19, 0xa3, 0x50, 0x79, 0xfd, 0xb8, 0xd3, 0x40, 0x9c, 0x06, 0xd2, 0x16, 0x0d, 0xa4, 0xbe,
-		0x16, 0x91, 0x3b, 0x28, 0x35, 0x54, 0x89, 0xfe, 0x59, 0x85, 0xb1, 0xaf, 0x68, 0x3c, 0xab, 0x4e,
-		0x2b, 0xef, 0xd8, 0x6f, 0x3a, 0x7d, 0xb0, 0x8e, 0xc8, 0xea, 0xf4, 0xe4, 0x1a, 0x30, 0x31, 0x35,
-		0xaa, 0x8c, 0xbf, 0x75, 0x96, 0xbe, 0x2a, 0x6b, 0x40, 0xc4, 0x94, 0xc4, 0x33, 0xaf, 0xda, 0x4a,
-		0x66, 0x73, 0x0d, 0xf2, 0x73, 0xcd, 0x59, 0xb8, 0x98, 0xe1, 0xa8, 0xf3, 0xe4, 0x80, 0xba, 0x40,
-		0xe7, 0x1d, 0x7b, 0x4d, 0x45, 0xbd, 0x65, 0xcd, 0xaf, 0x85, 0xb5, 0x6e, 0xbc, 0x71, 0xa6, 0x74,
-		0x25, 0xb6, 0xf6, 0x71, 0x36, 0x9a, 0x4d, 0xef, 0xf4, 0x05, 0xf4, 0x0e, 0x24, 0xbf, 0xbf, 0xdc,
-		0x6d, 0xab, 0x77, 0x53, 0xcf, 0x9b, 0x19, 0x34, 0x6b, 0x6d, 0xdc, 0x86, 0x50, 0xac, 0xc0, 0x5c,
-		0xf4, 0x13, 0x6f, 0xfe, 0x69, 0xe2, 0x45, 0x3d, 0xcd, 0xbb, 0xf8, 0x4f, 0x0b, 0x7e, 0xda, 0x53,
-		0x3b, 0x1f, 0x19, 0x6c, 0x0c, 0xe0, 0xbe, 0x93, 0xf7, 0xfc, 0x41, 0xcd, 0xfe, 0xf1, 0xdf, 0xfa,
-		0x96, 0x1f, 0x13, 0x5d, 0xa1, 0xd3, 0x50, 0x82, 0x8c, 0x09, 0x96, 0x24, 0x7b, 0xb1, 0x19, 0x13,
-		0x2c, 0xb2, 0x77, 0x17, 0xae, 0x8c, 0xaf, 0xe6, 0x35, 0x7c, 0x23, 0xc7, 0x16, 0x7d, 0x86, 0xab,
-		0x61, 0x91, 0xa4, 0x8a, 0x5b, 0x12, 0x16, 0x49, 0x15, 0x22, 0xe7, 0x59, 0x74, 0x9e, 0xc5, 0xbd,
-		0x7b, 0x16, 0x15, 0x65, 0x9b, 0x15, 0xa3, 0xd8, 0x6e, 0x4c, 0x2c, 0xe7, 0xa8, 0x67, 0x4e, 0xbc,
-		0x9b, 0x62, 0x5d, 0xa6, 0x22, 0x29, 0x3a, 0xa7, 0x26, 0xee, 0xc2, 0xab, 0x5b, 0x24, 0x15, 0x7f,
-		0xdd, 0x80, 0xee, 0xa9, 0xea, 0x5d, 0xaa, 0x7e, 0x79, 0x0a, 0x2c, 0x82, 0xa7, 0xe3, 0x88, 0x51,
-		0xf1, 0x14, 0xb0, 0xf0, 0xbb, 0xce, 0x0e, 0x70, 0x76, 0xc0, 0xc3, 0xb1, 0x03, 0x8c, 0x6b, 0x71,
-		0x6c, 0x95, 0x08, 0x2f, 0x2a, 0x8c, 0xb5, 0xac, 0xd5, 0xd1, 0x56, 0x7f, 0x62, 0xdf, 0xf9, 0x13,
-		0xf7, 0xed, 0x4f, 0x1c, 0xf4, 0x9c, 0x33, 0xf1, 0x21, 0x38, 0x13, 0x95, 0xf4, 0x56, 0x06, 0x41,
-		0x5d, 0x15, 0x40, 0x4f, 0x52, 0x4f, 0x07, 0x50, 0x33, 0x41, 0xa5, 0x99, 0x9c, 0x38, 0x77, 0xe2,
-		0xbc, 0xbd, 0xe2, 0xdc, 0x05, 0x07, 0x2b, 0x4b, 0x1a, 0x17, 0x1c, 0xdc, 0xbb, 0x30, 0x77, 0xc1,
-		0xc1, 0x56, 0xcb, 0xf3, 0x46, 0x9d, 0x04, 0x49, 0x8e, 0x8d, 0xb5, 0xd9, 0x6e, 0x97, 0x3e, 0x9c,
-		0x67, 0x05, 0x76, 0x69, 0xc4, 0x79, 0x0a, 0xaf, 0x9d, 0x4e, 0x9c, 0x4d, 0x66, 0x95, 0x56, 0x7c,
-		0x8f, 0xfc, 0xb5, 0x95, 0x9c, 0x94, 0xe6, 0x6e, 0xdb, 0x14, 0x88, 0xab, 0xdc, 0x98, 0x8d, 0x39,
-		0x6f, 0x6b, 0xe1, 0x44, 0x2d, 0x17, 0xbb, 0x81, 0x45, 0xee, 0xec, 0xa1, 0x1a, 0x13, 0xf1, 0xb1,
-		0x25, 0x9e, 0x3a, 0x1b, 0x61, 0x07, 0xa5, 0x7e, 0x4d, 0x05, 0x8e, 0x28, 0x0a, 0xe1, 0x07, 0x36,
-		0x1b, 0xc5, 0x5c, 0x39, 0xa0, 0x5f, 0xd1, 0x09, 0xa1, 0x0e, 0x4c, 0xed, 0xc0, 0xd4, 0xd0, 0xf1,
-		0xe3, 0x91, 0xa2, 0x72, 0x4b, 0x84, 0xe9, 0x12, 0x59, 0x5a, 0x1c, 0x5f, 0x2d, 0x48, 0xa2, 0xe9,
-		0x11, 0x38, 0xf9, 0x8f, 0x6d, 0xd8, 0xa3, 0xe7, 0xc2, 0x1e, 0x2e, 0xec, 0x51, 0x01, 0xf6, 0x69,
-		0x6f, 0xd1, 0x55, 0xb7, 0xe4, 0x2a, 0x58, 0x70, 0x15, 0x2d, 0xb7, 0x0a, 0xf6, 0x69, 0x1d, 0x4b,
-		0xad, 0xae, 0x85, 0xd6, 0x98, 0xc9, 0x50, 0xdf, 0x54, 0xa8, 0x60, 0x89, 0xd5, 0xb2, 0xc0, 0x9a,
-		0xb0, 0xbc, 0xda, 0xb4, 0x7c, 0x7b, 0xb2, 0x7c, 0xae, 0x0e, 0x09, 0x17, 0x50, 0x42, 0xc8, 0x9b,
-		0x33, 0x4e, 0xac, 0x34, 0xf9, 0x1c, 0x72, 0xab, 0x38, 0x41, 0x2d, 0x59, 0x98, 0xce, 0xb2, 0xad,
-		0xef, 0x1b, 0x46, 0x63, 0x40, 0x21, 0xa3, 0x93, 0x02, 0x68, 0x40, 0x55, 0x77, 0x26, 0x3c, 0xa9,
-		0x6f, 0x39, 0x9f, 0x63, 0x14, 0x01, 0xd2, 0x29, 0xce, 0x1c, 0xcd, 0x30, 0x84, 0xf8, 0x1a, 0x87,
-		0x19, 0xbe, 0x12, 0x71, 0x0c, 0x98, 0x46, 0x82, 0x60, 0xae, 0xaa, 0x44, 0x4f, 0xf1, 0x90, 0x8e,
-		0x89, 0xd4, 0x15, 0x59, 0x2c, 0xe6, 0xb1, 0x70, 0xb2, 0xd8, 0xc9, 0x62, 0x5b, 0x59, 0x5c, 0x5d,
-		0xae, 0x56, 0x03, 0x3e, 0x56, 0x01, 0x3c, 0x56, 0x03, 0x3a, 0xd6, 0x03, 0x38, 0x6a, 0x60, 0xa3,
-		0x42, 0x2b, 0x54, 0x71, 0x02, 0x2b, 0x40, 0xe3, 0x8c, 0x04, 0x55, 0xc6, 0x2a, 0x00, 0x63, 0x84,
-		0x91, 0x25, 0x6a, 0xd1, 0xda, 0x47, 0x5e, 0x19, 0xa5, 0x98, 0x2c, 0x4b, 0x25, 0xf9, 0xa5, 0x16,
-		0xa5, 0x52, 0xc0, 0x57, 0x2f, 0xc9, 0x05, 0x0c, 0xee, 0xd6, 0x6b, 0xd7, 0x88, 0xec, 0x1a, 0xc7,
-		0x38, 0x2c, 0x4b, 0xf0, 0xdf, 0x7a, 0xf0, 0xf2, 0x83, 0xab, 0xc9, 0xac, 0x1f, 0x63, 0x1c, 0xaa,
-		0x94, 0xff, 0xdd, 0x19, 0xff, 0x15, 0xa1, 0x6d, 0xe7, 0x4e, 0xae, 0x38, 0xb9, 0x62, 0x0d, 0x6d,
-		0x0b, 0x08, 0xe6, 0x92, 0xae, 0x6d, 0xca, 0x24, 0xaf, 0xed, 0x72, 0x7e, 0x92, 0x7a, 0x81, 0xed,
-		0x97, 0x6a, 0x26, 0xa8, 0x32, 0x93, 0x99, 0x1f, 0xae, 0xf6, 0x71, 0xa8, 0x73, 0x2c, 0x6a, 0x1e,
-		0x8f, 0xba, 0xc7, 0xa4, 0xb1, 0xe3, 0xd2, 0xd8, 0xb1, 0xa9, 0x7f, 0x7c, 0x2a, 0x9a, 0x56, 0xb6,
-		0x54, 0x4a, 0xa2, 0x6a, 0x1b, 0x3d, 0x43, 0xb7, 0xde, 0x88, 0x30, 0x2f, 0x39, 0x21, 0x73, 0x1c,
-		0xf9, 0xb8, 0x46, 0x92, 0x42, 0xa1, 0x0d, 0xce, 0x86, 0x79, 0x2b, 0xae, 0xfe, 0x06, 0x84, 0xe9,
-		0x0c, 0xdd, 0x92, 0x59, 0x3c, 0x83, 0x64, 0x66, 0x60, 0x63, 0x58, 0xde, 0x4f, 0xd9, 0x45, 0xda,
-		0x8e, 0x53, 0xbd, 0x74, 0x74, 0x8b, 0x80, 0xaa, 0x77, 0xaf, 0x86, 0x48, 0xa9, 0x7d, 0x80, 0x9b,
-		0x38, 0xc8, 0x0d, 0x1d, 0xe8, 0xa6, 0x0e, 0x76, 0xe3, 0x07, 0xbc, 0xf1, 0x83, 0xde, 0xdc, 0x81,
-		0xaf, 0x76, 0xf0, 0x6b, 0x38, 0xb2, 0xa0, 0x66, 0xea, 0x7c, 0x75, 0xdb, 0x6f, 0xa7, 0x8f, 0xf5,
-		0x45, 0x1d, 0x7a, 0xb3, 0x53, 0x1d, 0x1b, 0x74, 0xc1, 0xd6, 0x77, 0xc9, 0x36, 0xea, 0xa2, 0x6d,
-		0xda, 0x65, 0xbb, 0x37, 0x1f, 0x64, 0xf3, 0x3e, 0xc9, 0x86, 0x4e, 0x46, 0x63, 0x2e, 0xdf, 0xad,
-		0x5b, 0x61, 0x5c, 0xbe, 0xf6, 0x21, 0x6d, 0xc6, 0x93, 0xbb, 0x19, 0x7d, 0xd5, 0xce, 0x3c, 0x43,
-		0x5b, 0x9b, 0xac, 0x1a, 0xde, 0x24, 0x1b, 0x5f, 0x09, 0x12, 0x91, 0xe2, 0x0f, 0x4e, 0x73, 0xfe,
-		0x82, 0xd3, 0xbc, 0x81, 0xd4, 0x02, 0x34, 0xf3, 0x04, 0x71, 0x16, 0x12, 0x8a, 0x6b, 0x9a, 0x7d,
-		0xc5, 0x69, 0xea, 0x19, 0x7e, 0x3f, 0x25, 0x73, 0x9d, 0xfe, 0x8a, 0x45, 0xc4, 0x9c, 0x05, 0xe8,
-		0x2c, 0xc0, 0x07, 0x64, 0x01, 0x26, 0x46, 0x94, 0x87, 0xc5, 0x14, 0x51, 0x16, 0x36, 0x63, 0xfe,
-		0xad, 0x4e, 0xda, 0xa8, 0xed, 0x97, 0xda, 0x7d, 0x68, 0x82, 0x21, 0xb9, 0xc1, 0x66, 0xa3, 0xaf,
-		0x0b, 0xb8, 0x3b, 0xe9, 0xc2, 0xab, 0x17, 0xe7, 0xf0, 0x0d, 0xbc, 0x38, 0xff, 0xbf, 0xce, 0x08,
-		0x74, 0x46, 0xe0, 0x43, 0x37, 0x02, 0x9d, 0x01, 0xe7, 0x0c, 0x38, 0x67, 0xc0, 0x39, 0x03, 0xce,
-		0x19, 0x70, 0x95, 0x00, 0x61, 0xcc, 0x17, 0x88, 0x62, 0x6f, 0x56, 0x07, 0x52, 0x97, 0x32, 0xd0,
-		0xdc, 0x5c, 0xcd, 0xe8, 0x3f, 0x6f, 0x08, 0x55, 0x7e, 0xef, 0xcb, 0x7f, 0xbe, 0x86, 0x5f, 0xd4,
-		0xe4, 0xf0, 0x16, 0x89, 0x6a, 0x29, 0xe6, 0x4e, 0xb1, 0x71, 0x8a, 0x8d, 0xf3, 0x6e, 0x3b, 0xe5,
-		0xa8, 0xb5, 0xca, 0xd1, 0x8b, 0x73, 0xa7, 0x1d, 0xb5, 0x45, 0x3b, 0xfa, 0xfa, 0xcc, 0x29, 0x47,
-		0x0f, 0x4d, 0x39, 0x7a, 0x24, 0xde, 0xed, 0xa2, 0x1f, 0xb8, 0x05, 0xfe, 0xed, 0xb4, 0x5c, 0x62,
-		0x45, 0xbf, 0x76, 0x95, 0xba, 0x9a, 0xab, 0x7a, 0xe4, 0x2f, 0x72, 0x0e, 0x90, 0xab, 0xe4, 0xbc,
-		0xd8, 0xfb, 0x52, 0x08, 0x9d, 0x17, 0xfb, 0x60, 0x5e, 0x6c, 0x75, 0x24, 0x3c, 0x75, 0xe8, 0x2b,
-		0x95, 0xc1, 0xdb, 0x7c, 0xc6, 0x72, 0x13, 0x36, 0x63, 0xbd, 0x2d, 0x4f, 0x9d, 0xc6, 0xd9, 0x2a,
-		0xdf, 0xf4, 0x3f, 0x16, 0x41, 0xc4, 0x26, 0x98, 0x76, 0x87, 0xf4, 0xb5, 0x00, 0xc2, 0x61, 0x84,
-		0xb9, 0x80, 0xb9, 0x12, 0x93, 0xbe, 0x2a, 0xbe, 0x3d, 0x21, 0xd7, 0x18, 0x90, 0xae, 0xaa, 0x27,
-		0xa6, 0x48, 0x00, 0x9f, 0xb2, 0x9b, 0x5c, 0xf6, 0x87, 0xfc, 0xee, 0xa9, 0x1c, 0x87, 0xe9, 0x90,
-		0xfa, 0x53, 0xc6, 0x31, 0xed, 0xc2, 0x7b, 0x4e, 0xe8, 0x04, 0x90, 0x2e, 0xb8, 0xbd, 0x80, 0xa7,
-		0x72, 0xf4, 0xd3, 0xa4, 0xb2, 0xb7, 0x6a, 0x9c, 0x47, 0x99, 0x48, 0x66, 0xa6, 0x40, 0xa8, 0x88,
-		0x89, 0x14, 0x9e, 0x30, 0x25, 0x54, 0xa8, 0x92, 0xde, 0x37, 0x53, 0xe2, 0xeb, 0x52, 0xde, 0x70,
-		0x83, 0xe4, 0xe4, 0x72, 0x3a, 0xae, 0x32, 0xaa, 0x71, 0x70, 0x02, 0x9c, 0x50, 0x3f, 0x2b, 0xee,
-		0x8d, 0x68, 0xa0, 0xaf, 0x44, 0x11, 0x06, 0x36, 0x23, 0x42, 0xe0, 0x60, 0x48, 0xc7, 0x11, 0x9b,
-		0xa9, 0x87, 0xb4, 0xca, 0x43, 0x71, 0x16, 0xaa, 0xb3, 0x50, 0x9d, 0x85, 0xda, 0x08, 0xb5, 0x55,
-		0x2e, 0x3e, 0xb8, 0x7a, 0xf6, 0xfa, 0x2f, 0x9c, 0x4a, 0xda, 0xa8, 0x4a, 0xaa, 0x55, 0x38, 0x57,
-		0xc5, 0xda, 0x64, 0xb1, 0x1e, 0x51, 0xbd, 0x92, 0xac, 0x38, 0x48, 0xd5, 0x52, 0x25, 0x56, 0x2d,
-		0x23, 0x0d, 0x5f, 0xa9, 0xd2, 0xab, 0x74, 0x6a, 0x34, 0x3a, 0x8d, 0x69, 0x20, 0xe7, 0x41, 0x51,
-		0x44, 0xd0, 0xc4, 0xa0, 0xd5, 0xe9, 0xca, 0xf5, 0x66, 0xcd, 0x4e, 0xdf, 0x25, 0x6e, 0x08, 0x08,
-		0xb0, 0x40, 0x24, 0xe4, 0x25, 0x3d, 0x4c, 0xfb, 0xae, 0x87, 0x69, 0x15, 0xe9, 0xdc, 0x70, 0x0f,
-		0x53, 0x14, 0x09, 0xe2, 0xc7, 0x21, 0x12, 0x38, 0x30, 0xaf, 0xb8, 0x93, 0x1f, 0x64, 0x59, 0x74,
-		0x87, 0x67, 0x3d, 0xa8, 0x73, 0x93, 0x7c, 0x5b, 0x3e, 0xcb, 0x18, 0xc5, 0xa1, 0x30, 0x72, 0xcb,
-		0x76, 0x94, 0xab, 0x6a, 0xf7, 0x79, 0xbf, 0x32, 0xab, 0xf0, 0xd3, 0x73, 0x15, 0x7e, 0xea, 0x2b,
-		0xa4, 0x87, 0xae, 0xf0, 0x63, 0xac, 0xec, 0x2d, 0xdb, 0x9f, 0x32, 0x16, 0x62, 0x64, 0x12, 0x4f,
-		0xcc, 0x78, 0x57, 0x7f, 0x0f, 0x95, 0xaf, 0x44, 0xc2, 0x3f, 0x4d, 0xf3, 0x55, 0x97, 0x94, 0xb2,
-		0x32, 0xd0, 0xee, 0x48, 0x2e, 0x3b, 0x54, 0xa7, 0xf3, 0x6c, 0x2c, 0xad, 0x90, 0xeb, 0x46, 0x45,
-		0x28, 0x20, 0xf0, 0x19, 0x15, 0x88, 0x50, 0x1c, 0x0d, 0xe9, 0x14, 0x85, 0x63, 0x4f, 0x0e, 0xfe,
-		0x24, 0x6d, 0xed, 0xc4, 0x58, 0x55, 0x3d, 0xe7, 0x47, 0x11, 0xbe, 0x26, 0x62, 0xd1, 0x1d, 0xd2,
-		0xcb, 0x5c, 0xd7, 0xaa, 0x58, 0x1a, 0xc0, 0xca, 0x6c, 0x5e, 0xe9, 0x7f, 0xb5, 0xb4, 0xa2, 0xe7,
-		0x11, 0xbb, 0x26, 0x01, 0x06, 0x4c, 0x59, 0x3c, 0x99, 0xe6, 0x7a, 0x5a, 0xe5, 0xa7, 0xf2, 0x11,
-		0x4d, 0x1f, 0x03, 0x10, 0x5d, 0xc0, 0x7f, 0x5f, 0xfe, 0xeb, 0x27, 0xc0, 0x54, 0x10, 0xb1, 0x80,
-		0x11, 0xa6, 0x18, 0x89, 0x29, 0x10, 0x21, 0xcd, 0x7d, 0xf9, 0x6b, 0x80, 0xe7, 0x62, 0x0a, 0x9e,
-		0xea, 0x29, 0x71, 0xb2, 0x7c, 0xfc, 0x13, 0x55, 0x32, 0xa2, 0xdb, 0xcd, 0x7c, 0x05, 0x13, 0xc6,
-		0x82, 0x82, 0xaf, 0x40, 0x39, 0x09, 0xd0, 0x32, 0x65, 0x57, 0x19, 0xe0, 0x63, 0x16, 0xcd, 0x90,
-		0x80, 0x67, 0xc9, 0x9a, 0xcb, 0xeb, 0x66, 0xe8, 0x13, 0x96, 0xf7, 0xc3, 0x88, 0x13, 0x1c, 0x81,
-		0x60, 0x43, 0x9a, 0xb4, 0xe0, 0x5f, 0xa4, 0xa3, 0x8c, 0xcc, 0x76, 0xc3, 0x28, 0x96, 0xe3, 0x3e,
-		0x6d, 0xe4, 0x3e, 0xc6, 0xf5, 0xc5, 0xd4, 0x79, 0xe1, 0x76, 0x78, 0xec, 0xc2, 0x89, 0x4f, 0x07,
-		0x57, 0xcb, 0x4c, 0x7f, 0x4d, 0xb5, 0x7b, 0x89, 0x08, 0x98, 0x22, 0x0e, 0x7a, 0x42, 0xcb, 0xfc,
-		0xf3, 0x33, 0x97, 0x7f, 0xee, 0xf2, 0xcf, 0xad, 0xf3, 0xcf, 0x69, 0x3c, 0x1b, 0xe1, 0xc8, 0x4b,
-		0x28, 0xae, 0x72, 0xc8, 0xa6, 0x38, 0x4d, 0xbd, 0xd0, 0x8d, 0x9e, 0x2b, 0x15, 0x81, 0x55, 0xa6,
-		0x33, 0x57, 0x4f, 0xd7, 0x06, 0x0f, 0xec, 0x9c, 0x16, 0x57, 0xae, 0xfa, 0xbb, 0x0b, 0x2e, 0xb5,
-		0xc4, 0xfb, 0xd7, 0x4c, 0xf5, 0xf7, 0x17, 0x35, 0x8a, 0xbf, 0x9f, 0x3f, 0xda, 0xe2, 0xef, 0xae,
-		0x93, 0xcb, 0xe6, 0x68, 0x41, 0x93, 0xc5, 0xdf, 0x5d, 0xe1, 0xf7, 0x9a, 0xa3, 0xda, 0xd0, 0xc8,
-		0x45, 0x89, 0x74, 0x4f, 0x54, 0x61, 0x55, 0x45, 0x7d, 0x5b, 0xcf, 0x51, 0xb3, 0x95, 0x9b, 0xb2,
-		0x92, 0xab, 0xb6, 0x99, 0x76, 0xb2, 0xdc, 0xc9, 0xf2, 0x7d, 0xc9, 0xf2, 0x1a, 0x71, 0x56, 0xd7,
-		0xdc, 0xb9, 0x91, 0xe6, 0xce, 0x5c, 0x60, 0x1c, 0xd6, 0x6e, 0xee, 0x1c, 0xc5, 0xa3, 0xd1, 0x3d,
-		0xec, 0xc3, 0x9c, 0x3c, 0x76, 0xbd, 0x16, 0xcc, 0x7a, 0x05, 0x5d, 0xd3, 0xe4, 0x15, 0xc6, 0xb3,
-		0xe7, 0x70, 0x73, 0x31, 0x58, 0x78, 0xba, 0xe2, 0x8d, 0x3e, 0xcd, 0xfb, 0xaa, 0x0e, 0x58, 0xf9,
-		0xf1, 0x66, 0x8a, 0x71, 0x58, 0xd5, 0xbf, 0x96, 0x1f, 0x5c, 0xb1, 0xf2, 0x23, 0x8b, 0x94, 0xc7,
-		0x57, 0xcf, 0xa4, 0x7c, 0x6d, 0xa5, 0x0e, 0x66, 0xc4, 0x31, 0x84, 0xe4, 0x13, 0x86, 0x67, 0x9e,
-		0x06, 0xa1, 0x6d, 0xf0, 0x29, 0x43, 0xea, 0x52, 0x1e, 0xd2, 0x82, 0x4f, 0xb9, 0x3b, 0xa4, 0xaf,
-		0x50, 0x8a, 0x2e, 0xf3, 0x11, 0x85, 0x29, 0x52, 0x38, 0xb4, 0x05, 0x2c, 0x9d, 0x1b, 0x21, 0x46,
-		0x63, 0x9e, 0x73, 0x7d, 0x73, 0x60, 0x91, 0x72, 0x87, 0xf3, 0xd4, 0x5d, 0xae, 0xca, 0x24, 0xe3,
-		0xd4, 0x33, 0xc8, 0x71, 0x38, 0x86, 0x80, 0x61, 0xae, 0x5c, 0xf2, 0x49, 0xd5, 0xe4, 0xe4, 0xae,
-		0x33, 0x16, 0xe0, 0x10, 0x3c, 0x18, 0xc5, 0x42, 0xbe, 0x23, 0x85, 0x05, 0x8b, 0x81, 0x0b, 0x14,
-		0x09, 0xe0, 0x73, 0xec, 0x93, 0xf1, 0x82, 0xd0, 0xc9, 0x90, 0x22, 0x0a, 0x44, 0xe0, 0x19, 0x8c,
-		0x70, 0xc8, 0xe8, 0x84, 0xd0, 0x89, 0x7c, 0x05, 0x46, 0xf5, 0x2d, 0x4e, 0xe4, 0x9b, 0xf1, 0xa9,
-		0x72, 0xfa, 0xe3, 0x5b, 0x3f, 0x8c, 0x03, 0xac, 0xae, 0xe6, 0xa0, 0x70, 0x6e, 0x0a, 0xd1, 0xc1,
-		0xbb, 0x43, 0xfa, 0xff, 0xa6, 0x24, 0xd4, 0x23, 0xf8, 0xf2, 0xd5, 0x38, 0x53, 0x65, 0x9b, 0xd1,
-		0x58, 0x2d, 0x9f, 0xaa, 0xd3, 0xec, 0xb3, 0xd9, 0x8c, 0x51, 0x35, 0xab, 0xc2, 0xfc, 0x09, 0xa1,
-		0x97, 0x2c, 0x20, 0x5c, 0x10, 0x3a, 0x89, 0x09, 0x9f, 0x0e, 0x29, 0xce, 0x56, 0x69, 0xb4, 0x90,
-		0x33, 0xc9, 0x67, 0xa2, 0x92, 0x5a, 0x43, 0xc4, 0xa7, 0xea, 0x1f, 0x68, 0x26, 0x3f, 0xc6, 0x2c,
-		0x02, 0x7f, 0x4a, 0xc2, 0x20, 0xc2, 0xd4, 0xf9, 0x45, 0x9d, 0x5f, 0xf4, 0x50, 0x7e, 0x51, 0xcd,
-		0x30, 0x6a, 0xfb, 0x45, 0x93, 0x69, 0x9a, 0xf2, 0x8b, 0x26, 0x6c, 0x2c, 0x09, 0x0c, 0x12, 0x0e,
-		0xb3, 0x54, 0x5d, 0x3c, 0x51, 0x50, 0x55, 0xce, 0xe4, 0xa9, 0x53, 0xd5, 0xd5, 0x47, 0x18, 0xfc,
-		0x50, 0x72, 0x8a, 0x25, 0xdc, 0x75, 0x48, 0x09, 0xcf, 0x80, 0xae, 0xce, 0xe8, 0x71, 0x46, 0x8f,
-		0x33, 0x7a, 0x9c, 0xf3, 0xb3, 0x8e, 0x67, 0xce, 0x75, 0xbe, 0xdc, 0xbb, 0xf3, 0xb3, 0x4a, 0x97,
-		0x74, 0xe7, 0xfd, 0x6c, 0x9d, 0xf7, 0x33, 0xb1, 0x64, 0x82, 0x88, 0x5c, 0x63, 0x5a, 0x5d, 0xab,
-		0x28, 0x4e, 0xd3, 0xb0, 0x56, 0xa1, 0x67, 0x95, 0xc6, 0x04, 0xf6, 0x51, 0xac, 0xb2, 0x6f, 0x08,
-		0x57, 0xb0, 0x04, 0x04, 0x81, 0x0e, 0xad, 0x4a, 0xed, 0xc2, 0x57, 0x86, 0xc2, 0x28, 0x4b, 0x87,
-		0xd9, 0x8e, 0xd8, 0xd1, 0xb6, 0x8f, 0x90, 0xe6, 0x01, 0x17, 0xca, 0xdc, 0xc8, 0xd4, 0x15, 0x18,
-		0x13, 0x1c, 0x06, 0xd2, 0xf0, 0xc9, 0x01, 0x98, 0x34, 0xa0, 0x49, 0x59, 0x24, 0x88, 0x6b, 0xbb,
-		0xe3, 0x86, 0x68, 0x70, 0xc4, 0x12, 0x92, 0x34, 0x52, 0xbd, 0x67, 0x38, 0x19, 0x85, 0xea, 0x1e,
-		0x9f, 0x28, 0xbb, 0xd9, 0xa6, 0xe2, 0x00, 0x19, 0x67, 0x0f, 0x7e, 0x2d, 0xcf, 0x13, 0x87, 0x1b,
-		0xbc, 0xcc, 0xe3, 0x71, 0xe1, 0x65, 0xa7, 0x9d, 0x39, 0xed, 0xcc, 0x69, 0x58, 0x4e, 0xc3, 0x72,
-		0x1a, 0x96, 0xd3, 0xb0, 0x5a, 0xa9, 0x61, 0x3d, 0x24, 0x07, 0x7c, 0xde, 0x99, 0xfd, 0xa0, 0x13,
-		0xbf, 0x76, 0xaf, 0xc3, 0xfd, 0xcc, 0x00, 0x33, 0xca, 0xc4, 0x02, 0xe3, 0xb6, 0xe5, 0xef, 0xe5,
-		0x74, 0x3f, 0xa4, 0xb3, 0x99, 0x66, 0x94, 0x3d, 0xd9, 0xf1, 0xe6, 0x69, 0xdb, 0xfc, 0xb5, 0x16,
-		0x6c, 0x9d, 0x57, 0xb7, 0x8a, 0x29, 0xad, 0xcb, 0xc1, 0x2d, 0xc9, 0x67, 0x39, 0xfd, 0xe8, 0x56,
-		0x5c, 0x4c, 0x22, 0x16, 0xcf, 0x3d, 0x81, 0xb6, 0x25, 0xdb, 0x1a, 0x6b, 0x41, 0x05, 0x2d, 0x47,
-		0x2f, 0x07, 0xe0, 0x5b, 0x34, 0x9b, 0x87, 0x98, 0x77, 0x4a, 0xde, 0x75, 0x45, 0x1b, 0xde, 0xdd,
-		0xe9, 0x7f, 0xa5, 0xa3, 0xff, 0x06, 0x66, 0x5c, 0xa9, 0x73, 0xff, 0xee, 0x0e, 0xfd, 0xab, 0xbb,
-		0x51, 0x42, 0x7f, 0xa6, 0x74, 0xb7, 0x61, 0xd1, 0x8d, 0x08, 0xac, 0xb8, 0xa0, 0xcb, 0xc5, 0xd4,
-		0x7f, 0x25, 0x0f, 0xbb, 0xed, 0x21, 0x3b, 0x84, 0xff, 0x88, 0x3e, 0xe1, 0xb7, 0x8c, 0xad, 0xef,
-		0xe8, 0xea, 0x83, 0x77, 0xf2, 0x3f, 0x15, 0x1e, 0xec, 0x25, 0xbe, 0xce, 0x4e, 0xfb, 0x97, 0x27,
-		0x5f, 0xfe, 0x3f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19, 0xfc, 0x1e,
-		0xcc, 0x02, 0x5f, 0x02, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x73, 0xdb, 0x36,
+		0xbe, 0xe8, 0xff, 0xfe, 0x14, 0xbf, 0xd1, 0xdc, 0x3b, 0xb1, 0xb3, 0xa6, 0x2c, 0xab, 0x76, 0x36,
+		0xf5, 0x4c, 0xa7, 0x75, 0x9b, 0xb4, 0x9b, 0xd9, 0x66, 0xdb, 0x69, 0x93, 0xbd, 0xe7, 0x34, 0x72,
+		0x73, 0x20, 0x12, 0x92, 0x70, 0x42, 0x01, 0x5a, 0x02, 0xb4, 0xad, 0x26, 0xfe, 0xee, 0x77, 0x00,
+		0x90, 0x12, 0xf5, 0x24, 0x00, 0x52, 0x32, 0x6d, 0x43, 0xd3, 0xa9, 0x63, 0x19, 0x00, 0x41, 0xe0,
+		0xf7, 0x7e, 0x7e, 0x3e, 0x00, 0x00, 0x68, 0xfd, 0x0b, 0x8d, 0x71, 0xeb, 0x02, 0x5a, 0x11, 0xbe,
+		0x26, 0x21, 0x6e, 0x1d, 0xeb, 0x6f, 0xff, 0x49, 0x68, 0xd4, 0xba, 0x80, 0xd3, 0xec, 0xd7, 0x1f,
+		0x18, 0x1d, 0x90, 0x61, 0xeb, 0x02, 0x3a, 0xd9, 0x17, 0xaf, 0x48, 0xd2, 0xba, 0x00, 0xbd, 0x04,
+		0x00, 0x40, 0x2b, 0x64, 0x54, 0x9c, 0xa2, 0x85, 0xef, 0x16, 0x96, 0xcf, 0xfe, 0x7e, 0xbc, 0xf8,
+		0xd7, 0x57, 0x98, 0x87, 0x09, 0x99, 0x08, 0xc2, 0xa8, 0x1c, 0xf4, 0x6e, 0x84, 0x41, 0xb0, 0x09,
+		0xc4, 0xf8, 0x1a, 0xc7, 0x20, 0xa7, 0x20, 0x42, 0x71, 0xb2, 0x3c, 0x6b, 0x71, 0x73, 0xb3, 0xaf,
+		0x97, 0x37, 0x39, 0xfb, 0xc3, 0xaf, 0x09, 0x1e, 0x90, 0xdb, 0x95, 0xbd, 0x2d, 0xec, 0x4f, 0x9c,
+		0xb6, 0x8e, 0x57, 0xff, 0xfa, 0x3b, 0x4b, 0x93, 0x10, 0xaf, 0x9d, 0xa9, 0x77, 0x82, 0xa7, 0x37,
+		0x2c, 0x91, 0x9b, 0x69, 0x4d, 0xf4, 0x43, 0x8e, 0xd7, 0x0f, 0xfc, 0x07, 0xe2, 0x97, 0xc9, 0x30,
+		0x1d, 0x63, 0x2a, 0x5a, 0x17, 0x20, 0x92, 0x14, 0x6f, 0x18, 0x58, 0x18, 0x25, 0xf7, 0xb4, 0x32,
+		0xe8, 0x6e, 0xe1, 0x9b, 0xbb, 0xe5, 0xf3, 0x5c, 0xba, 0x96, 0x85, 0xeb, 0xe9, 0xa2, 0xcd, 0x2f,
+		0x52, 0xbc, 0xa6, 0x2e, 0xda, 0xf4, 0x16, 0x6b, 0xae, 0xab, 0x4b, 0xa3, 0x92, 0xeb, 0x2a, 0xb9,
+		0xb6, 0xd2, 0xeb, 0x33, 0xb9, 0x46, 0xb3, 0xeb, 0x34, 0xbd, 0x56, 0xeb, 0xeb, 0xb5, 0xbe, 0x66,
+		0xe3, 0xeb, 0x5e, 0x7f, 0xed, 0x1b, 0xae, 0xbf, 0x14, 0x0c, 0xf2, 0x4f, 0x2b, 0xc6, 0x68, 0xd0,
+		0x45, 0x5b, 0xc7, 0x2c, 0x1c, 0x67, 0x36, 0xbe, 0xe4, 0x65, 0x96, 0xc0, 0xe3, 0x5f, 0xe9, 0x18,
+		0x27, 0x24, 0x04, 0x39, 0x19, 0x08, 0xe5, 0x24, 0xc2, 0xf0, 0x43, 0x0e, 0x24, 0x60, 0xb2, 0xdc,
+		0x00, 0xa5, 0xb1, 0x3c, 0x9a, 0x0f, 0x5b, 0x07, 0x02, 0x00, 0xb4, 0xba, 0xad, 0xad, 0x63, 0xae,
+		0x4a, 0x9e, 0x95, 0xc1, 0x66, 0xa7, 0x64, 0x58, 0x19, 0x8c, 0xda, 0xc0, 0xaa, 0x1d, 0xcc, 0xda,
+		0xc2, 0xae, 0x33, 0x0c, 0x3b, 0xc3, 0xb2, 0x35, 0x4c, 0x6f, 0x87, 0xed, 0x12, 0x18, 0xcf, 0x3f,
+		0xad, 0x77, 0xd3, 0x09, 0xb6, 0x3b, 0xe7, 0x94, 0x50, 0xf1, 0xd2, 0xe4, 0xa8, 0x33, 0xa0, 0x38,
+		0x37, 0x18, 0xfa, 0x1b, 0xa2, 0x43, 0x6c, 0x04, 0xa9, 0x00, 0x60, 0x78, 0x75, 0x00, 0x00, 0xad,
+		0xb7, 0x84, 0xb6, 0x2e, 0x2c, 0x26, 0x00, 0x00, 0xb4, 0xfe, 0x8d, 0xe2, 0x14, 0x6f, 0x26, 0xb5,
+		0x9b, 0x3e, 0xad, 0x1f, 0x13, 0x14, 0x4a, 0xec, 0x7d, 0x45, 0x86, 0x44, 0xf0, 0x72, 0x30, 0x5f,
+		0x3d, 0x62, 0x3c, 0x44, 0x82, 0x5c, 0xcb, 0x67, 0x0f, 0x50, 0xcc, 0xb1, 0xf1, 0xec, 0xbb, 0x63,
+		0x8b, 0x23, 0x41, 0xb7, 0xee, 0x47, 0xf2, 0xd5, 0xc3, 0x39, 0x92, 0x83, 0x1a, 0x0f, 0x6e, 0x6f,
+		0x10, 0xe7, 0x41, 0x6e, 0xf5, 0x4c, 0x1e, 0x1d, 0xcc, 0x95, 0x8e, 0xba, 0xaa, 0x44, 0xd2, 0xf1,
+		0xad, 0x48, 0x50, 0x90, 0x52, 0x2e, 0x50, 0x3f, 0x36, 0x24, 0xee, 0x09, 0x1e, 0xe0, 0x04, 0xd3,
+		0x70, 0x27, 0x44, 0x38, 0xe7, 0x1c, 0xbf, 0xfd, 0xf8, 0x03, 0xbc, 0xe8, 0x9c, 0x75, 0x5a, 0x16,
+		0xa0, 0x63, 0xc9, 0xaf, 0xd7, 0xf1, 0xed, 0xf9, 0xbb, 0x59, 0xc2, 0x81, 0x2b, 0x0b, 0x5f, 0xcb,
+		0xca, 0x67, 0x2f, 0xdf, 0x34, 0x68, 0x3a, 0x70, 0x80, 0x33, 0x2d, 0xd1, 0xf6, 0x2d, 0x25, 0xe0,
+		0xbe, 0xa5, 0x04, 0xfc, 0x6f, 0x16, 0x0b, 0x34, 0xc4, 0xce, 0x12, 0xb0, 0x97, 0x4a, 0x6b, 0x03,
+		0xe9, 0x3d, 0x4b, 0xa5, 0x6f, 0x11, 0x8d, 0x90, 0x60, 0xc9, 0xb4, 0x5c, 0x0a, 0xf3, 0x12, 0xec,
+		0x16, 0xde, 0xd9, 0xf1, 0xe2, 0xc4, 0xf2, 0x91, 0x74, 0xbd, 0x34, 0x61, 0x38, 0xbf, 0x94, 0xfe,
+		0x87, 0x96, 0xf4, 0x3f, 0xb4, 0xa4, 0xff, 0xbf, 0x61, 0x14, 0x01, 0xa3, 0xf1, 0x74, 0x6f, 0x1c,
+		0xa0, 0xeb, 0x39, 0x40, 0x43, 0x38, 0x80, 0x3d, 0x55, 0xe7, 0x22, 0x21, 0x74, 0x68, 0x41, 0xd6,
+		0x4f, 0x5f, 0xee, 0x0a, 0x31, 0x22, 0x4b, 0xc4, 0x88, 0x2c, 0x11, 0xe3, 0x92, 0x32, 0x31, 0xc2,
+		0x09, 0x10, 0x2a, 0xf0, 0x10, 0x27, 0xfb, 0xc0, 0x0c, 0x2f, 0x1b, 0x3d, 0x5c, 0xcc, 0xf0, 0xf2,
+		0x4e, 0x03, 0x99, 0xbb, 0x97, 0x77, 0xec, 0xd0, 0xa2, 0x01, 0xf2, 0x0e, 0xb6, 0x24, 0xeb, 0xd8,
+		0x92, 0xac, 0xcb, 0x49, 0x10, 0x13, 0x2e, 0x3c, 0x41, 0xf7, 0x04, 0x7d, 0xdb, 0x39, 0x13, 0x2a,
+		0x4e, 0x5f, 0x58, 0x10, 0xf4, 0xee, 0xc3, 0x75, 0xc1, 0x74, 0xee, 0x93, 0xa4, 0x4b, 0x90, 0x69,
+		0x20, 0x45, 0xef, 0x74, 0x3c, 0x4d, 0xb7, 0xc2, 0xb0, 0x9f, 0x09, 0x17, 0x97, 0x42, 0x24, 0x66,
+		0x58, 0xf6, 0x96, 0xd0, 0xd7, 0x31, 0x96, 0xf8, 0x6f, 0x78, 0x54, 0xf2, 0x3a, 0x0b, 0x33, 0x4e,
+		0x5f, 0x9e, 0x9d, 0xbd, 0xf8, 0xfb, 0xd9, 0x59, 0xe7, 0xef, 0x5f, 0xfd, 0xbd, 0xf3, 0xf5, 0xf9,
+		0xf9, 0xe9, 0x8b, 0x53, 0x13, 0x89, 0xea, 0x97, 0x24, 0xc2, 0x09, 0x8e, 0xbe, 0x9f, 0xb6, 0x2e,
+		0x80, 0xa6, 0x71, 0xbc, 0x2b, 0x2e, 0x36, 0xb0, 0xe4, 0x62, 0x03, 0x4b, 0x2e, 0xd6, 0x27, 0x14,
+		0x25, 0x8b, 0x2a, 0x7b, 0xe8, 0xf9, 0x98, 0xe7, 0x63, 0x6b, 0xce, 0x59, 0x83, 0x8a, 0x05, 0x23,
+		0xfb, 0xda, 0x60, 0xe8, 0xcf, 0x98, 0x0e, 0xc5, 0xa8, 0x71, 0x9c, 0xac, 0xeb, 0x75, 0x93, 0x07,
+		0x7d, 0x26, 0x4d, 0x57, 0x4e, 0x86, 0x96, 0x64, 0x7d, 0x68, 0x49, 0xd6, 0xbf, 0x67, 0x2c, 0xc6,
+		0x88, 0x7a, 0x67, 0x9c, 0xa7, 0xeb, 0xe5, 0x74, 0x5d, 0xc3, 0x8a, 0x8d, 0x2d, 0xf6, 0x74, 0x57,
+		0x78, 0x31, 0xb2, 0xc4, 0x8b, 0x91, 0x25, 0x5e, 0xbc, 0x7f, 0xff, 0xe6, 0x95, 0x47, 0x0a, 0x8f,
+		0x14, 0xa5, 0xe7, 0x9c, 0xa6, 0x24, 0xb2, 0xf2, 0x4e, 0x18, 0x8c, 0xfd, 0x15, 0x09, 0x81, 0x13,
+		0x6a, 0x2c, 0xec, 0xb4, 0x3e, 0x74, 0x82, 0xaf, 0x51, 0x30, 0xb8, 0x0c, 0x7e, 0xbc, 0xfa, 0xfc,
+		0xf2, 0x2e, 0x28, 0xfe, 0x7a, 0x66, 0xf3, 0xeb, 0x69, 0xf7, 0xae, 0x75, 0x7f, 0xbc, 0x8e, 0x58,
+		0xe2, 0x34, 0xb1, 0xc4, 0xe9, 0x57, 0x48, 0x60, 0x40, 0x34, 0x82, 0x77, 0x64, 0xec, 0xc3, 0x4f,
+		0x3c, 0x72, 0x97, 0x9f, 0x73, 0x84, 0x04, 0x0e, 0x10, 0x8d, 0x02, 0x41, 0xc6, 0xf8, 0xde, 0xb1,
+		0xbc, 0xd7, 0x8b, 0x24, 0xc2, 0xca, 0x1f, 0xdd, 0xfc, 0xc7, 0x3b, 0xfd, 0xe3, 0x62, 0xe1, 0xc7,
+		0x61, 0xaf, 0xd7, 0xee, 0xf5, 0xa2, 0xbf, 0x1d, 0x7d, 0x7b, 0xf8, 0xc7, 0x97, 0x0f, 0xbd, 0xde,
+		0xdf, 0x7a, 0xbd, 0xe0, 0x6a, 0x61, 0xc4, 0xd1, 0x3d, 0xa2, 0xf9, 0xff, 0x5a, 0xa2, 0xf9, 0xff,
+		0x5a, 0xa2, 0xf9, 0x14, 0xd1, 0x61, 0x40, 0x22, 0x4c, 0x05, 0x19, 0x10, 0x9c, 0x78, 0x44, 0xf7,
+		0x88, 0x5e, 0x7a, 0xce, 0x4b, 0x30, 0x53, 0x37, 0xaa, 0x37, 0xd4, 0x78, 0xe1, 0xc3, 0xd2, 0x57,
+		0x8f, 0xc4, 0xc9, 0xca, 0xdb, 0x94, 0x53, 0xaa, 0xcb, 0x9a, 0xb1, 0x13, 0x19, 0x15, 0x05, 0x7f,
+		0x5d, 0x06, 0x7f, 0x7c, 0xbc, 0xca, 0xfe, 0xd1, 0x09, 0xbe, 0xee, 0xf5, 0x82, 0x8f, 0xed, 0xab,
+		0xe7, 0xa6, 0xd4, 0xa7, 0xfd, 0xa5, 0xdd, 0xfe, 0xf2, 0xe1, 0xcf, 0xdb, 0xff, 0xba, 0x6a, 0x3f,
+		0xff, 0xd2, 0xfe, 0xf0, 0xe7, 0xf8, 0xad, 0xfa, 0x47, 0xfb, 0xc3, 0x9f, 0xf1, 0xcf, 0x57, 0xed,
+		0xe7, 0x3b, 0x64, 0x69, 0x56, 0x79, 0x86, 0x97, 0x94, 0x32, 0x81, 0x32, 0x6e, 0xb4, 0x19, 0x0c,
+		0x5b, 0x3c, 0x1c, 0xe1, 0x31, 0x9a, 0x20, 0x45, 0x18, 0x5a, 0x27, 0x8c, 0x0e, 0x02, 0x81, 0xb9,
+		0x38, 0x3d, 0xd1, 0x59, 0xc1, 0x27, 0x5b, 0xb3, 0x4e, 0xf5, 0x0a, 0x22, 0x49, 0x43, 0x41, 0x33,
+		0x1a, 0xf6, 0x0b, 0x1d, 0xbc, 0x93, 0xf3, 0x3f, 0x4a, 0x26, 0x77, 0x7a, 0xa9, 0x7e, 0x74, 0x2f,
+		0x5b, 0x07, 0x66, 0x6f, 0xb4, 0xe6, 0x6d, 0x14, 0xfb, 0x3d, 0x35, 0xc8, 0x8f, 0xcd, 0xc6, 0x99,
+		0xe5, 0xc7, 0xfe, 0xbc, 0x96, 0x1d, 0x6f, 0x9e, 0xbe, 0x9d, 0x0d, 0xfb, 0x04, 0xd9, 0xfa, 0x12,
+		0x64, 0x4b, 0xd9, 0xa6, 0x79, 0x50, 0x9e, 0x09, 0x77, 0x34, 0xe5, 0x8a, 0x66, 0xbe, 0x3d, 0x73,
+		0xe9, 0x27, 0x27, 0xf5, 0x86, 0x74, 0xdd, 0x99, 0x9e, 0xdb, 0xd3, 0xf1, 0x3b, 0x33, 0xa7, 0xa4,
+		0xfd, 0xab, 0x9e, 0x76, 0x9a, 0xf7, 0xae, 0x8e, 0xb4, 0xf8, 0xaa, 0x0a, 0x3d, 0x23, 0xdc, 0x28,
+		0xdf, 0x3f, 0x1b, 0x67, 0x46, 0xcf, 0x2e, 0x81, 0x93, 0xf1, 0x24, 0xc6, 0x3a, 0xc4, 0x87, 0x0d,
+		0xa4, 0x5b, 0x74, 0x40, 0x86, 0x69, 0xa2, 0x58, 0x00, 0x10, 0x81, 0xc7, 0xdc, 0x27, 0xff, 0x37,
+		0x3e, 0xf9, 0x3f, 0xe3, 0xa2, 0x86, 0x8a, 0xa9, 0x1a, 0x6d, 0xa7, 0x96, 0xbe, 0x1b, 0x65, 0x20,
+		0x42, 0x38, 0x7c, 0xc2, 0x53, 0x1c, 0x41, 0x7f, 0x0a, 0x26, 0xeb, 0x78, 0x3d, 0xb4, 0x32, 0x50,
+		0x59, 0x03, 0x97, 0x21, 0x95, 0x6a, 0x42, 0xb4, 0xfb, 0xc3, 0x55, 0x3f, 0xcf, 0xbc, 0xfa, 0xb9,
+		0x7c, 0x24, 0x2f, 0xbd, 0xe7, 0xdc, 0x70, 0xfe, 0x96, 0x2b, 0x69, 0x25, 0x32, 0xe8, 0x31, 0x18,
+		0x1b, 0x5c, 0xc4, 0x0c, 0xf1, 0xe6, 0x53, 0x2c, 0x73, 0x36, 0x60, 0x8c, 0x6e, 0xe1, 0x5a, 0x5e,
+		0x1f, 0x0c, 0x58, 0x02, 0x62, 0x84, 0x41, 0xad, 0xe5, 0xa9, 0xba, 0xa7, 0xea, 0x3e, 0x53, 0xc3,
+		0x93, 0x74, 0xe8, 0x9e, 0x9f, 0x7b, 0xa2, 0x5e, 0x1f, 0x51, 0x37, 0x80, 0xce, 0x65, 0xa2, 0x4e,
+		0xa8, 0x35, 0x51, 0xcf, 0x54, 0x3a, 0xb5, 0x00, 0x08, 0x06, 0xd2, 0x30, 0x07, 0x49, 0x1a, 0x63,
+		0x0e, 0x84, 0xc2, 0x7f, 0x5f, 0xfe, 0xeb, 0xa7, 0x36, 0xbc, 0x25, 0x14, 0xc6, 0x29, 0x17, 0xd0,
+		0xc7, 0xd0, 0x4b, 0x3b, 0x9d, 0xaf, 0xc2, 0x6f, 0xc0, 0x80, 0x81, 0x78, 0xc2, 0x0f, 0xe0, 0xcb,
+		0x17, 0x00, 0x78, 0x26, 0xf1, 0x00, 0xe8, 0xa1, 0x67, 0x12, 0x2e, 0xe4, 0x1f, 0xee, 0x8f, 0x49,
+		0x60, 0xab, 0x34, 0x6d, 0x3d, 0xdc, 0x96, 0x39, 0xcc, 0x2a, 0x0f, 0x81, 0x60, 0xa0, 0x33, 0xbd,
+		0x81, 0x50, 0x25, 0xfa, 0x77, 0x51, 0x69, 0xc5, 0xcf, 0x65, 0x3c, 0xf6, 0x8c, 0xe0, 0xc1, 0x31,
+		0x02, 0x7b, 0xe2, 0x2e, 0xa1, 0x24, 0xc1, 0x03, 0x1b, 0xc3, 0xce, 0xdf, 0xcd, 0x9c, 0xb0, 0xda,
+		0x79, 0xb8, 0xe0, 0x32, 0x3c, 0xd1, 0x30, 0xd9, 0xda, 0x01, 0x7e, 0x89, 0xdb, 0x60, 0xc2, 0x6e,
+		0x70, 0x62, 0x8e, 0x62, 0xb3, 0x19, 0x96, 0xd6, 0xd2, 0x04, 0x51, 0x3e, 0x26, 0x02, 0x8c, 0x26,
+		0x7b, 0x54, 0x7a, 0x3a, 0xa8, 0x94, 0xda, 0xa6, 0xc9, 0xbe, 0xf0, 0x95, 0x4a, 0x77, 0x2f, 0x13,
+		0xf8, 0xdc, 0x22, 0x4b, 0xc4, 0xd8, 0x83, 0x9c, 0x64, 0xe5, 0x20, 0xfb, 0x27, 0x9e, 0x96, 0x38,
+		0xb6, 0xcc, 0xf2, 0x6d, 0xcd, 0xf3, 0x6c, 0x97, 0xf2, 0x6b, 0xb7, 0x78, 0x03, 0xcc, 0x92, 0x68,
+		0x37, 0xbd, 0x99, 0x45, 0xe1, 0xcc, 0x96, 0xd4, 0xe7, 0xeb, 0x08, 0x48, 0x98, 0xf9, 0x09, 0xd3,
+		0x71, 0x1f, 0x27, 0x87, 0xed, 0x93, 0x99, 0x29, 0xe2, 0x68, 0x66, 0x2b, 0x58, 0xfe, 0x1b, 0xba,
+		0x3d, 0x32, 0xa1, 0x6a, 0x8b, 0x8c, 0xd2, 0x90, 0x8d, 0xcc, 0x98, 0xd4, 0x88, 0x70, 0x20, 0x1c,
+		0x90, 0xb6, 0x5c, 0x70, 0x81, 0x84, 0xba, 0x00, 0x53, 0xae, 0x62, 0xc9, 0xbe, 0x60, 0x89, 0x85,
+		0x45, 0x85, 0xbd, 0x5b, 0x50, 0x0c, 0x57, 0x5e, 0x06, 0x2b, 0xfc, 0x6c, 0xd3, 0xeb, 0xd7, 0x84,
+		0xaa, 0x26, 0x01, 0x1c, 0xaf, 0x93, 0x84, 0x25, 0x97, 0x93, 0xc9, 0x3b, 0x34, 0xb4, 0xbf, 0x3f,
+		0x05, 0x2a, 0x6a, 0xf3, 0x7b, 0xba, 0x31, 0x2c, 0x77, 0x1b, 0xa0, 0xc9, 0x24, 0x10, 0x68, 0x78,
+		0x2f, 0x77, 0x56, 0x78, 0xe5, 0x7d, 0xdf, 0xd2, 0x5b, 0xcc, 0x39, 0x1a, 0x62, 0xc7, 0x6b, 0x92,
+		0xd8, 0x3e, 0x33, 0x10, 0xc6, 0x98, 0x73, 0x10, 0x23, 0x44, 0x81, 0x25, 0x80, 0xff, 0x93, 0xa2,
+		0x18, 0x04, 0x03, 0x53, 0xaf, 0x53, 0xbd, 0xb7, 0x39, 0xce, 0x5e, 0xeb, 0xde, 0x6e, 0xd3, 0xea,
+		0x64, 0xea, 0xba, 0xf4, 0x7a, 0xe3, 0x8d, 0x76, 0x1c, 0xfb, 0xb9, 0x35, 0x02, 0x09, 0xca, 0x63,
+		0x3f, 0x25, 0xab, 0xae, 0x18, 0xfb, 0x49, 0xb8, 0x38, 0x33, 0x0b, 0x95, 0x3a, 0x33, 0x8e, 0x94,
+		0x92, 0xa3, 0xe1, 0x86, 0x88, 0x11, 0x20, 0xc8, 0x54, 0x62, 0x20, 0x34, 0xc2, 0xb7, 0xcd, 0x08,
+		0x90, 0xc2, 0x0f, 0x31, 0x42, 0x0a, 0xef, 0x2d, 0x44, 0x8a, 0x58, 0x58, 0xd6, 0x88, 0xad, 0x59,
+		0xed, 0x67, 0x42, 0x3f, 0x29, 0x7b, 0x9a, 0x82, 0x7c, 0x15, 0x18, 0xc5, 0x1f, 0x86, 0xda, 0x8f,
+		0x1f, 0xa3, 0xde, 0x8f, 0x9f, 0x9e, 0x0d, 0x4d, 0x9c, 0x5e, 0x64, 0xd4, 0x57, 0x9c, 0x5e, 0x68,
+		0x30, 0x94, 0xff, 0x52, 0x24, 0x76, 0x47, 0xb9, 0x70, 0x67, 0x96, 0xb5, 0xd6, 0xcf, 0xfa, 0x2e,
+		0xb5, 0xe7, 0xce, 0x10, 0x30, 0xaa, 0x10, 0xeb, 0x0c, 0x01, 0xce, 0x35, 0x2e, 0x8f, 0x5b, 0x1e,
+		0xb7, 0x9e, 0x66, 0xdc, 0xa1, 0x37, 0xab, 0x01, 0x78, 0xb3, 0xda, 0x4e, 0xf2, 0x9b, 0x15, 0x8d,
+		0xb5, 0xa0, 0xe9, 0x7a, 0xbc, 0xad, 0x03, 0x72, 0x26, 0x46, 0x13, 0x0a, 0x68, 0xfe, 0x1b, 0x74,
+		0x65, 0x60, 0x39, 0x07, 0xc4, 0x95, 0x70, 0x2d, 0xfd, 0x94, 0xa6, 0x64, 0xfe, 0xd4, 0x93, 0xf9,
+		0x87, 0x47, 0xe6, 0xcb, 0x04, 0xf6, 0xfc, 0xd3, 0x8a, 0x08, 0x9f, 0xc4, 0x68, 0x6a, 0x94, 0xe2,
+		0xb0, 0x72, 0x3b, 0xc5, 0xc9, 0x86, 0xe7, 0xb0, 0x04, 0xb0, 0x52, 0xb5, 0x57, 0xbf, 0xa0, 0x18,
+		0xb2, 0xd5, 0x94, 0x7c, 0x0f, 0x48, 0x88, 0x84, 0xf4, 0x53, 0x81, 0x73, 0xf0, 0x8d, 0xc8, 0x40,
+		0xb9, 0xd6, 0x05, 0xc4, 0x8a, 0x75, 0x68, 0x33, 0x00, 0x37, 0x7d, 0xae, 0x99, 0xcc, 0x62, 0x0d,
+		0xd4, 0x2e, 0xc0, 0xed, 0x08, 0xe4, 0xae, 0xc0, 0x5e, 0x19, 0xe8, 0x2b, 0x03, 0xbf, 0x3b, 0x12,
+		0x58, 0x12, 0x76, 0xc3, 0xbb, 0x32, 0x96, 0x81, 0xdc, 0x65, 0x21, 0x17, 0x99, 0xc8, 0x55, 0x36,
+		0xca, 0x3f, 0x76, 0xe0, 0x00, 0xae, 0xb2, 0xd2, 0x8a, 0x80, 0x60, 0x29, 0x33, 0xd5, 0x26, 0x27,
+		0x54, 0x97, 0x17, 0x2c, 0x01, 0xa7, 0xb2, 0x4c, 0xb5, 0x72, 0x74, 0xe7, 0x0f, 0xff, 0xe8, 0x0e,
+		0x76, 0x78, 0xd0, 0xf7, 0x06, 0xd1, 0x1d, 0x0f, 0xd2, 0xae, 0x67, 0xd7, 0xed, 0x3c, 0x35, 0x98,
+		0x36, 0x1e, 0x7d, 0xb5, 0x47, 0x27, 0xd5, 0xe0, 0x13, 0x9e, 0x9e, 0xda, 0x4b, 0x74, 0x7a, 0x9a,
+		0x9b, 0x2c, 0x37, 0x60, 0x09, 0x26, 0x43, 0x2a, 0x15, 0x0d, 0x38, 0x85, 0x40, 0xea, 0x18, 0xb9,
+		0xd5, 0xf6, 0x1c, 0x9d, 0xd8, 0x2c, 0xec, 0x85, 0x35, 0x2f, 0xac, 0x01, 0x00, 0x54, 0x13, 0xd6,
+		0xcc, 0x8d, 0xc2, 0x2e, 0xc6, 0xe1, 0xed, 0x46, 0x62, 0xac, 0xac, 0xc4, 0xe7, 0xea, 0x5f, 0x0a,
+		0xf2, 0xf7, 0x8c, 0xfa, 0x5d, 0x37, 0xd4, 0xef, 0xd6, 0x80, 0xfa, 0xdd, 0x75, 0xa8, 0xdf, 0xf5,
+		0xa8, 0xef, 0x51, 0xff, 0x49, 0xa2, 0x7e, 0x77, 0x4f, 0x51, 0x0a, 0xc7, 0xa5, 0xae, 0xe7, 0x19,
+		0x7f, 0x07, 0x13, 0x54, 0x7f, 0x8c, 0x2d, 0x34, 0x76, 0xd0, 0x46, 0xdb, 0x28, 0x2a, 0x30, 0xff,
+		0x38, 0x40, 0x72, 0xc8, 0x68, 0x88, 0xc4, 0xe1, 0x33, 0x25, 0x5e, 0xc3, 0xb3, 0x63, 0xd0, 0x26,
+		0x88, 0xc3, 0x76, 0xfb, 0xe4, 0x3b, 0x12, 0x1d, 0x1d, 0xc3, 0xb3, 0xa0, 0xf0, 0xe5, 0xc9, 0x77,
+		0xea, 0x82, 0xd7, 0x7f, 0xdd, 0x3d, 0x3a, 0x82, 0x6f, 0xe6, 0xdf, 0x15, 0x8c, 0x77, 0x47, 0x36,
+		0x88, 0xe2, 0x12, 0x64, 0xb8, 0x4a, 0x4a, 0x67, 0xd1, 0x76, 0x1c, 0xa9, 0x74, 0x4a, 0xa7, 0x98,
+		0xc3, 0xaa, 0xa4, 0x16, 0x6a, 0x89, 0x41, 0xac, 0x8d, 0xee, 0xc2, 0xe6, 0x98, 0xc4, 0xf5, 0xa7,
+		0xb4, 0x23, 0x3d, 0xc5, 0xc6, 0xb5, 0xe4, 0x12, 0xb2, 0xb8, 0x02, 0x0d, 0x05, 0x50, 0x84, 0x01,
+		0x4b, 0xc6, 0xe8, 0xbe, 0x00, 0xc0, 0x35, 0xa4, 0x71, 0x77, 0x20, 0xb0, 0xe6, 0x68, 0x9a, 0x72,
+		0xeb, 0xb6, 0x21, 0x90, 0x5b, 0xaf, 0x3d, 0x0f, 0xf9, 0xd3, 0xef, 0x28, 0x70, 0x94, 0x11, 0x2a,
+		0x88, 0xc9, 0x27, 0x0c, 0x19, 0xf9, 0xd3, 0xe1, 0xd1, 0xed, 0xf6, 0x09, 0x89, 0xd4, 0x3f, 0x71,
+		0xa0, 0xbf, 0x51, 0x44, 0x6f, 0xe5, 0x9b, 0xae, 0xfe, 0xe6, 0xd9, 0xbd, 0xc2, 0x92, 0x7d, 0x40,
+		0xe5, 0x7e, 0x60, 0x69, 0x97, 0xe7, 0xbd, 0x2b, 0x00, 0x6d, 0x44, 0x67, 0x31, 0xc3, 0x30, 0x4e,
+		0xab, 0x70, 0xce, 0xb3, 0x13, 0x23, 0x6f, 0x2f, 0x98, 0x05, 0x77, 0x9e, 0xe9, 0xff, 0x5f, 0xb6,
+		0xf6, 0x99, 0x1c, 0xb2, 0x31, 0xa8, 0x6f, 0xc7, 0xa9, 0x21, 0x76, 0x72, 0x63, 0xb5, 0x6c, 0x91,
+		0x3a, 0x23, 0x78, 0xcf, 0xaa, 0x05, 0xf0, 0x9e, 0x55, 0x8d, 0xdf, 0x3d, 0x37, 0x8b, 0xdf, 0x3d,
+		0x77, 0x88, 0xdf, 0xd5, 0xa1, 0x06, 0x3e, 0x6c, 0xf7, 0x01, 0x84, 0xed, 0x1a, 0x19, 0x89, 0x67,
+		0xc7, 0x69, 0x60, 0xc2, 0x5d, 0x86, 0x0b, 0x39, 0x05, 0x58, 0xd6, 0xe3, 0xf6, 0x1c, 0x02, 0x30,
+		0xf2, 0xa0, 0xfa, 0x08, 0xc3, 0x1a, 0x80, 0xca, 0x1e, 0xb8, 0xcc, 0x58, 0xa8, 0x8f, 0x30, 0xf4,
+		0x11, 0x86, 0xb5, 0xb8, 0x0e, 0x7d, 0x84, 0xa1, 0xfd, 0xfc, 0x2d, 0x77, 0xd2, 0x32, 0x32, 0xfb,
+		0x17, 0x89, 0x79, 0xd7, 0x9e, 0x98, 0x77, 0x17, 0x88, 0xb9, 0x4e, 0x53, 0xf5, 0xc4, 0xdc, 0x13,
+		0xf3, 0xa7, 0x58, 0xab, 0xaa, 0xeb, 0x29, 0x79, 0xd5, 0xc0, 0x19, 0x4f, 0xc9, 0xd7, 0x7d, 0x94,
+		0xfb, 0xea, 0x1c, 0xd9, 0xe5, 0xff, 0x9c, 0xdb, 0xc6, 0x8a, 0x53, 0xa6, 0xfd, 0xb5, 0xf3, 0x48,
+		0x5b, 0x2f, 0xa7, 0x7b, 0xd2, 0xbe, 0xed, 0xa4, 0xbd, 0x9c, 0x0e, 0xe0, 0xe5, 0x74, 0x4f, 0xdd,
+		0x4d, 0xa8, 0xbb, 0x8b, 0x0d, 0x55, 0x19, 0x4b, 0xb6, 0xc8, 0xe5, 0xad, 0xd7, 0xb7, 0x82, 0x6f,
+		0x45, 0x14, 0x73, 0x43, 0xd6, 0xad, 0xb8, 0x18, 0x26, 0x2c, 0x35, 0x71, 0x74, 0x55, 0x33, 0x67,
+		0x45, 0x78, 0xcc, 0x28, 0x17, 0x09, 0x12, 0x98, 0xc3, 0xfc, 0x91, 0x96, 0x27, 0x77, 0xf5, 0xe8,
+		0x8d, 0xcb, 0xf7, 0x59, 0x8a, 0x28, 0x0f, 0x32, 0x38, 0x47, 0xb0, 0x10, 0x35, 0xb0, 0x3e, 0x96,
+		0x60, 0x35, 0x94, 0x40, 0xcb, 0x1f, 0xfb, 0x2c, 0x51, 0x54, 0x25, 0x6a, 0xe0, 0xf1, 0x54, 0x2a,
+		0xaa, 0x10, 0x15, 0xd0, 0x80, 0x82, 0x45, 0x1a, 0x6a, 0xec, 0x1c, 0xfe, 0x0f, 0xbf, 0x66, 0xd1,
+		0xe2, 0x5b, 0x3f, 0x98, 0xb2, 0x45, 0xd9, 0xb6, 0x4b, 0xdc, 0xc6, 0xe7, 0x28, 0xf3, 0x19, 0xaf,
+		0x38, 0x88, 0xed, 0xfd, 0xf1, 0x0f, 0xbf, 0xa0, 0x51, 0x9d, 0x67, 0xf6, 0x64, 0x4b, 0x1d, 0x9d,
+		0x57, 0x73, 0x94, 0x9e, 0x1b, 0x3b, 0x4a, 0x0f, 0xb6, 0xbc, 0xd9, 0x66, 0xc9, 0xeb, 0xf3, 0x41,
+		0x0d, 0x92, 0x96, 0x31, 0xc8, 0x39, 0x49, 0x56, 0x77, 0x07, 0x5b, 0x24, 0xa9, 0xd6, 0x65, 0x3a,
+		0x94, 0x0b, 0xe2, 0xc8, 0xe6, 0xf5, 0x72, 0xaa, 0x30, 0x0f, 0x7c, 0x35, 0xf5, 0x13, 0x47, 0x11,
+		0x20, 0x08, 0x59, 0x2a, 0xf9, 0x16, 0x1b, 0x00, 0xc5, 0x37, 0xca, 0xe6, 0xc0, 0x41, 0x30, 0xd8,
+		0xbe, 0x92, 0x77, 0x1c, 0x97, 0xc0, 0xc3, 0x3e, 0x1d, 0xc7, 0xdb, 0x4b, 0x80, 0xad, 0x72, 0x8f,
+		0x92, 0x88, 0x07, 0xa8, 0xa5, 0x24, 0x98, 0x21, 0xa8, 0x18, 0x83, 0x8c, 0x0d, 0xe8, 0x58, 0x82,
+		0x90, 0x2b, 0x93, 0x7b, 0xfc, 0x66, 0xa9, 0xb2, 0x78, 0x22, 0x3b, 0xd5, 0xcf, 0x5e, 0x05, 0xac,
+		0x45, 0x15, 0xb4, 0x53, 0x09, 0xcb, 0x0f, 0xae, 0xac, 0xb0, 0xc8, 0xb9, 0x1d, 0x2a, 0x9e, 0x57,
+		0x40, 0xc5, 0xad, 0xd1, 0x3d, 0x1e, 0x03, 0x1f, 0x0f, 0x06, 0x96, 0x59, 0xa3, 0xca, 0x65, 0xa3,
+		0xe5, 0x8f, 0xfd, 0x01, 0x5b, 0x58, 0xa9, 0xea, 0x3d, 0x66, 0x5b, 0xab, 0x95, 0xd9, 0xc9, 0x5f,
+		0xf9, 0x94, 0x1b, 0x77, 0xab, 0x97, 0x9d, 0xf5, 0xcb, 0x0e, 0xe6, 0xe0, 0x7e, 0xac, 0x61, 0x1b,
+		0xe8, 0xad, 0xcf, 0xa9, 0xa9, 0x8e, 0xcd, 0x9b, 0x09, 0xe8, 0x13, 0xc9, 0xa9, 0x71, 0xb1, 0xae,
+		0xd5, 0x7d, 0xf7, 0xcd, 0x4b, 0xa7, 0x71, 0xb0, 0xbe, 0xed, 0xe1, 0xae, 0x2b, 0x67, 0xd2, 0xec,
+		0xdf, 0x3a, 0xb7, 0x1b, 0x50, 0x69, 0x52, 0xb6, 0xcc, 0xde, 0xad, 0x77, 0x0e, 0x80, 0xd6, 0x4c,
+		0x2f, 0xa7, 0xa5, 0x05, 0xca, 0x40, 0x06, 0xd8, 0xce, 0xf3, 0x0d, 0x0c, 0x3a, 0x94, 0x89, 0x43,
+		0x5d, 0xb7, 0xf7, 0x03, 0xc7, 0x22, 0xc8, 0x1a, 0xb1, 0xf1, 0xc3, 0x01, 0x8b, 0x63, 0x76, 0x43,
+		0xe8, 0x30, 0xe0, 0xa4, 0x1f, 0x13, 0x3a, 0xbc, 0x98, 0x95, 0xf7, 0xcd, 0x9a, 0x53, 0x1d, 0x43,
+		0xfe, 0xaf, 0xa3, 0xab, 0x6d, 0x3c, 0xdd, 0x8a, 0x87, 0xcf, 0xf6, 0x75, 0x8d, 0x62, 0x12, 0x29,
+		0x4b, 0x29, 0x0c, 0x10, 0x89, 0x39, 0x90, 0xc1, 0xec, 0x79, 0x40, 0x38, 0x50, 0x26, 0x20, 0xa5,
+		0xe4, 0x3f, 0x29, 0xce, 0x8b, 0x3a, 0x96, 0x96, 0x7f, 0xb7, 0xc5, 0x4a, 0x77, 0x66, 0xed, 0x84,
+		0x73, 0x0b, 0x38, 0xe6, 0xfc, 0xfa, 0x3b, 0xd0, 0xa3, 0x6d, 0xb8, 0xee, 0x82, 0x36, 0xdd, 0x45,
+		0x26, 0x5d, 0x37, 0x5c, 0xaf, 0xc4, 0x96, 0x87, 0x56, 0xbf, 0x94, 0xe2, 0x3b, 0xed, 0xea, 0x9c,
+		0x4d, 0x39, 0xde, 0x4a, 0xaf, 0x38, 0xb5, 0x2d, 0x05, 0x17, 0x7d, 0x0c, 0x09, 0x9e, 0x60, 0x24,
+		0x49, 0xf2, 0xae, 0xf1, 0xc2, 0x96, 0x3b, 0x55, 0xbf, 0x04, 0xd3, 0xf7, 0xdd, 0x31, 0xe5, 0x5e,
+		0xa3, 0xb1, 0x4a, 0xab, 0x07, 0x97, 0x2d, 0x2e, 0xab, 0xd0, 0xe3, 0x77, 0x6c, 0x02, 0x31, 0xbe,
+		0xc6, 0x31, 0x10, 0x0e, 0x7a, 0x41, 0x51, 0xbf, 0xc5, 0x5d, 0x6f, 0x73, 0x97, 0x36, 0xf7, 0xb5,
+		0xef, 0x51, 0xfd, 0xd4, 0xb7, 0xfa, 0xa2, 0x4a, 0xbc, 0x6b, 0x65, 0x5e, 0xb5, 0x35, 0xe7, 0xb1,
+		0xdd, 0x8f, 0xd6, 0x3a, 0x58, 0xbf, 0xdb, 0xc2, 0xbe, 0x5a, 0x6a, 0xe9, 0x7e, 0xa0, 0xd4, 0xa8,
+		0x95, 0x5d, 0x15, 0x35, 0xeb, 0xf9, 0xa8, 0xe3, 0x83, 0x2d, 0xec, 0xb3, 0x75, 0x09, 0x1c, 0x87,
+		0x8c, 0x46, 0x20, 0x66, 0x27, 0x3c, 0xeb, 0x9e, 0x0a, 0x01, 0x28, 0x2d, 0x8e, 0x51, 0x25, 0x96,
+		0x69, 0xe5, 0x6d, 0x1e, 0xc7, 0xca, 0xdb, 0xf0, 0x3a, 0x22, 0x02, 0xf8, 0x74, 0xdc, 0x67, 0x31,
+		0xf0, 0x11, 0x4b, 0xe3, 0x28, 0x47, 0xa1, 0x6b, 0x22, 0x19, 0xfd, 0xca, 0xd3, 0xd7, 0xdb, 0x2b,
+		0xe7, 0xf6, 0xc9, 0xa5, 0x58, 0xef, 0x6d, 0xf6, 0xc8, 0xed, 0x5d, 0x1f, 0xcb, 0x20, 0xd9, 0xd8,
+		0xbc, 0xe8, 0xe6, 0x33, 0x5c, 0xd7, 0xb5, 0x71, 0xbb, 0xe7, 0x73, 0x93, 0x2b, 0xa8, 0x95, 0x75,
+		0x12, 0x2d, 0x4f, 0x23, 0xd5, 0xe3, 0x0c, 0xfd, 0x83, 0xcb, 0x97, 0x59, 0xe2, 0x0d, 0xec, 0x94,
+		0x79, 0x03, 0xbb, 0xb5, 0x78, 0x03, 0x1f, 0xa2, 0x33, 0xb0, 0x2e, 0x5f, 0x60, 0x69, 0x9c, 0xb0,
+		0x79, 0xfb, 0x4d, 0x83, 0xb6, 0x9b, 0x86, 0xb9, 0x1e, 0x66, 0xe6, 0x51, 0x73, 0xa3, 0xfe, 0x3c,
+		0x81, 0xa1, 0xd3, 0x31, 0x2d, 0x25, 0xe6, 0x1a, 0xdd, 0x6a, 0x1f, 0xd5, 0x7a, 0x67, 0x66, 0xdb,
+		0xb5, 0x7f, 0xdd, 0x6e, 0x33, 0x5f, 0xb7, 0xde, 0x20, 0x15, 0xe3, 0x9c, 0xf8, 0x6e, 0xdf, 0x2c,
+		0x29, 0xbe, 0xdb, 0x37, 0xa7, 0x66, 0x44, 0xd9, 0x18, 0xe5, 0x2c, 0x60, 0x83, 0x8c, 0xb8, 0x11,
+		0x81, 0xc7, 0x95, 0xf3, 0xe3, 0x3d, 0x61, 0xab, 0x4a, 0xd8, 0xca, 0x9b, 0x5a, 0xa9, 0x58, 0x03,
+		0xf3, 0xbe, 0x56, 0x26, 0xa1, 0x09, 0xcb, 0xbd, 0xac, 0x47, 0x19, 0x70, 0xd8, 0xc4, 0x35, 0xf8,
+		0x5e, 0xd6, 0xee, 0xb0, 0x64, 0x0d, 0x53, 0x86, 0x44, 0xc9, 0xe7, 0x51, 0x3a, 0xa7, 0x95, 0x74,
+		0x7c, 0xa6, 0xcd, 0xf2, 0x91, 0x74, 0xcf, 0xcf, 0x7d, 0xaa, 0x8d, 0xe1, 0xfc, 0xb2, 0x44, 0xca,
+		0xaf, 0x42, 0xbb, 0x44, 0xca, 0xaf, 0x42, 0xeb, 0xe8, 0x98, 0xcc, 0x23, 0x30, 0x53, 0x5a, 0x80,
+		0x50, 0x10, 0x19, 0x65, 0xaf, 0x9b, 0xa6, 0x77, 0x3d, 0x4d, 0x7f, 0xb0, 0x34, 0xdd, 0x27, 0x50,
+		0x3e, 0x71, 0xb2, 0xee, 0x13, 0x28, 0x8d, 0xa9, 0xba, 0x53, 0x11, 0xba, 0x6d, 0xfd, 0x82, 0x7d,
+		0x1d, 0xba, 0x45, 0x43, 0x70, 0x66, 0x87, 0x3d, 0xd9, 0xaa, 0xd1, 0x42, 0x99, 0x75, 0xf8, 0xfb,
+		0xdf, 0xe5, 0x22, 0xba, 0xa9, 0xf4, 0xf7, 0x8f, 0x37, 0xd7, 0xe2, 0xd5, 0x3c, 0x1e, 0x50, 0x2b,
+		0xf1, 0xd6, 0x69, 0x16, 0x95, 0x8c, 0xf6, 0xeb, 0x6d, 0xe6, 0x86, 0x97, 0x63, 0x62, 0xbf, 0x97,
+		0x82, 0x4f, 0x80, 0x44, 0x20, 0xd8, 0x24, 0x50, 0xd6, 0xf6, 0xcd, 0x46, 0xfc, 0xd5, 0xa1, 0x65,
+		0x96, 0x7c, 0x39, 0x03, 0x90, 0x50, 0x22, 0xd1, 0xdc, 0x9c, 0x7f, 0x48, 0x99, 0x80, 0x04, 0x87,
+		0x6c, 0x3c, 0xc6, 0x34, 0xc2, 0x11, 0xf4, 0x53, 0x31, 0x8b, 0xb6, 0xe0, 0xe9, 0x64, 0xc2, 0x12,
+		0x81, 0xa3, 0xa3, 0x0d, 0x96, 0xfa, 0xce, 0x26, 0x4b, 0x7d, 0xe7, 0xc9, 0x5a, 0xea, 0x37, 0xca,
+		0x23, 0xe5, 0xf2, 0xc7, 0x36, 0x79, 0x43, 0x56, 0xb9, 0x17, 0x38, 0xa1, 0x1b, 0x05, 0x8c, 0xd6,
+		0x87, 0xcb, 0xe0, 0x8f, 0xab, 0xcf, 0x5f, 0xdd, 0x05, 0x1f, 0x3a, 0xc1, 0xd7, 0x57, 0xcf, 0x5b,
+		0x96, 0xfe, 0xac, 0x47, 0x94, 0x5b, 0xb5, 0x1e, 0xb7, 0x08, 0x97, 0x6e, 0xb7, 0xcd, 0x08, 0xa5,
+		0xff, 0x5e, 0x8a, 0x45, 0x84, 0x8b, 0x15, 0x2c, 0xb2, 0xf5, 0x63, 0x75, 0xbc, 0x1f, 0x6b, 0xe9,
+		0xa3, 0x8e, 0x3f, 0x20, 0x91, 0x99, 0xe9, 0x37, 0x20, 0xa6, 0x9e, 0xac, 0x2c, 0x6c, 0x85, 0x44,
+		0x98, 0x0a, 0x32, 0x20, 0x38, 0x51, 0xae, 0xca, 0x5c, 0x2b, 0x2c, 0x4d, 0x73, 0xeb, 0xec, 0x27,
+		0xcd, 0xcd, 0x3b, 0xb6, 0x4c, 0xce, 0xa9, 0x54, 0x6f, 0x9b, 0xd3, 0xcf, 0x0a, 0x2e, 0x88, 0xad,
+		0x3d, 0x58, 0x67, 0x7b, 0xd9, 0xd2, 0x6c, 0x75, 0x19, 0x04, 0x13, 0xac, 0x3a, 0xa6, 0x86, 0x92,
+		0x5e, 0x28, 0xc8, 0x93, 0x73, 0x01, 0x53, 0x91, 0x4c, 0x4b, 0x23, 0x66, 0x3c, 0x1c, 0x36, 0x10,
+		0x0e, 0xcb, 0xdb, 0xda, 0x98, 0xb4, 0xb1, 0x99, 0xb7, 0xad, 0x29, 0xa4, 0x59, 0x77, 0xd1, 0x89,
+		0x82, 0xac, 0x5a, 0x24, 0xf8, 0x4c, 0x1d, 0x5b, 0x4f, 0x30, 0x1b, 0x2f, 0xdf, 0x4b, 0x5d, 0x86,
+		0xdb, 0xca, 0xf7, 0x5b, 0xb5, 0xcb, 0x72, 0xad, 0xd2, 0x49, 0x9b, 0xdc, 0xae, 0x45, 0xd6, 0x16,
+		0x36, 0xb4, 0x91, 0x5f, 0x6d, 0xd2, 0x3d, 0xe4, 0x59, 0x98, 0x85, 0x0d, 0xf1, 0x1b, 0x22, 0xc2,
+		0xd1, 0x66, 0xd1, 0x28, 0xfb, 0x7b, 0x99, 0x68, 0x34, 0x46, 0x14, 0x0d, 0x71, 0x04, 0x11, 0xbe,
+		0x26, 0xe1, 0xcc, 0xfa, 0x3a, 0x40, 0xfd, 0x84, 0x84, 0x79, 0xb0, 0x10, 0xe1, 0x80, 0x28, 0xe0,
+		0x5b, 0x9d, 0xf4, 0xc1, 0x06, 0x80, 0xe6, 0x52, 0xd4, 0x42, 0xa6, 0x71, 0xc4, 0xd2, 0x7e, 0x8c,
+		0x65, 0xe6, 0x1b, 0x8e, 0x80, 0xa7, 0x7d, 0xf5, 0xb7, 0x43, 0xa9, 0x8d, 0x1c, 0xb5, 0x7b, 0xf4,
+		0x47, 0x96, 0x00, 0x67, 0x92, 0x8c, 0xca, 0xa8, 0x65, 0x08, 0x99, 0x5c, 0xee, 0x96, 0x88, 0xa9,
+		0x7a, 0xa4, 0xde, 0xaf, 0x7c, 0x96, 0xd2, 0x92, 0x12, 0x3c, 0x18, 0xe0, 0x08, 0x04, 0xcb, 0xfe,
+		0x10, 0x8c, 0x59, 0x84, 0x63, 0xb8, 0x19, 0x91, 0x70, 0x04, 0x09, 0x96, 0x6c, 0x25, 0x14, 0x5c,
+		0xcd, 0x0c, 0x47, 0x4c, 0x6e, 0x9d, 0x0d, 0x40, 0x3e, 0xa9, 0xdd, 0xa3, 0x97, 0x51, 0x44, 0x74,
+		0x63, 0xec, 0x78, 0xba, 0x34, 0x62, 0x61, 0x31, 0x44, 0x23, 0x35, 0x65, 0xb6, 0x9e, 0x1a, 0x2c,
+		0xbf, 0x09, 0xf8, 0x04, 0xcb, 0xa7, 0x8f, 0x90, 0x80, 0x10, 0x51, 0xe8, 0xab, 0x35, 0x38, 0xa6,
+		0xed, 0x1e, 0x55, 0x7e, 0xc7, 0xbc, 0x11, 0x3c, 0xa0, 0x98, 0x51, 0x0c, 0x11, 0x03, 0xa9, 0x92,
+		0x0d, 0xc9, 0xb5, 0x64, 0x12, 0x2c, 0x1d, 0xce, 0xf7, 0x48, 0x18, 0x05, 0x46, 0x67, 0x2b, 0x03,
+		0xc7, 0x31, 0xd6, 0xdf, 0x1e, 0x22, 0xb5, 0xff, 0xa9, 0x7c, 0xc2, 0x33, 0x91, 0xc7, 0x68, 0xf5,
+		0xe8, 0x24, 0xc1, 0x11, 0x09, 0xa5, 0xf8, 0x7c, 0xa4, 0xb6, 0xc8, 0x19, 0x20, 0x0a, 0x48, 0xbe,
+		0x94, 0x7c, 0x27, 0xad, 0xe8, 0x25, 0x69, 0x8c, 0x81, 0x70, 0xf5, 0x48, 0xaa, 0x37, 0x8a, 0xe9,
+		0x80, 0x25, 0x21, 0xe6, 0xfa, 0x59, 0xf9, 0x22, 0xf2, 0x3c, 0x38, 0x20, 0x18, 0xe1, 0x78, 0x82,
+		0x93, 0x9c, 0x9d, 0xfd, 0xf6, 0xcb, 0x0f, 0xc1, 0x4f, 0xef, 0xdf, 0xa8, 0x7f, 0xff, 0x8f, 0x3a,
+		0xf0, 0xd9, 0xbe, 0xfe, 0x47, 0xde, 0x0f, 0xa6, 0x5c, 0x6e, 0x71, 0xf6, 0x00, 0x05, 0x17, 0x84,
+		0x43, 0x88, 0xb8, 0x62, 0x89, 0x7a, 0x74, 0x8f, 0xaa, 0x28, 0xe4, 0xac, 0x0f, 0x39, 0x57, 0x62,
+		0x1a, 0xa6, 0xe9, 0x18, 0x27, 0x2a, 0xd4, 0xf2, 0x5a, 0xda, 0xee, 0x78, 0x0e, 0x53, 0xf2, 0xfd,
+		0xf0, 0xad, 0xd0, 0xf0, 0x33, 0xdb, 0xde, 0x3e, 0xa4, 0x70, 0x7e, 0xd3, 0x3c, 0x29, 0x9c, 0xdf,
+		0xd4, 0x26, 0x85, 0xcf, 0x03, 0xbe, 0x4a, 0x25, 0xa0, 0xd2, 0xd8, 0xb0, 0xe5, 0x1e, 0xf3, 0x30,
+		0x46, 0x13, 0x79, 0x63, 0x1a, 0x65, 0x67, 0xd3, 0xf9, 0x45, 0xe6, 0xb8, 0x09, 0xb6, 0xca, 0x77,
+		0x7b, 0x2d, 0x3c, 0x31, 0x10, 0x0f, 0x4f, 0x12, 0x1a, 0x88, 0xbd, 0x45, 0x64, 0xcc, 0xee, 0x2e,
+		0xf8, 0x84, 0xa7, 0x5b, 0x87, 0xae, 0x87, 0x18, 0x35, 0xcd, 0xce, 0xbb, 0x27, 0xb1, 0x5e, 0x96,
+		0x49, 0x25, 0x14, 0x66, 0x80, 0x34, 0x07, 0xa1, 0x87, 0x10, 0xb1, 0x31, 0x10, 0x8f, 0xcf, 0xbb,
+		0x37, 0x10, 0xcd, 0xf3, 0xee, 0x4d, 0x11, 0x1d, 0x06, 0x73, 0x7d, 0xdf, 0xbb, 0xf9, 0x9a, 0xe6,
+		0xd2, 0xda, 0x97, 0x9b, 0xef, 0xec, 0x09, 0xba, 0xf9, 0x0c, 0x00, 0xb8, 0xcc, 0x8e, 0xbc, 0xfc,
+		0x69, 0x7d, 0x40, 0xc1, 0x5f, 0x97, 0xc1, 0x1f, 0x1f, 0xaf, 0xb2, 0x7f, 0x74, 0x82, 0xaf, 0x7b,
+		0xbd, 0xe0, 0x63, 0xfb, 0xea, 0xb9, 0x29, 0xad, 0x69, 0x7f, 0x69, 0xb7, 0xbf, 0x7c, 0xf8, 0xf3,
+		0xf6, 0xbf, 0xae, 0xda, 0xcf, 0xbf, 0xb4, 0x3f, 0xfc, 0x39, 0x7e, 0xab, 0xfe, 0xd1, 0xfe, 0xf0,
+		0x67, 0xfc, 0xf3, 0x55, 0xfb, 0x79, 0xeb, 0x5e, 0x82, 0x52, 0xae, 0x33, 0x30, 0x31, 0x4f, 0x11,
+		0x4c, 0xb1, 0x03, 0xd3, 0x52, 0xf3, 0x16, 0xb8, 0x95, 0x09, 0xf7, 0xf3, 0x0c, 0xeb, 0xa1, 0x32,
+		0xac, 0xb7, 0x88, 0x46, 0x48, 0xb0, 0x64, 0x5a, 0x4e, 0x94, 0x7d, 0xe8, 0xca, 0x76, 0x02, 0xee,
+		0x79, 0xda, 0xca, 0x91, 0x74, 0x3b, 0x3e, 0x76, 0xc5, 0x74, 0xbe, 0x53, 0xec, 0x8a, 0x09, 0x95,
+		0xf6, 0x31, 0x2c, 0xd2, 0x2a, 0xa9, 0xcd, 0x5f, 0x27, 0xd9, 0x8f, 0x32, 0x6b, 0x00, 0xac, 0x35,
+		0x54, 0xfe, 0xae, 0x26, 0x7f, 0xcc, 0x7e, 0x5c, 0xce, 0xd6, 0xa8, 0xe0, 0xbe, 0x89, 0x0c, 0xf2,
+		0xff, 0xe7, 0xad, 0x6f, 0x4b, 0x13, 0xed, 0x97, 0x59, 0x7a, 0xcc, 0xe8, 0x10, 0x0a, 0xd3, 0x60,
+		0x40, 0x70, 0x1c, 0x35, 0xc1, 0x7b, 0xf3, 0xb4, 0x6d, 0x16, 0x3b, 0xf1, 0x22, 0x1e, 0x1f, 0x54,
+		0x65, 0x99, 0x3b, 0x4b, 0x90, 0x7b, 0x3a, 0xc9, 0x71, 0xa7, 0x9d, 0xee, 0x99, 0xcf, 0x8d, 0x83,
+		0x59, 0xdf, 0xe8, 0xc0, 0xcc, 0x41, 0xbd, 0x30, 0xda, 0x8c, 0xb6, 0x65, 0x53, 0xb4, 0x7f, 0x5a,
+		0x30, 0x48, 0x39, 0x06, 0x42, 0x41, 0xda, 0xf6, 0x59, 0x02, 0x3f, 0xfc, 0xfc, 0xc6, 0x93, 0x39,
+		0x4f, 0xe6, 0x3c, 0x99, 0xdb, 0x0d, 0x99, 0x7b, 0xe9, 0x13, 0x80, 0x01, 0xa0, 0x35, 0x62, 0x5c,
+		0x04, 0x31, 0x0b, 0x51, 0x1c, 0xa0, 0xa1, 0x46, 0x9f, 0x12, 0x42, 0xb7, 0x32, 0xc3, 0x54, 0x90,
+		0x0b, 0x51, 0x0c, 0x6a, 0x06, 0xbc, 0xff, 0xad, 0x11, 0xa4, 0x6d, 0xad, 0x6f, 0xb1, 0xe1, 0xa4,
+		0x6d, 0x9d, 0xef, 0x71, 0xd7, 0xa4, 0x2d, 0x4d, 0xc8, 0xae, 0x83, 0xc0, 0x94, 0x53, 0xdf, 0x28,
+		0x1c, 0x71, 0x36, 0xd2, 0x10, 0xec, 0x08, 0xfd, 0x34, 0x8f, 0x44, 0x00, 0x35, 0xdb, 0xc3, 0xde,
+		0x7d, 0xc3, 0x9e, 0x81, 0xd1, 0xee, 0x1e, 0xe3, 0xc3, 0xf8, 0xf8, 0xa2, 0x18, 0x6b, 0xb2, 0xfc,
+		0xbb, 0x04, 0xbe, 0x0a, 0xa0, 0x2e, 0x23, 0x49, 0xca, 0xc1, 0x5c, 0x8d, 0x32, 0x2d, 0xb7, 0x20,
+		0x07, 0x6b, 0x4f, 0xad, 0xde, 0x67, 0x1b, 0xde, 0x8d, 0x08, 0x87, 0x85, 0x68, 0x6e, 0x04, 0x31,
+		0x13, 0x80, 0xa2, 0x6b, 0x44, 0x43, 0x1c, 0xc1, 0x20, 0xa5, 0xa1, 0x8e, 0xb3, 0x21, 0x62, 0x9a,
+		0x87, 0x79, 0x64, 0x91, 0x25, 0x32, 0xde, 0x87, 0x24, 0x5c, 0x00, 0x11, 0x40, 0xf8, 0x72, 0x6c,
+		0x90, 0x0c, 0x0c, 0x3a, 0xd6, 0x51, 0x43, 0x21, 0x1a, 0xe2, 0x40, 0x77, 0x43, 0x86, 0x3e, 0x56,
+		0xb9, 0xa0, 0xb0, 0x10, 0x7d, 0xb9, 0x70, 0x6c, 0x72, 0x97, 0x3d, 0x7a, 0xd8, 0x9f, 0xe6, 0x5d,
+		0x0d, 0x8e, 0x54, 0xee, 0xc3, 0x7f, 0x52, 0x14, 0x4b, 0xcf, 0xa1, 0xdc, 0x53, 0x22, 0x46, 0x72,
+		0xa9, 0x59, 0xe4, 0x0f, 0xc7, 0x34, 0x5b, 0x43, 0xbf, 0xe3, 0x61, 0xca, 0xe5, 0x53, 0xe6, 0x51,
+		0x34, 0x6a, 0x1b, 0xa8, 0x10, 0x77, 0x73, 0xa4, 0x83, 0x7c, 0xb2, 0x2a, 0x4a, 0xca, 0x83, 0x2d,
+		0xdf, 0x40, 0x6f, 0xf2, 0x58, 0x3d, 0x90, 0xf0, 0x59, 0x80, 0x0f, 0x8e, 0x66, 0x0f, 0x2b, 0xbc,
+		0x4a, 0xf6, 0x60, 0x44, 0xa3, 0x62, 0x74, 0x93, 0x7a, 0x89, 0x1e, 0x15, 0xa3, 0x44, 0xc5, 0x08,
+		0xa1, 0xf9, 0x2e, 0xe4, 0x71, 0x63, 0xc8, 0x27, 0x23, 0x69, 0xe2, 0x01, 0x7c, 0x1b, 0xea, 0x28,
+		0x24, 0x0c, 0x63, 0x74, 0x1b, 0x84, 0x23, 0x44, 0x29, 0x8e, 0x39, 0xb0, 0x81, 0x7e, 0x1c, 0x4b,
+		0x12, 0xcc, 0x27, 0x8c, 0x46, 0x84, 0x0e, 0x7b, 0x74, 0xe5, 0x9c, 0xb2, 0x58, 0x25, 0x1d, 0xca,
+		0xb4, 0xb8, 0xe1, 0xf9, 0x06, 0xb2, 0x63, 0xd4, 0x6f, 0x25, 0x46, 0x98, 0xc2, 0x98, 0x25, 0x6a,
+		0x52, 0x48, 0x06, 0x24, 0x54, 0x21, 0x54, 0x85, 0x89, 0xfa, 0xec, 0xf4, 0xbe, 0x7b, 0x54, 0x6e,
+		0x1c, 0x02, 0x20, 0xf3, 0xe4, 0x13, 0x46, 0x71, 0xbe, 0x3d, 0xb9, 0x72, 0x20, 0x2f, 0x7a, 0x25,
+		0x0e, 0xa8, 0xb0, 0x6d, 0x58, 0xb7, 0xeb, 0xef, 0x71, 0x88, 0x94, 0x1e, 0x93, 0x81, 0xce, 0x58,
+		0x62, 0x7a, 0xc4, 0x92, 0xa9, 0x36, 0xdc, 0x1c, 0x17, 0x1f, 0x98, 0x6d, 0x14, 0x47, 0x79, 0x7c,
+		0x3f, 0x57, 0x8a, 0x10, 0x19, 0x63, 0x40, 0x7c, 0x1e, 0x75, 0x45, 0xb8, 0x06, 0xae, 0x1e, 0x0d,
+		0x13, 0x55, 0x07, 0x4e, 0x9f, 0xb7, 0x54, 0x93, 0x28, 0xc6, 0x11, 0x07, 0xc1, 0x20, 0xc1, 0x22,
+		0x21, 0xf8, 0x1a, 0x43, 0xe6, 0x02, 0x22, 0x11, 0x70, 0xac, 0x6b, 0x8f, 0xa8, 0x23, 0xcc, 0xa3,
+		0x9c, 0xfa, 0x78, 0xc0, 0x12, 0x3d, 0x4a, 0xad, 0x8d, 0x6f, 0x65, 0xd4, 0xa3, 0x3a, 0x6d, 0x1d,
+		0x2c, 0x17, 0xc9, 0x53, 0xc8, 0x8f, 0x58, 0x0e, 0xbb, 0xfc, 0xf5, 0x4d, 0x16, 0xb9, 0x26, 0xff,
+		0x9a, 0xa8, 0x88, 0xaa, 0xfe, 0x74, 0x7e, 0x4a, 0xf3, 0x98, 0xb0, 0x59, 0xe8, 0xd5, 0x71, 0x0e,
+		0x3a, 0x3d, 0xba, 0x38, 0x24, 0x88, 0xf0, 0x00, 0xa5, 0x71, 0x76, 0xaa, 0x12, 0x9a, 0xe5, 0x7d,
+		0xe2, 0xf1, 0x84, 0x25, 0x28, 0x21, 0xf1, 0x14, 0xa2, 0x54, 0x2a, 0x11, 0x72, 0x2a, 0x10, 0x4a,
+		0x04, 0x41, 0x31, 0x4c, 0x12, 0xd6, 0x57, 0xf7, 0x72, 0x33, 0x42, 0x62, 0xfe, 0x7a, 0x3d, 0x9a,
+		0xbf, 0x52, 0x56, 0xe8, 0xab, 0x8f, 0x8f, 0x9a, 0x10, 0xc9, 0xf3, 0xb4, 0xf9, 0x5a, 0x69, 0x24,
+		0x4f, 0x81, 0xcc, 0x98, 0xbb, 0x42, 0x8b, 0x93, 0xec, 0x1c, 0xa2, 0x2b, 0xa1, 0xf0, 0x85, 0xa5,
+		0x72, 0x5c, 0xcf, 0x99, 0xc7, 0x32, 0xa9, 0x7b, 0x08, 0x2e, 0xd3, 0xad, 0xd0, 0x66, 0x0b, 0x75,
+		0xce, 0xd0, 0xe7, 0x0c, 0x85, 0xd6, 0xd0, 0xb8, 0x1d, 0x2a, 0x4b, 0xa0, 0xd3, 0x5c, 0xb2, 0x72,
+		0x90, 0xb0, 0x6c, 0x24, 0x2d, 0x23, 0x89, 0x4b, 0x82, 0xa5, 0xfc, 0x59, 0x04, 0xfe, 0x4a, 0x6f,
+		0xbd, 0xdb, 0x7e, 0x1d, 0x8b, 0x84, 0x7e, 0xaf, 0x00, 0xb3, 0x7c, 0x7a, 0x1f, 0xbe, 0x5b, 0x15,
+		0x58, 0xbf, 0xf9, 0x3f, 0x32, 0x04, 0xf8, 0xa4, 0xdd, 0x96, 0xff, 0xe5, 0x5f, 0x5e, 0xcd, 0xce,
+		0xf9, 0x3b, 0x9b, 0x83, 0x36, 0x38, 0xec, 0x9a, 0x8e, 0x31, 0xe7, 0x97, 0x7b, 0x3d, 0xce, 0xce,
+		0xae, 0xdb, 0x9d, 0x34, 0xb1, 0xf9, 0xc7, 0x42, 0x75, 0xf0, 0xfa, 0x01, 0xea, 0x18, 0xda, 0xfb,
+		0x6f, 0x0c, 0x22, 0x37, 0xb2, 0xc0, 0xf0, 0x66, 0x35, 0xed, 0x13, 0x36, 0x2e, 0x11, 0x67, 0x7d,
+		0xd7, 0x10, 0x51, 0xf9, 0x08, 0x1f, 0x4d, 0x9b, 0x89, 0x8d, 0xa7, 0x90, 0x55, 0x3e, 0x06, 0x42,
+		0xb7, 0x9c, 0xc3, 0xc9, 0x16, 0x55, 0x7e, 0xd7, 0xf0, 0xd4, 0xbc, 0xf6, 0x12, 0x75, 0x9c, 0xe5,
+		0x63, 0x6d, 0x2b, 0xb1, 0x45, 0x71, 0xca, 0xec, 0x07, 0xf6, 0xaa, 0xc3, 0xe2, 0xbc, 0xaa, 0xda,
+		0xc3, 0xc2, 0x6a, 0x65, 0x0a, 0x44, 0x66, 0xc3, 0xd0, 0xba, 0xae, 0x83, 0x59, 0x64, 0xcd, 0xc2,
+		0x81, 0xd7, 0x4c, 0xbc, 0x66, 0x02, 0xbe, 0x5e, 0xe4, 0x2e, 0xfd, 0x96, 0xee, 0xfe, 0x4b, 0xc3,
+		0x5b, 0x76, 0xf6, 0xdd, 0xae, 0x1c, 0xc9, 0xe9, 0x0b, 0x1f, 0x9c, 0x69, 0x85, 0x5f, 0x4d, 0x54,
+		0x3d, 0x34, 0x33, 0x39, 0x6c, 0x1f, 0x65, 0x1d, 0xa5, 0xbe, 0xc9, 0x2c, 0xe9, 0x35, 0xa8, 0x21,
+		0x1f, 0xbe, 0x2b, 0x48, 0x1a, 0xf3, 0xa1, 0xc5, 0x6f, 0xd5, 0xd8, 0x02, 0x2b, 0xba, 0x2f, 0x35,
+		0x65, 0x91, 0xb3, 0xe6, 0x42, 0x11, 0x96, 0xce, 0x11, 0x60, 0x09, 0xc4, 0x98, 0x4b, 0x8b, 0x38,
+		0xa2, 0x45, 0xb6, 0xd9, 0x5c, 0xb9, 0xb3, 0x99, 0x7a, 0xcc, 0xae, 0xce, 0xf8, 0x91, 0x29, 0x3a,
+		0x8b, 0xc7, 0xa4, 0x25, 0x36, 0xbe, 0x70, 0x26, 0x6c, 0xe0, 0xf5, 0x9d, 0x0a, 0x90, 0xe7, 0x78,
+		0xa4, 0x4f, 0x50, 0xed, 0x89, 0x5c, 0x9a, 0xcb, 0x99, 0x13, 0x9f, 0x4a, 0xc1, 0xe6, 0xcb, 0x92,
+		0xa5, 0xcf, 0x1d, 0xab, 0x8e, 0x46, 0x8d, 0x4f, 0x76, 0xf6, 0xf9, 0x60, 0xcd, 0x94, 0xae, 0xf7,
+		0xa6, 0x71, 0x18, 0x07, 0xc7, 0x37, 0xe1, 0x54, 0x9a, 0x4c, 0xd9, 0x4d, 0x82, 0xeb, 0x57, 0x49,
+		0x7b, 0x79, 0x90, 0xfd, 0x26, 0xda, 0xee, 0x1a, 0x6c, 0xef, 0xc9, 0xbc, 0x27, 0xf3, 0x9e, 0xcc,
+		0x3f, 0x31, 0x32, 0xff, 0xd2, 0x67, 0xfd, 0xd6, 0x41, 0xe4, 0x55, 0xec, 0x9d, 0x39, 0x75, 0xd7,
+		0xc3, 0xed, 0xc8, 0x7a, 0xa8, 0xc8, 0x6d, 0x9a, 0xe0, 0xac, 0x1c, 0x9c, 0x5a, 0xa3, 0x0d, 0xff,
+		0x56, 0x21, 0x80, 0x59, 0xf0, 0xa2, 0xd2, 0xf4, 0x59, 0x1f, 0xcf, 0xa3, 0xf6, 0x54, 0xd4, 0x64,
+		0x16, 0xa4, 0xa7, 0xfd, 0x0b, 0xf3, 0x90, 0xd2, 0x40, 0x87, 0xfb, 0xf5, 0xe8, 0x18, 0x23, 0xca,
+		0x81, 0xe8, 0x2a, 0x72, 0x8c, 0xc6, 0xd3, 0xa5, 0x20, 0xc9, 0x6c, 0xf5, 0xb5, 0xae, 0x8b, 0x95,
+		0xd0, 0x48, 0xef, 0xb3, 0x78, 0xac, 0x3e, 0x8b, 0xdd, 0x16, 0xa0, 0xb8, 0xc7, 0xc8, 0x2b, 0x85,
+		0x4a, 0xdc, 0x07, 0x5d, 0xad, 0x85, 0xab, 0xfd, 0x19, 0xa7, 0x4d, 0xae, 0x01, 0x1e, 0x77, 0x48,
+		0x96, 0x3a, 0x82, 0x00, 0xa5, 0x82, 0x51, 0x3c, 0x7c, 0x8a, 0xe1, 0x59, 0x35, 0x86, 0x66, 0xd9,
+		0x81, 0xdd, 0x71, 0x56, 0x2b, 0xf2, 0x50, 0x0d, 0x3c, 0xba, 0x27, 0xef, 0x88, 0xda, 0x8b, 0x0f,
+		0xdf, 0xb2, 0xc6, 0x1b, 0xc7, 0xc3, 0x7b, 0x5c, 0xfe, 0x8c, 0xc5, 0xf7, 0xf7, 0x21, 0x5b, 0x75,
+		0x40, 0x91, 0x0f, 0xd6, 0x72, 0x2a, 0x76, 0xb4, 0x98, 0x64, 0x66, 0x10, 0x99, 0xe5, 0x2b, 0x1f,
+		0xad, 0x56, 0x3e, 0x2a, 0xc1, 0xcd, 0xb2, 0xa2, 0x47, 0xbf, 0x6e, 0x04, 0x4a, 0xb3, 0xf4, 0x4d,
+		0xdd, 0x91, 0xaa, 0x34, 0x7f, 0x73, 0x53, 0xe3, 0xaa, 0x35, 0x9c, 0xb1, 0xf5, 0xcb, 0x64, 0xb9,
+		0xfd, 0x7f, 0x23, 0xda, 0x64, 0xfb, 0x54, 0xae, 0xed, 0xa9, 0x5c, 0x8c, 0x52, 0x2c, 0x13, 0x2b,
+		0x2d, 0xa2, 0x31, 0x67, 0x53, 0xec, 0x8c, 0x1c, 0x12, 0x3a, 0x52, 0x5e, 0x8c, 0xb7, 0xbc, 0x80,
+		0x74, 0xf2, 0x25, 0x62, 0x37, 0xd4, 0xdb, 0x15, 0x7c, 0x2c, 0x64, 0x05, 0xab, 0xf5, 0x2e, 0x9a,
+		0x0f, 0x23, 0x2e, 0x82, 0x39, 0xa4, 0x9b, 0x37, 0x21, 0x5e, 0x9c, 0x67, 0x87, 0x21, 0x3f, 0x23,
+		0x2e, 0xe0, 0x13, 0x65, 0x37, 0x54, 0xe7, 0x0f, 0xcf, 0x43, 0x86, 0xe1, 0x06, 0x71, 0x30, 0x5e,
+		0xd6, 0xa3, 0xcb, 0xd3, 0x41, 0x97, 0x08, 0x09, 0x1c, 0x20, 0x1a, 0x05, 0x12, 0x62, 0xea, 0xf6,
+		0xf5, 0x58, 0x57, 0x7d, 0xee, 0xf5, 0xa2, 0xcf, 0x67, 0x77, 0x81, 0xfc, 0xd1, 0xcd, 0x7f, 0xbc,
+		0xd3, 0x3f, 0x2e, 0x16, 0x7e, 0x1c, 0xf6, 0x7a, 0xed, 0x5e, 0x2f, 0xfa, 0xdb, 0xd1, 0xb7, 0x87,
+		0x7f, 0x7c, 0xf9, 0xd0, 0xeb, 0xfd, 0xad, 0xd7, 0x0b, 0xae, 0x16, 0x46, 0x1c, 0xb5, 0x1a, 0x22,
+		0xf6, 0xd6, 0x26, 0xf3, 0x6d, 0x93, 0xa6, 0xc0, 0x40, 0xe8, 0xfb, 0x7d, 0x7d, 0xef, 0x52, 0x30,
+		0x97, 0xfa, 0xb4, 0x5e, 0x63, 0x52, 0xa0, 0x66, 0x3e, 0xb4, 0x6a, 0xc7, 0xbc, 0xb5, 0x6d, 0x80,
+		0x0c, 0x49, 0x95, 0xcf, 0xea, 0xbf, 0x8f, 0x4a, 0x49, 0x11, 0x1b, 0x23, 0x42, 0xcb, 0xc2, 0x13,
+		0x7c, 0x25, 0xb8, 0x46, 0x55, 0x82, 0xeb, 0x9e, 0x7f, 0xf5, 0x78, 0x4a, 0xc1, 0x1d, 0x1f, 0x54,
+		0x66, 0x49, 0xad, 0xc3, 0xc3, 0xc3, 0x79, 0xeb, 0x81, 0x8f, 0x57, 0x87, 0x8b, 0x7d, 0x08, 0xae,
+		0x8e, 0x3e, 0x77, 0x8e, 0x5f, 0x9c, 0xde, 0x1d, 0x7d, 0x3b, 0xff, 0xfe, 0xaa, 0xd7, 0x6b, 0x1f,
+		0x3d, 0x77, 0x99, 0xf5, 0xed, 0xd1, 0x97, 0x5e, 0xaf, 0xdd, 0xaa, 0x5e, 0xdb, 0xce, 0xa4, 0x33,
+		0xdf, 0x26, 0xd2, 0xdc, 0xc8, 0xde, 0x7c, 0xef, 0x69, 0x84, 0x93, 0x18, 0x4d, 0x7d, 0x4b, 0xbe,
+		0x65, 0x71, 0xc0, 0xa8, 0x27, 0xdf, 0x82, 0x00, 0x60, 0xde, 0x94, 0x2f, 0x33, 0x81, 0x97, 0xb4,
+		0xe6, 0x5b, 0xeb, 0x65, 0x58, 0xd3, 0xa0, 0x4f, 0x8e, 0x9a, 0xb7, 0xae, 0x83, 0x00, 0x46, 0x38,
+		0xc1, 0x2b, 0x89, 0x92, 0x1c, 0x50, 0x82, 0x21, 0x26, 0x9f, 0x30, 0x20, 0x88, 0x49, 0x3f, 0x41,
+		0xc9, 0x54, 0xce, 0x9a, 0xc8, 0x36, 0xe1, 0xb3, 0xf0, 0x06, 0x3d, 0x43, 0x27, 0x55, 0x4e, 0xd5,
+		0x0c, 0x95, 0x9f, 0x99, 0xe8, 0x4e, 0x7b, 0x59, 0x49, 0xa2, 0x67, 0x7a, 0xd4, 0x33, 0x20, 0xd2,
+		0x7f, 0x45, 0x43, 0xcc, 0x75, 0x9c, 0x83, 0xea, 0x6e, 0x87, 0x54, 0xad, 0xa1, 0x18, 0x89, 0x59,
+		0xc3, 0x3d, 0x3e, 0xef, 0xa3, 0xa7, 0xfd, 0x3d, 0xb2, 0xbe, 0x15, 0x12, 0x30, 0x46, 0x2a, 0xd8,
+		0x41, 0xd5, 0x27, 0xba, 0x19, 0x61, 0x0a, 0xf9, 0x3e, 0xe6, 0x36, 0x5e, 0x2c, 0xab, 0x26, 0xfd,
+		0x43, 0xbe, 0xce, 0x0d, 0xd6, 0xcb, 0x13, 0x01, 0x68, 0x21, 0x7a, 0x5a, 0xae, 0x8d, 0xa0, 0x18,
+		0xb3, 0xa7, 0x37, 0x83, 0x62, 0xce, 0xd6, 0x75, 0x95, 0x52, 0xad, 0xf0, 0x7a, 0xb4, 0xf8, 0x70,
+		0xc1, 0x00, 0x25, 0x7d, 0x22, 0x64, 0x79, 0x24, 0x59, 0x20, 0x09, 0x71, 0x4e, 0x86, 0x34, 0x0f,
+		0xbd, 0x40, 0x02, 0x92, 0x94, 0xae, 0x11, 0xdf, 0x77, 0xd3, 0x8a, 0x6e, 0xdc, 0xc0, 0x56, 0x74,
+		0x63, 0xdf, 0x8a, 0xce, 0xd7, 0x3b, 0xf6, 0xad, 0xe8, 0xb6, 0xdc, 0x84, 0x0f, 0xdb, 0xad, 0x0a,
+		0x6b, 0xd6, 0x30, 0xb7, 0x1d, 0xf6, 0x4a, 0x60, 0xd0, 0x5c, 0xed, 0x02, 0xf0, 0xad, 0xe8, 0x7c,
+		0xfc, 0x2e, 0x80, 0x6f, 0x45, 0xe7, 0x5b, 0xd1, 0xd9, 0xc0, 0x9a, 0x6f, 0x45, 0x07, 0xe0, 0x19,
+		0x96, 0x3d, 0x9a, 0xf9, 0x56, 0x74, 0x9e, 0xa7, 0xd9, 0x02, 0xc5, 0x12, 0x80, 0xf8, 0x56, 0x74,
+		0x56, 0xa3, 0x7c, 0x2b, 0xba, 0x06, 0x07, 0x64, 0xe5, 0x19, 0x03, 0xc5, 0x5f, 0xaa, 0xb4, 0xa5,
+		0x7b, 0x2b, 0x57, 0xf8, 0x58, 0xfc, 0xb7, 0x6f, 0x50, 0xe7, 0xc0, 0xcc, 0xbd, 0x25, 0x03, 0x7c,
+		0xe7, 0xa6, 0xda, 0x79, 0x82, 0x6f, 0x50, 0x57, 0x85, 0x97, 0xf9, 0x06, 0x75, 0x9e, 0xcc, 0x79,
+		0x32, 0xe7, 0xc9, 0xdc, 0x2e, 0x72, 0xd0, 0x1f, 0x39, 0x91, 0xdb, 0x43, 0xbb, 0x24, 0x08, 0x40,
+		0xac, 0xeb, 0x97, 0x44, 0xb8, 0x50, 0x0d, 0x86, 0x08, 0xcd, 0x7e, 0x6b, 0xc3, 0x6b, 0x94, 0xb7,
+		0x22, 0x1a, 0x21, 0xbe, 0xe8, 0xee, 0xec, 0xd1, 0x55, 0x7f, 0x67, 0x23, 0x3a, 0xb2, 0x8c, 0x1f,
+		0x60, 0xec, 0xd6, 0xf8, 0xb1, 0x76, 0x64, 0x99, 0x7b, 0x27, 0x66, 0x85, 0x03, 0xd0, 0x10, 0x43,
+		0x00, 0x93, 0xd1, 0x94, 0xcb, 0x9e, 0x4d, 0x1a, 0xba, 0x18, 0xdd, 0x1e, 0xfd, 0xb7, 0x0c, 0x49,
+		0xf7, 0x1b, 0xb0, 0x3c, 0x7e, 0x84, 0x01, 0xcb, 0x63, 0x5f, 0xeb, 0x78, 0xf5, 0xe3, 0x6b, 0x1d,
+		0x5b, 0xde, 0xb2, 0xb3, 0x18, 0xb0, 0x72, 0x24, 0xdd, 0xf3, 0x73, 0x6f, 0xfe, 0x33, 0x9c, 0xef,
+		0x4b, 0x4a, 0xd6, 0x40, 0xd5, 0xbd, 0x0f, 0x68, 0x3f, 0x54, 0xdd, 0xfb, 0x75, 0x9e, 0x36, 0x61,
+		0xf7, 0x25, 0x25, 0xeb, 0xa2, 0xec, 0xbe, 0xa4, 0xa4, 0x27, 0xf3, 0x9e, 0xcc, 0x7b, 0x32, 0xef,
+		0x4b, 0x4a, 0x3e, 0x5e, 0x22, 0x5f, 0xa8, 0x99, 0x6f, 0x4e, 0xe3, 0x8b, 0x93, 0xec, 0x48, 0xfc,
+		0x25, 0xf0, 0x49, 0x4c, 0x64, 0xd4, 0x1c, 0x84, 0x88, 0x42, 0x44, 0xae, 0x49, 0x84, 0x17, 0xbb,
+		0x5e, 0xb1, 0xbc, 0x94, 0x8b, 0x6e, 0x23, 0x8d, 0x61, 0xb1, 0xc9, 0x73, 0x07, 0x08, 0xd5, 0xad,
+		0xc9, 0x17, 0x5b, 0x5a, 0x53, 0x36, 0xeb, 0x0b, 0x40, 0xfe, 0x52, 0x95, 0xc6, 0x4a, 0x77, 0xa6,
+		0x96, 0x35, 0xc2, 0xf5, 0xb2, 0x26, 0x96, 0x57, 0xde, 0xaa, 0xe4, 0xad, 0x4a, 0xde, 0xaa, 0xd4,
+		0x5c, 0x0a, 0xec, 0x3b, 0x68, 0x59, 0xe2, 0xc5, 0xfd, 0x72, 0xa5, 0xac, 0x46, 0xa7, 0x5d, 0xa5,
+		0x63, 0x6e, 0xc9, 0x8b, 0xe6, 0x69, 0x7a, 0xca, 0x61, 0x85, 0x39, 0x06, 0x94, 0x60, 0x40, 0x71,
+		0x0c, 0x2a, 0xa3, 0x30, 0x9d, 0xc8, 0x11, 0x38, 0xd2, 0x63, 0x38, 0x30, 0xaa, 0xdd, 0x5a, 0xf2,
+		0x5b, 0x9d, 0x78, 0xb7, 0xe4, 0xe0, 0x9a, 0xa8, 0xbb, 0x0c, 0x51, 0xdc, 0xa3, 0x52, 0x75, 0xc9,
+		0xcb, 0x0e, 0xa8, 0x82, 0x9f, 0xda, 0xdd, 0xf5, 0x1b, 0x12, 0x23, 0x9c, 0xe8, 0xa6, 0x48, 0x29,
+		0x27, 0x74, 0x08, 0x98, 0xa6, 0x63, 0x20, 0x8a, 0x83, 0xe9, 0x2f, 0x10, 0x85, 0x37, 0xca, 0xa7,
+		0x21, 0xa6, 0xfa, 0x21, 0x37, 0x24, 0x8e, 0x01, 0x85, 0x21, 0x9e, 0x08, 0x40, 0x74, 0xda, 0xa3,
+		0xf9, 0x9f, 0x41, 0xa5, 0x16, 0x12, 0x0e, 0x7d, 0xc4, 0x71, 0x04, 0x6c, 0x30, 0xc8, 0x52, 0x16,
+		0xe5, 0x76, 0x9f, 0x65, 0x05, 0x10, 0x84, 0xca, 0xf3, 0x29, 0x04, 0x99, 0x89, 0xe9, 0xe4, 0x61,
+		0x24, 0xf9, 0x78, 0x7e, 0xb5, 0x1f, 0x7e, 0x95, 0xc3, 0x89, 0x65, 0x55, 0x64, 0x13, 0xb6, 0x95,
+		0x43, 0xea, 0xf7, 0x88, 0x5b, 0x5c, 0x91, 0x4d, 0xf9, 0xf2, 0x45, 0x02, 0xcc, 0x8d, 0x59, 0x24,
+		0x58, 0xb1, 0xc9, 0xd5, 0x6d, 0x05, 0xa7, 0x9d, 0xce, 0xb0, 0xb5, 0x0b, 0xfe, 0x53, 0x71, 0x57,
+		0x4d, 0xdc, 0x54, 0x03, 0xf7, 0xd4, 0x0d, 0xce, 0x9b, 0xb8, 0xab, 0x26, 0x6e, 0xea, 0xac, 0x91,
+		0x90, 0x7e, 0xd6, 0xc4, 0x4d, 0x35, 0xf1, 0xfa, 0x8c, 0xab, 0x7c, 0xcf, 0x36, 0x66, 0x34, 0xf2,
+		0x6a, 0xc7, 0x7c, 0xcc, 0x28, 0x2f, 0xa0, 0xa8, 0xc6, 0x14, 0xa3, 0xfe, 0xcd, 0x42, 0xc8, 0x9c,
+		0xf3, 0x04, 0xec, 0xf2, 0x05, 0xca, 0xcf, 0xa3, 0x6a, 0xed, 0x5b, 0x9f, 0x5b, 0x01, 0x00, 0x60,
+		0x9d, 0x5b, 0xe1, 0x58, 0xf8, 0x76, 0x35, 0xad, 0xa2, 0x72, 0x09, 0xdc, 0xc5, 0x7a, 0xf3, 0xc6,
+		0x25, 0xd1, 0x66, 0x13, 0x1e, 0x51, 0x61, 0xb4, 0x27, 0x1d, 0x5c, 0x67, 0x1e, 0x7c, 0x6c, 0x9e,
+		0x99, 0xef, 0xa3, 0x90, 0x1b, 0x96, 0x6c, 0xd1, 0xf1, 0xc5, 0xd1, 0x6a, 0x49, 0x89, 0x77, 0x4f,
+		0x85, 0xdf, 0x45, 0x0d, 0xb4, 0x0d, 0xb4, 0xd8, 0x57, 0x42, 0x5b, 0x44, 0x98, 0xa6, 0x56, 0x42,
+		0x5b, 0x23, 0x1f, 0x58, 0x54, 0x45, 0x5b, 0x11, 0x09, 0x4c, 0xea, 0xa3, 0x5d, 0xe3, 0x11, 0x09,
+		0xd7, 0x34, 0xc4, 0x99, 0x97, 0x49, 0xc8, 0x06, 0x94, 0x55, 0x45, 0x93, 0xa6, 0x45, 0x60, 0x03,
+		0xc8, 0xc6, 0xf3, 0x7d, 0xd4, 0xee, 0x12, 0xa7, 0x61, 0xf3, 0x8a, 0x77, 0xc9, 0x4d, 0xd5, 0x55,
+		0xbd, 0xcb, 0x44, 0x0c, 0x33, 0x96, 0xbc, 0x2e, 0x61, 0xb3, 0xec, 0x95, 0xdd, 0x9a, 0x36, 0x41,
+		0xab, 0x90, 0x17, 0x36, 0x80, 0xf7, 0xef, 0xdf, 0xbc, 0xd2, 0xb5, 0xed, 0xf8, 0x88, 0xa5, 0x71,
+		0x04, 0x93, 0x84, 0x8d, 0x27, 0x42, 0x0d, 0x79, 0xff, 0x06, 0x04, 0x83, 0x11, 0x92, 0x05, 0xe8,
+		0x7a, 0xf4, 0x27, 0x4c, 0xb1, 0xb4, 0x3f, 0xeb, 0x29, 0xfd, 0x54, 0x88, 0xcd, 0x99, 0xb6, 0xfb,
+		0x14, 0xe4, 0xd6, 0x03, 0x88, 0x29, 0xa0, 0x58, 0x03, 0x8c, 0x35, 0xe0, 0x98, 0x03, 0xd0, 0x76,
+		0x3e, 0x58, 0x5d, 0x94, 0x4b, 0x53, 0x12, 0x55, 0x95, 0xdf, 0xcc, 0xb9, 0x6d, 0x27, 0xf8, 0x1a,
+		0x05, 0x83, 0xcb, 0xe0, 0xc7, 0xab, 0xcf, 0x2f, 0xef, 0x82, 0xe2, 0xaf, 0x67, 0x36, 0xbf, 0x9e,
+		0x76, 0xef, 0x5a, 0x3b, 0x4a, 0x4f, 0xba, 0xc1, 0x49, 0x10, 0x8e, 0x18, 0x09, 0xb1, 0x49, 0x9a,
+		0x52, 0x61, 0xb4, 0x69, 0xed, 0x3c, 0x3d, 0x5c, 0x97, 0x6c, 0xbc, 0xc1, 0x09, 0x28, 0x8f, 0x08,
+		0x04, 0x8b, 0xde, 0x9c, 0x6c, 0x10, 0xa1, 0x9c, 0x44, 0x58, 0x91, 0xd8, 0x12, 0xac, 0x3a, 0xf7,
+		0x58, 0xb5, 0x7b, 0xac, 0x2a, 0xcd, 0x3e, 0x52, 0x6d, 0xf5, 0x12, 0x12, 0x06, 0xa1, 0x89, 0xcb,
+		0x61, 0x76, 0xae, 0x8b, 0xd3, 0xec, 0xdc, 0x88, 0xaf, 0xb3, 0xb9, 0x1a, 0x9a, 0x70, 0x04, 0xeb,
+		0xf9, 0xf6, 0x26, 0xb8, 0x39, 0xbb, 0x4f, 0x1f, 0xdb, 0x76, 0x78, 0xb2, 0x85, 0x2b, 0x67, 0xf8,
+		0x72, 0x86, 0x33, 0x7b, 0x78, 0xdb, 0x0e, 0x77, 0x25, 0xf0, 0x67, 0x0c, 0x87, 0xf9, 0xa7, 0xd5,
+		0x57, 0x84, 0x79, 0x6a, 0xef, 0xfc, 0xca, 0x27, 0x1a, 0xbe, 0xff, 0x12, 0x4c, 0x7e, 0xaf, 0x67,
+		0xcf, 0xca, 0xcc, 0x22, 0x9b, 0x7e, 0x9e, 0xdb, 0x93, 0x29, 0x9d, 0x01, 0xd4, 0x05, 0x50, 0x1d,
+		0x01, 0xd6, 0x15, 0x70, 0x2b, 0x03, 0x70, 0x65, 0x40, 0x76, 0x07, 0x68, 0x33, 0xc0, 0x36, 0x04,
+		0x70, 0x6b, 0x40, 0xcf, 0x3f, 0xad, 0x10, 0x4d, 0x50, 0x48, 0xc4, 0xd4, 0xbd, 0xc9, 0xe0, 0x6c,
+		0x05, 0xcb, 0x13, 0x5b, 0x53, 0x30, 0xae, 0x9f, 0xa3, 0x81, 0xe3, 0x92, 0x66, 0x61, 0x10, 0x95,
+		0x31, 0xa2, 0x0a, 0x66, 0x54, 0xc4, 0x90, 0xaa, 0x98, 0x52, 0x1b, 0xc6, 0xd4, 0x86, 0x39, 0xd5,
+		0x31, 0xc8, 0x0e, 0x93, 0x2c, 0x31, 0xca, 0xa5, 0xb8, 0x9d, 0xbd, 0x72, 0x61, 0x14, 0xa4, 0x78,
+		0xfa, 0xc2, 0x05, 0x50, 0x32, 0x9c, 0x78, 0xe1, 0x30, 0xd5, 0x2e, 0x8a, 0x71, 0xf9, 0xe3, 0x06,
+		0x98, 0xe0, 0x1a, 0xe5, 0xb8, 0xd1, 0x82, 0xdb, 0x39, 0xae, 0xb6, 0x4e, 0xd5, 0x88, 0xbf, 0xea,
+		0xf6, 0xde, 0x9a, 0x40, 0x78, 0x09, 0x9c, 0x6f, 0xeb, 0x3b, 0xe2, 0x17, 0xe7, 0xe7, 0x5f, 0x9d,
+		0x3f, 0xde, 0x63, 0x3e, 0xd8, 0xcf, 0xac, 0xab, 0x06, 0xf4, 0x1d, 0x1e, 0x23, 0x81, 0x13, 0x82,
+		0x62, 0x77, 0x71, 0x60, 0xb6, 0x42, 0x35, 0x71, 0x20, 0x97, 0x88, 0xf3, 0xe5, 0xf2, 0xde, 0x11,
+		0x37, 0x58, 0xaa, 0xfa, 0x8c, 0xcf, 0x12, 0xce, 0x10, 0x55, 0x31, 0x99, 0x58, 0xcb, 0xcc, 0xaa,
+		0xef, 0x03, 0x46, 0x51, 0x8f, 0xb2, 0x01, 0xcc, 0x4c, 0x07, 0xb2, 0x88, 0x09, 0x48, 0x55, 0x91,
+		0x03, 0xd2, 0x59, 0x08, 0xd9, 0x1f, 0x08, 0x87, 0x6b, 0xf9, 0x14, 0x4e, 0xc6, 0x93, 0x38, 0x1b,
+		0x47, 0x19, 0xfc, 0x27, 0x45, 0xb1, 0xb2, 0xfa, 0x71, 0x2f, 0x81, 0x00, 0x78, 0x09, 0x64, 0x37,
+		0x38, 0x7f, 0xb7, 0x77, 0x29, 0xa2, 0x80, 0x28, 0x15, 0x44, 0x89, 0xd3, 0x33, 0x87, 0xb9, 0xaf,
+		0x69, 0x3a, 0x76, 0x87, 0x95, 0x77, 0xec, 0x77, 0x9d, 0x3e, 0x78, 0x51, 0x45, 0xac, 0xe8, 0xb4,
+		0x2e, 0xa0, 0xc5, 0x64, 0x44, 0x77, 0xab, 0x02, 0xe7, 0x3c, 0x95, 0xab, 0xc4, 0xb2, 0x2c, 0x52,
+		0x3a, 0x0e, 0xdc, 0x4e, 0x32, 0xff, 0xb4, 0xba, 0xc5, 0xb5, 0x26, 0x2c, 0x9e, 0x8e, 0x71, 0xd2,
+		0x72, 0x63, 0x37, 0xc7, 0xae, 0x27, 0xfb, 0x86, 0x8a, 0x6a, 0xc7, 0x5a, 0x3c, 0x0b, 0x6b, 0xd9,
+		0x78, 0xed, 0x4a, 0xf9, 0x49, 0x6c, 0xec, 0xe3, 0x6c, 0xf2, 0xc9, 0x6e, 0xfa, 0x02, 0x3a, 0x7b,
+		0xe2, 0xdf, 0x77, 0xf7, 0xdb, 0xea, 0xdd, 0xd4, 0xf2, 0x66, 0x16, 0x9a, 0x65, 0xe2, 0x8a, 0x15,
+		0x98, 0x8b, 0xd3, 0xcc, 0x9a, 0x7f, 0x92, 0x59, 0x51, 0x4f, 0x8a, 0x26, 0xfe, 0x93, 0x05, 0x3b,
+		0xed, 0x89, 0x9d, 0x8d, 0x0c, 0xd6, 0x3a, 0x70, 0xdf, 0xc9, 0x67, 0xfe, 0xa0, 0x56, 0xff, 0xf8,
+		0x6f, 0xfd, 0xc8, 0x8f, 0x99, 0xac, 0xd0, 0xaa, 0x29, 0x41, 0xc6, 0xe0, 0x24, 0xe7, 0x06, 0xe8,
+		0x31, 0x13, 0x2c, 0xb1, 0x37, 0x17, 0x2e, 0xcd, 0x77, 0xb3, 0x1a, 0xbe, 0x95, 0x73, 0x17, 0x6d,
+		0x86, 0xcb, 0x6e, 0x91, 0xac, 0x8a, 0x5b, 0xe6, 0x16, 0xc9, 0x05, 0x22, 0x6f, 0x59, 0xdc, 0x9b,
+		0x94, 0xf2, 0x74, 0x2d, 0x8b, 0x0a, 0xb2, 0xcd, 0x8a, 0x51, 0x6c, 0xbc, 0xe4, 0xc2, 0x1a, 0xd5,
+		0xd4, 0x09, 0x99, 0x97, 0x2c, 0x97, 0xc9, 0x8b, 0xce, 0xa9, 0x85, 0xdb, 0xf0, 0xfa, 0x16, 0x49,
+		0xc1, 0x5f, 0x37, 0xa0, 0x7b, 0xa6, 0x7a, 0x97, 0xaa, 0xbf, 0x3c, 0x03, 0x96, 0xc0, 0xb3, 0x41,
+		0xc2, 0xa8, 0x78, 0x06, 0x58, 0x84, 0x6d, 0xaf, 0x07, 0xd4, 0x8d, 0x59, 0xb5, 0x61, 0x58, 0x6d,
+		0x98, 0x56, 0x1d, 0xe3, 0xec, 0x30, 0xcf, 0x12, 0x03, 0x6b, 0xd4, 0x03, 0x8c, 0x6b, 0x71, 0xb8,
+		0x04, 0x37, 0x54, 0x0d, 0x5a, 0xdd, 0xf4, 0x69, 0x8a, 0x3d, 0xf1, 0xd4, 0xdb, 0x13, 0x77, 0x6d,
+		0x4f, 0xec, 0x76, 0xbc, 0x31, 0xf1, 0x31, 0x18, 0x13, 0x15, 0xf7, 0x56, 0x0a, 0x41, 0x55, 0x11,
+		0x40, 0x2f, 0x52, 0x4d, 0x06, 0x50, 0x2b, 0x81, 0xd3, 0x4a, 0x9e, 0x9d, 0x03, 0x78, 0x76, 0xde,
+		0x54, 0x76, 0xee, 0x9d, 0x83, 0xce, 0x9c, 0xc6, 0x3b, 0x07, 0x77, 0xce, 0xcc, 0xbd, 0x73, 0xb0,
+		0xd1, 0xfc, 0xbc, 0x56, 0x23, 0x41, 0x96, 0x63, 0x63, 0xad, 0xb6, 0xdb, 0xa5, 0x0f, 0x17, 0x49,
+		0x81, 0x5d, 0x1a, 0x71, 0x11, 0xc2, 0x2b, 0xa7, 0x13, 0x9b, 0xa5, 0xbc, 0x3c, 0x60, 0x7b, 0xad,
+		0x93, 0x91, 0x12, 0x8c, 0xcd, 0xb6, 0x79, 0x20, 0xae, 0x32, 0x63, 0xd6, 0x66, 0xbc, 0xad, 0x14,
+		0x27, 0x6a, 0x79, 0xd8, 0x35, 0x1c, 0x72, 0x6b, 0x07, 0xd5, 0x98, 0x48, 0x88, 0x2d, 0xe3, 0xa9,
+		0x67, 0x33, 0xec, 0x42, 0xa9, 0xdf, 0x50, 0x99, 0xc5, 0x80, 0x62, 0xf8, 0x81, 0x8d, 0xfb, 0x29,
+		0x97, 0x5f, 0xc3, 0x6b, 0x3a, 0x24, 0xd4, 0x07, 0x53, 0xfb, 0x60, 0x6a, 0x68, 0x85, 0x69, 0x5f,
+		0x41, 0xb9, 0x65, 0x84, 0xe9, 0x3c, 0xb2, 0x74, 0x71, 0xbe, 0x9b, 0x93, 0x44, 0xc3, 0x23, 0x70,
+		0xf2, 0x97, 0xad, 0xdb, 0xa3, 0xe3, 0xdd, 0x1e, 0xde, 0xed, 0xe1, 0x10, 0xf6, 0x69, 0xaf, 0xd1,
+		0xb9, 0x6b, 0x72, 0x0e, 0x1a, 0x9c, 0xa3, 0xe6, 0xf6, 0xf9, 0x60, 0xaf, 0x9a, 0x5a, 0x55, 0x0d,
+		0xad, 0x36, 0x95, 0xa1, 0xba, 0xaa, 0xe0, 0xa0, 0x89, 0x55, 0xd2, 0xc0, 0xea, 0xd0, 0xbc, 0x9a,
+		0x74, 0x7c, 0x3b, 0xd2, 0x7c, 0xae, 0xf6, 0x19, 0x2e, 0xa0, 0x98, 0x50, 0x30, 0x61, 0x9c, 0x58,
+		0x49, 0xf2, 0x85, 0xc8, 0xad, 0xc5, 0x05, 0x2a, 0xf1, 0xc2, 0x7c, 0x95, 0x4d, 0x7d, 0xdf, 0x30,
+		0x1a, 0x00, 0x92, 0x8d, 0x5b, 0x16, 0x82, 0x06, 0x54, 0x75, 0x67, 0xc2, 0xb3, 0xfa, 0x96, 0x93,
+		0x09, 0x46, 0x09, 0x20, 0x9d, 0xe2, 0xcc, 0xd1, 0x18, 0x43, 0x8c, 0xaf, 0x71, 0x3c, 0x8b, 0xaf,
+		0x44, 0x1c, 0x03, 0xa6, 0x89, 0x20, 0x98, 0x03, 0xa1, 0xf2, 0xbb, 0x1e, 0x1d, 0x10, 0x29, 0x2b,
+		0xb2, 0x54, 0x4c, 0x52, 0xe1, 0x79, 0x31, 0x80, 0xe7, 0xc5, 0x56, 0x04, 0xb2, 0x02, 0x5f, 0x75,
+		0x0b, 0x7c, 0x74, 0x09, 0x78, 0x74, 0x0b, 0x74, 0xac, 0x16, 0xe0, 0xa8, 0x03, 0x1b, 0x55, 0xb4,
+		0x82, 0x8b, 0x11, 0x58, 0x05, 0x34, 0x8e, 0x49, 0xe4, 0x32, 0x57, 0x05, 0x30, 0x26, 0x18, 0x59,
+		0x46, 0x2d, 0x5a, 0xdb, 0xc8, 0x9d, 0xa3, 0x14, 0xb3, 0x63, 0x71, 0xe2, 0x5f, 0xea, 0x50, 0x9c,
+		0x1c, 0xbe, 0xfa, 0x48, 0x2e, 0xa0, 0x7b, 0xbf, 0x56, 0xbb, 0x5a, 0x78, 0xd7, 0x20, 0xc5, 0x71,
+		0x59, 0x82, 0xff, 0x46, 0xc4, 0x2b, 0x4e, 0x76, 0xe3, 0x59, 0x3f, 0xa6, 0x38, 0x56, 0x29, 0xff,
+		0xdb, 0x33, 0xfe, 0x1d, 0x43, 0xdb, 0xce, 0x3d, 0x5f, 0xf1, 0x7c, 0xc5, 0x3a, 0xb4, 0x2d, 0x22,
+		0x98, 0xe3, 0x58, 0x5b, 0xcc, 0x9c, 0x1d, 0xdb, 0xc5, 0x45, 0xaa, 0x39, 0xb6, 0x5f, 0xa9, 0x95,
+		0xc0, 0x65, 0x25, 0x33, 0x3b, 0x5c, 0x65, 0x74, 0xa8, 0x82, 0x16, 0x15, 0xd1, 0xa3, 0x2a, 0x9a,
+		0xd4, 0x86, 0x2e, 0xb5, 0xa1, 0x4d, 0x75, 0xf4, 0xb1, 0x43, 0x23, 0x4b, 0x74, 0x72, 0x46, 0xab,
+		0xfc, 0xa3, 0xda, 0xd5, 0xf4, 0x09, 0x0b, 0x32, 0x0c, 0x99, 0xe0, 0x24, 0xc4, 0x15, 0x92, 0x14,
+		0x16, 0xda, 0xe0, 0xac, 0x59, 0xd7, 0xf1, 0xf4, 0xd7, 0x44, 0x98, 0x8e, 0xd1, 0x2d, 0x19, 0xa7,
+		0x63, 0xc8, 0x56, 0x06, 0x36, 0x80, 0xf9, 0xf3, 0x94, 0x5e, 0xa4, 0xf5, 0x38, 0x08, 0x11, 0xcd,
+		0x5a, 0x04, 0xb8, 0x3e, 0xdd, 0x2d, 0x22, 0xa5, 0x32, 0x02, 0xd7, 0x81, 0xc8, 0x35, 0x21, 0x74,
+		0x5d, 0x88, 0x5d, 0x3b, 0x82, 0xd7, 0x8e, 0xe8, 0xf5, 0x21, 0xbc, 0x1b, 0xe2, 0x3b, 0x12, 0x80,
+		0x2a, 0x36, 0xd4, 0xea, 0xba, 0xdf, 0x56, 0x1b, 0xeb, 0xcb, 0x2a, 0xf0, 0x66, 0x27, 0x3a, 0xd6,
+		0x68, 0x82, 0x5d, 0xfe, 0x54, 0x83, 0x77, 0xa8, 0x2b, 0x98, 0x66, 0xc5, 0xee, 0x58, 0x31, 0x38,
+		0xa3, 0x76, 0x1b, 0x64, 0xfd, 0x36, 0xc9, 0x9a, 0x30, 0x63, 0x09, 0x4b, 0x6e, 0xeb, 0xbf, 0x0a,
+		0xe3, 0xf2, 0xb5, 0x8f, 0xe9, 0x32, 0x0e, 0xee, 0x67, 0xf6, 0x55, 0x33, 0xf3, 0x0c, 0x6d, 0x75,
+		0x32, 0xb7, 0x78, 0x93, 0x6a, 0x21, 0x11, 0x79, 0xfc, 0xc1, 0x49, 0xc1, 0x5e, 0x70, 0x52, 0x54,
+		0x90, 0x1a, 0x10, 0xcd, 0x3c, 0x44, 0x9c, 0xc5, 0xd2, 0x08, 0x5f, 0x4d, 0xed, 0x5b, 0x5c, 0xa6,
+		0x9a, 0xe2, 0xf7, 0x53, 0xb6, 0xd6, 0xc9, 0xaf, 0x58, 0x24, 0xcc, 0x6b, 0x80, 0xbb, 0x15, 0x14,
+		0xbd, 0x06, 0xb8, 0x57, 0x0d, 0x30, 0x53, 0xa2, 0x02, 0x2c, 0xbb, 0xaf, 0xb1, 0xb8, 0x1e, 0xf5,
+		0x6f, 0x79, 0xd1, 0x5a, 0x75, 0xbf, 0x5c, 0xef, 0x43, 0x43, 0x0c, 0xd9, 0x03, 0xd6, 0x2b, 0x7d,
+		0x6d, 0xc0, 0xed, 0x61, 0x1b, 0x5e, 0xbf, 0x3c, 0x87, 0x6f, 0xe0, 0xe5, 0xf9, 0xff, 0xf5, 0x4a,
+		0xa0, 0x57, 0x02, 0xf7, 0x87, 0xf3, 0x6e, 0xb8, 0xef, 0x48, 0x03, 0x00, 0xbc, 0x02, 0xb7, 0xf1,
+		0xe3, 0x15, 0xb8, 0xc6, 0xe8, 0x0c, 0x5e, 0x81, 0x6b, 0xd0, 0x65, 0x3c, 0x76, 0x05, 0xce, 0x41,
+		0x76, 0x65, 0xa1, 0x40, 0x14, 0x07, 0xe3, 0x0a, 0xe8, 0x3e, 0x23, 0xa0, 0x85, 0xb5, 0xea, 0x91,
+		0x7f, 0xde, 0x12, 0xaa, 0xec, 0xde, 0x97, 0xff, 0x7c, 0x03, 0xbf, 0xa8, 0xc5, 0x65, 0xe7, 0x5c,
+		0xb7, 0x14, 0x73, 0xf0, 0x82, 0x0d, 0x80, 0x17, 0x6c, 0xbc, 0x75, 0x1b, 0xc0, 0x0b, 0x47, 0xd5,
+		0x3e, 0xbb, 0x11, 0x8e, 0x5e, 0x9e, 0x7b, 0xe9, 0xc8, 0x09, 0x4d, 0x76, 0x20, 0x1d, 0x7d, 0x7d,
+		0xe6, 0x85, 0xa3, 0xc7, 0x26, 0x1c, 0x3d, 0x11, 0xeb, 0xf6, 0xa2, 0x1d, 0xb8, 0x01, 0xf6, 0xed,
+		0xbc, 0x5c, 0xa2, 0xa3, 0x5d, 0xdb, 0xa5, 0xae, 0xe6, 0xb2, 0x1c, 0xf9, 0x8b, 0x5c, 0x03, 0xe4,
+		0x29, 0x79, 0x2b, 0xf6, 0xae, 0x04, 0x42, 0x6f, 0xc5, 0xde, 0x9b, 0x15, 0x5b, 0xa1, 0x44, 0xa0,
+		0x90, 0xde, 0xa9, 0x0c, 0xde, 0x7a, 0x1c, 0x2b, 0x2c, 0x58, 0x8f, 0xf6, 0x36, 0xc7, 0x3a, 0x1d,
+		0x67, 0xab, 0x6c, 0xd3, 0xff, 0x98, 0x46, 0x09, 0x1b, 0x62, 0xda, 0xee, 0xd1, 0x37, 0x02, 0x08,
+		0x87, 0x3e, 0xe6, 0x02, 0x26, 0x8a, 0x4d, 0x86, 0x18, 0x04, 0x83, 0x21, 0xb9, 0xc6, 0x80, 0x74,
+		0x55, 0x3d, 0x31, 0x42, 0x02, 0xf8, 0x88, 0xdd, 0x14, 0xb2, 0x3f, 0xe4, 0x77, 0xcf, 0xe4, 0x3c,
+		0x4c, 0x7b, 0x34, 0x1c, 0x31, 0x8e, 0x69, 0x1b, 0xde, 0x73, 0x42, 0x87, 0x80, 0x74, 0xc1, 0xed,
+		0x29, 0x3c, 0x93, 0xb3, 0x9f, 0x65, 0x95, 0xbd, 0x55, 0xe3, 0x3c, 0xca, 0x44, 0xb6, 0x32, 0x05,
+		0x42, 0x45, 0x4a, 0x24, 0xf3, 0x84, 0x11, 0xa1, 0x42, 0x95, 0xf4, 0xbe, 0x19, 0x91, 0x50, 0x97,
+		0xf2, 0x86, 0x1b, 0x24, 0x17, 0x97, 0xcb, 0x71, 0x95, 0x51, 0x8d, 0xa3, 0x63, 0xe0, 0x84, 0x86,
+		0xb3, 0xe2, 0xde, 0x88, 0x46, 0x7a, 0x24, 0x4a, 0x30, 0xb0, 0x31, 0x11, 0x02, 0x47, 0x3d, 0x3a,
+		0x48, 0xd8, 0x58, 0x6d, 0xd2, 0x2a, 0x0f, 0x65, 0x13, 0xc1, 0xf1, 0x1a, 0xaa, 0xd7, 0x50, 0x1d,
+		0x3e, 0x4f, 0x59, 0x43, 0x75, 0x2e, 0x3e, 0xb8, 0x8c, 0x7b, 0xa7, 0x2f, 0xbd, 0x48, 0x5a, 0xab,
+		0x48, 0xaa, 0x45, 0x38, 0x5f, 0xc5, 0xda, 0xe4, 0xb0, 0x9e, 0x50, 0xbd, 0x92, 0xfc, 0xf5, 0x9d,
+		0x4b, 0x95, 0x58, 0xb5, 0x8c, 0x34, 0x7c, 0x25, 0xa7, 0x57, 0x69, 0x55, 0x68, 0x74, 0x9a, 0xca,
+		0x26, 0xdc, 0x41, 0x88, 0x92, 0x84, 0xa0, 0xa1, 0x41, 0xab, 0xd3, 0xa5, 0xf1, 0x66, 0xcd, 0x4e,
+		0xdf, 0x65, 0x66, 0x08, 0x88, 0xb0, 0x40, 0x24, 0xe6, 0x25, 0x3d, 0x4c, 0x4f, 0x7d, 0x0f, 0xd3,
+		0x22, 0xe4, 0xdc, 0x53, 0x0f, 0x53, 0x94, 0x08, 0x12, 0xa6, 0x31, 0x12, 0x38, 0x32, 0xaf, 0xb8,
+		0x53, 0x9c, 0x64, 0x59, 0x74, 0x87, 0xcf, 0x7a, 0x50, 0x17, 0x16, 0xf9, 0xb6, 0x7c, 0x95, 0x01,
+		0x4a, 0x63, 0x61, 0x64, 0x96, 0x6d, 0x29, 0x53, 0xd5, 0x76, 0x7c, 0xbf, 0x32, 0xab, 0xf0, 0xd3,
+		0xf1, 0x15, 0x7e, 0xdc, 0x41, 0xda, 0x1e, 0xb4, 0xeb, 0x61, 0x2b, 0xc6, 0xc2, 0xde, 0xbc, 0xfd,
+		0x29, 0x63, 0x31, 0x46, 0x26, 0xfe, 0xc4, 0x19, 0xed, 0x3a, 0xdd, 0x41, 0xe5, 0x2b, 0x91, 0xd1,
+		0x4f, 0xd3, 0x7c, 0xd5, 0x39, 0xa4, 0x2c, 0x4d, 0xb4, 0x43, 0xc9, 0x79, 0x87, 0xea, 0x7c, 0x9d,
+		0xb5, 0xa5, 0x15, 0x0a, 0xdd, 0xa8, 0x08, 0x05, 0x04, 0x21, 0xa3, 0x02, 0x11, 0x8a, 0x93, 0x1e,
+		0x1d, 0xa1, 0x78, 0x10, 0xc8, 0xc9, 0x9f, 0x80, 0xf0, 0x5c, 0x59, 0x55, 0x3d, 0xe7, 0xfb, 0x09,
+		0xbe, 0x26, 0x62, 0xda, 0xee, 0xd1, 0xcb, 0x42, 0xd7, 0xaa, 0x54, 0x2a, 0xc0, 0x4a, 0x6d, 0x5e,
+		0xea, 0x7f, 0x35, 0xd7, 0xa2, 0x27, 0x09, 0xbb, 0x26, 0x11, 0x06, 0x4c, 0x59, 0x3a, 0x1c, 0x15,
+		0x7a, 0x5a, 0x15, 0x97, 0x0a, 0x11, 0xcd, 0xb7, 0x01, 0x88, 0x4e, 0xe1, 0xbf, 0x2f, 0xff, 0xf5,
+		0x13, 0x60, 0x2a, 0x88, 0x98, 0x42, 0x1f, 0x53, 0x8c, 0xc4, 0x08, 0x88, 0x00, 0xc1, 0xd4, 0x5f,
+		0x23, 0x3c, 0x11, 0x23, 0x08, 0x54, 0x4f, 0x89, 0xe3, 0xf9, 0xf6, 0x8f, 0x55, 0xc9, 0x88, 0x76,
+		0x7b, 0x66, 0x2b, 0x18, 0x32, 0x16, 0x2d, 0xd8, 0x0a, 0xa4, 0x9a, 0x3f, 0x4b, 0xd1, 0xcd, 0xea,
+		0x41, 0xc8, 0xb7, 0x1b, 0x23, 0x01, 0xcf, 0xb3, 0x33, 0x97, 0xe3, 0xc6, 0xe8, 0x13, 0x06, 0x22,
+		0x00, 0x23, 0x4e, 0x70, 0x02, 0x82, 0xf5, 0x68, 0xd6, 0x82, 0x7f, 0x9a, 0xcf, 0x32, 0x52, 0xdb,
+		0x0d, 0xbd, 0x58, 0x9e, 0xfa, 0x34, 0x91, 0xfa, 0x18, 0xd7, 0x17, 0x53, 0xf8, 0xc2, 0xed, 0xe2,
+		0xb1, 0x17, 0x30, 0x3e, 0x9f, 0xec, 0x96, 0x99, 0xfe, 0x86, 0x6a, 0xf3, 0x12, 0x11, 0x30, 0x42,
+		0x1c, 0xf4, 0x82, 0x96, 0xf9, 0xe7, 0x67, 0x3e, 0xff, 0xdc, 0xe7, 0x9f, 0x5b, 0xe7, 0x9f, 0xd3,
+		0x74, 0xdc, 0xc7, 0x49, 0x90, 0x41, 0x9c, 0xb3, 0xcb, 0x66, 0x71, 0x99, 0x6a, 0xae, 0x1b, 0xbd,
+		0x56, 0xce, 0x02, 0x5d, 0x96, 0x33, 0x17, 0x4f, 0x97, 0x3f, 0xad, 0x6e, 0xcb, 0x6a, 0xce, 0x95,
+		0xaf, 0xfe, 0x5e, 0x33, 0x2e, 0xd7, 0x86, 0xd3, 0xb5, 0xe1, 0x76, 0x75, 0x1c, 0xb7, 0xc3, 0x75,
+		0x4b, 0x9c, 0xb7, 0x17, 0xb5, 0x6b, 0x8f, 0xf8, 0xa9, 0x10, 0xe9, 0xf3, 0x48, 0x8a, 0xbf, 0xfb,
+		0x4e, 0x2e, 0xbb, 0x0a, 0xd4, 0x99, 0x97, 0x20, 0xf4, 0x85, 0xdf, 0x2b, 0xce, 0x6a, 0x42, 0x23,
+		0x17, 0xc5, 0xd2, 0x03, 0xe1, 0x42, 0xaa, 0x16, 0xe5, 0x6d, 0xbd, 0x46, 0xc5, 0x56, 0x6e, 0x72,
+		0x21, 0x70, 0x6d, 0x33, 0xed, 0x79, 0x39, 0x80, 0xe7, 0xe5, 0x3b, 0xa1, 0xa2, 0x55, 0xfc, 0xac,
+		0xbe, 0xb9, 0x73, 0x2d, 0xcd, 0x9d, 0xb9, 0xc0, 0x38, 0xae, 0xdc, 0xdc, 0x39, 0x49, 0xfb, 0xfd,
+		0x07, 0xd8, 0x87, 0x39, 0xdb, 0x76, 0xb5, 0x16, 0xcc, 0xfa, 0x04, 0x7d, 0xd3, 0x64, 0x27, 0xa7,
+		0xa4, 0xb3, 0x93, 0x72, 0xd1, 0x59, 0x78, 0xb2, 0x64, 0x8d, 0x3e, 0x29, 0xda, 0xaa, 0xf6, 0x58,
+		0xf9, 0xf1, 0x66, 0x84, 0x71, 0xec, 0x6a, 0x5f, 0x2b, 0x4e, 0x76, 0xac, 0xfc, 0xc8, 0x12, 0x10,
+		0x23, 0x65, 0xe7, 0xc6, 0x31, 0x57, 0xb6, 0xb6, 0x52, 0x03, 0x33, 0xe2, 0x18, 0x62, 0xf2, 0x09,
+		0x4b, 0xab, 0x32, 0xe2, 0xeb, 0x6d, 0xca, 0x90, 0x9b, 0x94, 0x7b, 0x74, 0xc1, 0xa6, 0xdc, 0xee,
+		0xd1, 0xd7, 0x28, 0x8f, 0x2e, 0x0b, 0x11, 0x85, 0x11, 0x52, 0x71, 0x68, 0x53, 0x98, 0x1b, 0x37,
+		0xa4, 0xa1, 0x9b, 0x17, 0x4c, 0xdf, 0x1c, 0x58, 0xa2, 0xcc, 0xe1, 0x3c, 0x37, 0x97, 0xab, 0x32,
+		0xc9, 0x38, 0xb7, 0x0c, 0x72, 0x1c, 0x0f, 0x20, 0x62, 0x98, 0x03, 0x65, 0x22, 0xaf, 0x9a, 0x9c,
+		0x3d, 0x75, 0xcc, 0x22, 0x1c, 0x43, 0x00, 0xfd, 0x54, 0xc8, 0x77, 0xa4, 0x30, 0x65, 0x29, 0x70,
+		0x81, 0x12, 0x01, 0x7c, 0x82, 0x43, 0x32, 0x98, 0x12, 0x3a, 0xec, 0x51, 0x44, 0x81, 0x08, 0x3c,
+		0x86, 0x3e, 0x96, 0x25, 0x99, 0x09, 0x1d, 0x82, 0x60, 0xc0, 0xa8, 0x7e, 0xc4, 0x31, 0x10, 0x15,
+		0x78, 0x27, 0x8d, 0xfe, 0xf8, 0x36, 0x8c, 0xd3, 0x08, 0xab, 0xd1, 0x1c, 0x54, 0x9c, 0x9b, 0x8a,
+		0xe8, 0x90, 0x06, 0xff, 0xff, 0x37, 0x22, 0xb1, 0x9e, 0xc1, 0xe7, 0xaf, 0xc6, 0x99, 0x2a, 0xdb,
+		0x8c, 0x06, 0xea, 0xf8, 0x38, 0x10, 0x0a, 0x21, 0x1b, 0x8f, 0x19, 0x55, 0xab, 0xaa, 0x98, 0x3f,
+		0xd9, 0x58, 0x5b, 0x3e, 0x2f, 0x22, 0x5c, 0xe6, 0x73, 0xa5, 0x84, 0x8f, 0x7a, 0x14, 0xcf, 0x4e,
+		0xa9, 0x3f, 0x95, 0x2b, 0xc9, 0x3d, 0x51, 0x09, 0xad, 0x31, 0xe2, 0x23, 0xf5, 0x0b, 0x1a, 0xcb,
+		0x1f, 0xd2, 0x7d, 0x11, 0x8e, 0x48, 0x1c, 0x25, 0x98, 0x7a, 0xbb, 0xe8, 0xde, 0xe4, 0xae, 0x27,
+		0x6f, 0x17, 0xd5, 0x04, 0xa3, 0xb2, 0x5d, 0x34, 0x5b, 0xa6, 0x2e, 0xbb, 0xa8, 0x5e, 0x2e, 0x77,
+		0x0c, 0x12, 0x0e, 0xe3, 0x5c, 0x5c, 0x3c, 0x06, 0x44, 0x23, 0xe0, 0x0c, 0x88, 0xd0, 0xd5, 0xd5,
+		0xfb, 0x18, 0xc2, 0x58, 0x52, 0x8a, 0x79, 0xb8, 0x6b, 0x8f, 0x12, 0x3e, 0x0b, 0x74, 0xf5, 0x4a,
+		0x4f, 0xdd, 0x48, 0x57, 0x1b, 0xf2, 0xd5, 0x86, 0x84, 0xd5, 0x91, 0xd1, 0x0e, 0x29, 0x2d, 0x91,
+		0xb3, 0x21, 0x4a, 0x8f, 0x37, 0x7e, 0xba, 0x5a, 0xe6, 0x7c, 0xe7, 0xcb, 0xf5, 0xd0, 0x5c, 0xa3,
+		0xf1, 0xd3, 0xa5, 0x4b, 0xfa, 0x43, 0x39, 0xe3, 0x27, 0x64, 0xfd, 0xcc, 0x34, 0x99, 0x28, 0x21,
+		0xd7, 0x98, 0xba, 0x4b, 0x15, 0x8b, 0xcb, 0xd4, 0x2c, 0x55, 0xe8, 0x55, 0xa5, 0x32, 0x81, 0x43,
+		0x94, 0xaa, 0xec, 0x1b, 0xc2, 0x61, 0x84, 0x38, 0x20, 0x88, 0xb4, 0x6b, 0x15, 0x88, 0x80, 0x50,
+		0x29, 0x0a, 0xfd, 0x59, 0x3a, 0xcc, 0xe6, 0x88, 0x1d, 0xad, 0xfb, 0x08, 0xa9, 0x1e, 0x70, 0xa1,
+		0xd4, 0x8d, 0x99, 0xb8, 0x02, 0x03, 0x82, 0xe3, 0x08, 0x58, 0x32, 0x57, 0x83, 0x94, 0x26, 0x85,
+		0x32, 0x8d, 0x04, 0x71, 0xad, 0x77, 0xdc, 0x10, 0xa5, 0x02, 0x15, 0x42, 0x92, 0xfa, 0xaa, 0xf7,
+		0x0c, 0x27, 0xfd, 0x58, 0x3d, 0xe3, 0x13, 0x65, 0x37, 0x9b, 0x44, 0x1c, 0x20, 0x83, 0xd9, 0xc6,
+		0xaf, 0x25, 0x3e, 0x71, 0xb8, 0xc1, 0xf3, 0x3c, 0x1e, 0xef, 0x5e, 0xf6, 0xd2, 0x99, 0x97, 0xce,
+		0x00, 0xbc, 0x84, 0xb5, 0xfa, 0xf1, 0x12, 0xd6, 0xce, 0xb9, 0xbf, 0x97, 0xb0, 0x76, 0x7f, 0xc6,
+		0xbe, 0xb1, 0x78, 0x73, 0x0c, 0xf0, 0x45, 0x63, 0xf6, 0xa3, 0x4e, 0xfc, 0xda, 0x7e, 0x0e, 0x0f,
+		0x33, 0x03, 0xcc, 0x28, 0x13, 0x0b, 0x8c, 0xdb, 0x96, 0xbf, 0x97, 0xcb, 0xfd, 0x90, 0xaf, 0x66,
+		0x9a, 0x51, 0x76, 0xb0, 0xe5, 0xcd, 0xf3, 0xb6, 0xf9, 0x2b, 0x2d, 0xd8, 0x5a, 0xaf, 0x6f, 0x05,
+		0x5f, 0xcb, 0x07, 0x3f, 0x1f, 0x94, 0xc9, 0x47, 0xb7, 0xe2, 0x62, 0x98, 0xb0, 0x74, 0x12, 0x08,
+		0xb4, 0x29, 0xd9, 0xd6, 0x58, 0x0a, 0x5a, 0x90, 0x72, 0xf4, 0x71, 0x00, 0xbe, 0x45, 0xe3, 0x49,
+		0x8c, 0x79, 0xab, 0xe4, 0x5d, 0x97, 0xa4, 0xe1, 0xed, 0x9d, 0xfe, 0x97, 0x3a, 0xfa, 0xaf, 0x21,
+		0xc6, 0x4e, 0x9d, 0xfb, 0xb7, 0x77, 0xe8, 0x5f, 0xbe, 0x8d, 0x12, 0xf8, 0x33, 0x85, 0xbb, 0x35,
+		0x87, 0x6e, 0x04, 0x60, 0xad, 0x83, 0xf5, 0x87, 0x79, 0x77, 0x50, 0xd8, 0xec, 0xa6, 0x4d, 0xb6,
+		0x08, 0xff, 0x11, 0x7d, 0xc2, 0xbf, 0x31, 0xb6, 0x7a, 0xa3, 0xcb, 0x1b, 0x6f, 0x1d, 0x1f, 0x6c,
+		0xd8, 0xd8, 0x2b, 0x7c, 0x3d, 0xc3, 0xf6, 0xbb, 0x83, 0xbb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff,
+		0x03, 0x00, 0x19, 0xfc, 0x1e, 0xcc, 0x02, 0x5f, 0x02, 0x00,
 	}
 )
 
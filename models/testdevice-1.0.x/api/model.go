@@ -8,6 +8,7 @@
 package api
 
 import (
+	_ "embed" // embed
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
@@ -17,14 +18,41 @@ var modelData = []*gnmi.ModelData{
 	{Name: "onf-switch-model", Organization: "Open Networking Foundation", Version: "2023-03-07"},
 	{Name: "onf-switch", Organization: "Open Networking Foundation", Version: "2023-03-07"},
 	{Name: "onf-test1-choice", Organization: "Open Networking Foundation", Version: "2023-03-07"},
+	{Name: "ietf-inet-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
+	{Name: "ietf-yang-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
+	{Name: "onf-extension-types", Organization: "Intel Corporation", Version: "2022-12-12"},
+	{Name: "onf-switch-types", Organization: "Open Networking Foundation", Version: "2023-03-07"},
 }
 
 var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
 
+// features are the enabled YANG features, as module:feature
+var features = []string{}
+
+// deviations are the names of the deviation modules applied to the model
+var deviations = []string{}
+
+// manifest records the compiler, the inputs and the artifacts the model plugin was generated from
+//
+//go:embed manifest.json
+var manifest []byte
+
 func ModelData() []*gnmi.ModelData {
 	return modelData
 }
 
+func Features() []string {
+	return features
+}
+
+func Deviations() []string {
+	return deviations
+}
+
 func Encodings() []gnmi.Encoding {
 	return encodings
 }
+
+func Manifest() []byte {
+	return manifest
+}
@@ -5,13 +5,12 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.26.0/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/onf-test1@2019-06-10.yang
-	- /config-model/yang/onf-test1-augmented@2020-02-29.yang
+	- yang/onf-test1@2019-06-10.yang
+	- yang/onf-test1-augmented@2020-02-29.yang
 Imported modules were sourced from:
-	- /var/model-compiler/yang-base/...
-	- /config-model/yang/...
+	- yang
 */
 package api
 
@@ -580,177 +579,180 @@ var (
 	// fields within the struct.
 	ySchema = []byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5f, 0x6f, 0xdb, 0x38,
-		0x12, 0x7f, 0xef, 0xa7, 0x18, 0xf8, 0x25, 0xdb, 0x43, 0xdc, 0xc8, 0x72, 0x92, 0xa6, 0x01, 0xee,
-		0xc1, 0x69, 0x52, 0x5c, 0xb1, 0xed, 0x6e, 0xd1, 0x06, 0x7b, 0xd8, 0xeb, 0x05, 0x07, 0x5a, 0x1a,
-		0xdb, 0x44, 0x65, 0xd2, 0x47, 0x52, 0x6e, 0x7c, 0x8b, 0x7c, 0xf7, 0x03, 0x25, 0xd9, 0xf1, 0x5f,
-		0x89, 0x94, 0x64, 0xc7, 0x4e, 0xb8, 0x2f, 0xdb, 0xd8, 0x24, 0x4d, 0x0e, 0x67, 0x7e, 0x33, 0x1c,
-		0xce, 0x0c, 0xff, 0x7a, 0x05, 0x00, 0xd0, 0xf8, 0x8d, 0x0c, 0xb1, 0x71, 0x09, 0x8d, 0x10, 0xc7,
-		0x34, 0xc0, 0xc6, 0x71, 0xfa, 0xe9, 0xaf, 0x94, 0x85, 0x8d, 0x4b, 0x68, 0x65, 0x7f, 0xbe, 0xe7,
-		0xac, 0x47, 0xfb, 0x8d, 0x4b, 0xf0, 0xb2, 0x0f, 0xae, 0xa9, 0x68, 0x5c, 0x42, 0x3a, 0x44, 0xf2,
-		0x41, 0xc0, 0x99, 0x6a, 0x91, 0x85, 0xcf, 0x16, 0x86, 0xcf, 0xbe, 0x3f, 0x5e, 0xfc, 0xf6, 0x1a,
-		0x65, 0x20, 0xe8, 0x48, 0x51, 0xce, 0x74, 0xa3, 0xdb, 0x01, 0x82, 0xe2, 0x23, 0x88, 0x70, 0x8c,
-		0x11, 0xe8, 0x2e, 0x84, 0x32, 0x14, 0xcb, 0xbd, 0x16, 0x27, 0x37, 0xfb, 0x78, 0x79, 0x92, 0xb3,
-		0x2f, 0xbe, 0x08, 0xec, 0xd1, 0xfb, 0x95, 0xb9, 0x2d, 0xcc, 0x4f, 0xb5, 0x96, 0x7e, 0x25, 0xf9,
-		0xf6, 0x1b, 0x8f, 0x45, 0x80, 0x6b, 0x7b, 0xa6, 0x33, 0xc1, 0xc9, 0x4f, 0x2e, 0xf4, 0x64, 0x1a,
-		0xa3, 0xf4, 0x47, 0x8e, 0xd7, 0x37, 0xfc, 0x07, 0x91, 0x1d, 0xd1, 0x8f, 0x87, 0xc8, 0x54, 0xe3,
-		0x12, 0x94, 0x88, 0x71, 0x43, 0xc3, 0xb9, 0x56, 0x7a, 0x4e, 0x2b, 0x8d, 0x1e, 0x16, 0x3e, 0x79,
-		0x58, 0xa6, 0xe7, 0xd2, 0xb6, 0x2c, 0x6c, 0x8f, 0x4f, 0x36, 0x2f, 0x64, 0x7e, 0x9b, 0x7c, 0xb2,
-		0x69, 0x15, 0x6b, 0xb6, 0xcb, 0x67, 0x61, 0xc1, 0x76, 0x15, 0x6c, 0x5b, 0xe1, 0xf6, 0x99, 0x6c,
-		0xa3, 0xd9, 0x76, 0x9a, 0x6e, 0xab, 0xf5, 0xf6, 0x5a, 0x6f, 0xb3, 0xf1, 0x76, 0xaf, 0xdf, 0xf6,
-		0x0d, 0xdb, 0x5f, 0xc8, 0x06, 0xb3, 0x06, 0x11, 0x92, 0x5e, 0x0e, 0x3b, 0xac, 0x90, 0x33, 0x6b,
-		0x5f, 0xb0, 0x98, 0x25, 0xf6, 0xf8, 0x2d, 0x1e, 0xa2, 0xa0, 0x01, 0xe8, 0xce, 0x40, 0x99, 0xa4,
-		0x21, 0xc2, 0xfb, 0x29, 0x93, 0x80, 0xc9, 0x70, 0x3d, 0x12, 0x47, 0x9a, 0x34, 0xdf, 0x73, 0x1b,
-		0x26, 0x8d, 0xfd, 0x46, 0x6e, 0x9b, 0xbb, 0x82, 0xdf, 0xca, 0x78, 0xd3, 0x2b, 0x68, 0x56, 0xc4,
-		0xa3, 0x36, 0xbc, 0x6a, 0xc7, 0xb3, 0xb6, 0xbc, 0x5b, 0x9a, 0x87, 0x4b, 0xf3, 0xb2, 0x35, 0x4f,
-		0xe7, 0xf3, 0x76, 0x01, 0x8f, 0xcf, 0x7e, 0xed, 0x76, 0x32, 0x42, 0x3b, 0x3a, 0xc7, 0x94, 0xa9,
-		0x0b, 0x13, 0x52, 0x67, 0x4c, 0x71, 0x66, 0xd0, 0xf4, 0x2b, 0x61, 0x7d, 0x34, 0xe2, 0x54, 0xfd,
-		0x9f, 0xd9, 0xd6, 0x25, 0x03, 0x7f, 0xa6, 0xcc, 0x78, 0xaf, 0x67, 0x9d, 0xfe, 0x20, 0x51, 0x8c,
-		0x9b, 0xa1, 0x76, 0x63, 0xbf, 0x0f, 0x82, 0x04, 0x5a, 0x7a, 0xaf, 0x69, 0x9f, 0x2a, 0x59, 0xcc,
-		0xe6, 0xab, 0x24, 0xc6, 0x3e, 0x51, 0x74, 0xac, 0x7f, 0xbb, 0x47, 0x22, 0x89, 0xc6, 0xbd, 0x1f,
-		0x8e, 0x2d, 0x48, 0x42, 0xee, 0xcb, 0x93, 0xa4, 0x7d, 0x38, 0x24, 0x79, 0x55, 0x23, 0xe1, 0x76,
-		0xc6, 0x71, 0x8e, 0xe5, 0x56, 0x69, 0xf2, 0xec, 0x78, 0xae, 0xb0, 0xd5, 0x5d, 0x25, 0x48, 0xc7,
-		0x7b, 0x25, 0x48, 0x33, 0x66, 0x52, 0x91, 0x6e, 0x64, 0x08, 0xee, 0x02, 0x7b, 0x28, 0x90, 0x05,
-		0x5b, 0x01, 0xe1, 0xa9, 0xe6, 0xf8, 0xfa, 0xe1, 0x3d, 0x9c, 0x7b, 0xa7, 0x5e, 0xc3, 0x82, 0x75,
-		0x2c, 0xf5, 0xf5, 0x3a, 0xbd, 0xfd, 0xb8, 0x36, 0x4b, 0x3e, 0x28, 0xab, 0xc2, 0xd7, 0xaa, 0xf2,
-		0xd9, 0xe2, 0xf7, 0x8d, 0x9b, 0x5e, 0x95, 0xe0, 0xb3, 0xd4, 0xa2, 0xed, 0x5a, 0x5a, 0xc0, 0x5d,
-		0x4b, 0x0b, 0xf8, 0x0f, 0x1e, 0x29, 0xd2, 0xc7, 0xd2, 0x16, 0xb0, 0xb3, 0x4a, 0x0f, 0xd5, 0x2a,
-		0xfd, 0x4c, 0x58, 0x48, 0x14, 0x17, 0x93, 0x62, 0x2b, 0xac, 0x84, 0x05, 0x1b, 0x62, 0x40, 0x87,
-		0x24, 0x3a, 0x3f, 0xb5, 0xb0, 0x62, 0x5b, 0xbe, 0x41, 0xdb, 0x15, 0xcd, 0xd3, 0x7e, 0xb9, 0xb6,
-		0x6f, 0xbb, 0xbc, 0xd2, 0xd5, 0x6c, 0xb9, 0x7f, 0x76, 0x88, 0xef, 0x79, 0xde, 0x13, 0x12, 0x65,
-		0x2f, 0x2d, 0x91, 0xf2, 0xba, 0x23, 0xb0, 0xd4, 0x1d, 0x81, 0xa5, 0xee, 0xf8, 0x8a, 0x24, 0x04,
-		0xce, 0xa2, 0xc9, 0xce, 0xb4, 0x87, 0xef, 0xb4, 0xc7, 0xc1, 0xfa, 0x34, 0xa4, 0x12, 0x94, 0xf5,
-		0x6d, 0xd4, 0xc1, 0xc5, 0xb6, 0x04, 0x23, 0xb4, 0x14, 0x8c, 0xd0, 0x52, 0x30, 0x3a, 0x8c, 0xab,
-		0x01, 0x0a, 0xc8, 0x94, 0xa0, 0xb3, 0xab, 0x9c, 0x64, 0x38, 0x5b, 0xe9, 0x70, 0x6d, 0xa5, 0x3d,
-		0x75, 0xda, 0x38, 0x63, 0xa9, 0x4e, 0x9d, 0x80, 0x96, 0x3a, 0x01, 0x2d, 0x75, 0x42, 0x62, 0x22,
-		0x45, 0x54, 0x2a, 0xa7, 0x0d, 0x9c, 0x36, 0xc8, 0xa3, 0x33, 0x65, 0xaa, 0x75, 0x6e, 0xa1, 0x09,
-		0xfc, 0xc3, 0xc5, 0xf4, 0xea, 0xf8, 0xe5, 0x3d, 0xc3, 0x13, 0xb0, 0x73, 0xc5, 0x5b, 0x49, 0xd8,
-		0x27, 0x2a, 0x55, 0x47, 0x29, 0x61, 0x26, 0x65, 0x9f, 0x29, 0xbb, 0x89, 0x50, 0xcb, 0xbf, 0x21,
-		0xa9, 0xf4, 0x76, 0xce, 0xf5, 0x68, 0x5d, 0x9c, 0x9e, 0x9e, 0xbf, 0x3d, 0x3d, 0xf5, 0xde, 0xb6,
-		0xdf, 0x7a, 0xef, 0xce, 0xce, 0x5a, 0xe7, 0x2d, 0x93, 0xcb, 0xd7, 0xdf, 0x45, 0x88, 0x02, 0xc3,
-		0xab, 0x49, 0xe3, 0x12, 0x58, 0x1c, 0x45, 0xdb, 0xd2, 0x62, 0x3d, 0x4b, 0x2d, 0xd6, 0xb3, 0xd4,
-		0x62, 0x5d, 0xca, 0x88, 0x98, 0x38, 0x6f, 0xb1, 0xd3, 0x63, 0x85, 0x74, 0x4e, 0x59, 0xc5, 0x42,
-		0x91, 0xbd, 0x33, 0x68, 0xfa, 0x09, 0x59, 0x5f, 0x0d, 0xf6, 0x4e, 0x93, 0xf9, 0x9e, 0xbb, 0x53,
-		0x3e, 0x64, 0x9a, 0xec, 0xfb, 0xe1, 0xa4, 0x6f, 0x09, 0xeb, 0x7d, 0x4b, 0x58, 0xbf, 0xe2, 0x3c,
-		0x42, 0xc2, 0x1c, 0xae, 0x3b, 0x5c, 0x2f, 0xc6, 0xf5, 0x94, 0x57, 0x6c, 0x7c, 0x55, 0xad, 0xb2,
-		0x72, 0x61, 0x15, 0x52, 0x6a, 0x11, 0x93, 0xd1, 0x18, 0xc6, 0xb2, 0x38, 0x6a, 0xd3, 0xda, 0xb9,
-		0xfd, 0x4b, 0x2a, 0x7c, 0xaf, 0xe1, 0xef, 0x70, 0xa4, 0x77, 0xfc, 0x08, 0xb8, 0x00, 0x16, 0x0f,
-		0xbb, 0x28, 0x7e, 0x79, 0x73, 0x92, 0x46, 0xa8, 0xbe, 0x86, 0x7f, 0xc7, 0x9e, 0xd7, 0x0e, 0xc0,
-		0xc8, 0xdb, 0x77, 0x23, 0x04, 0x17, 0x9f, 0x51, 0x4a, 0xd2, 0xb7, 0x60, 0xda, 0xe9, 0xac, 0x3e,
-		0xf6, 0xe0, 0x93, 0x96, 0x68, 0x9f, 0x00, 0x95, 0x40, 0xba, 0x7c, 0x8c, 0x70, 0x0a, 0x6a, 0x80,
-		0xa9, 0xa4, 0xfb, 0x7d, 0xd0, 0x74, 0x80, 0x2e, 0x26, 0xfc, 0x09, 0x3d, 0x2e, 0xf4, 0x97, 0x30,
-		0x26, 0x11, 0x0d, 0x89, 0xc6, 0x06, 0x50, 0x1c, 0x46, 0x44, 0x4a, 0x53, 0xa6, 0x2f, 0x11, 0x49,
-		0x32, 0x2f, 0x61, 0xa8, 0x57, 0xdb, 0x1c, 0x66, 0xcb, 0xb5, 0x50, 0x54, 0x55, 0xa2, 0x48, 0x16,
-		0x04, 0xae, 0x3e, 0x8a, 0xd5, 0xa4, 0x52, 0x1e, 0x4a, 0x8a, 0xce, 0x9d, 0x95, 0xe8, 0x74, 0x18,
-		0xe3, 0x8a, 0x64, 0xda, 0x20, 0x47, 0x6c, 0x64, 0x30, 0xc0, 0x21, 0x19, 0x91, 0xc4, 0x04, 0x6b,
-		0x9c, 0x70, 0xd6, 0x6b, 0x2a, 0x94, 0xaa, 0x75, 0x92, 0xe6, 0x4e, 0x9c, 0xe4, 0xc6, 0xe6, 0xa7,
-		0x23, 0x28, 0x11, 0x07, 0x8a, 0x65, 0x0c, 0xfa, 0x3b, 0xeb, 0xdd, 0xea, 0xfe, 0xff, 0xd1, 0xca,
-		0xa6, 0xd5, 0x49, 0xfe, 0xe7, 0x77, 0xd6, 0xd3, 0x6e, 0x75, 0xad, 0x6b, 0x56, 0x93, 0x66, 0x07,
-		0x84, 0x86, 0x59, 0x04, 0xa1, 0x61, 0x16, 0xc1, 0x9c, 0x2a, 0x0c, 0xf7, 0x23, 0x75, 0x80, 0x1c,
-		0x62, 0xee, 0x00, 0xd9, 0x6d, 0xf2, 0x40, 0xd8, 0xb6, 0xbd, 0x00, 0xd7, 0x3d, 0xec, 0x0c, 0xa7,
-		0x14, 0xf7, 0x53, 0xbb, 0x89, 0xb3, 0x79, 0x9b, 0x29, 0x04, 0x12, 0xf7, 0xf5, 0xda, 0x31, 0xd4,
-		0x98, 0xb0, 0x36, 0xb9, 0x68, 0x4f, 0xed, 0x28, 0xf2, 0x1c, 0x0d, 0x29, 0xe2, 0x6e, 0xc4, 0x37,
-		0x20, 0x32, 0x23, 0xc1, 0x0f, 0x73, 0x41, 0x49, 0x9b, 0x5b, 0x4b, 0x09, 0xa1, 0xfd, 0x81, 0x82,
-		0x9e, 0xe0, 0x43, 0xf8, 0xfa, 0xe1, 0x7d, 0xf3, 0xdc, 0xf3, 0x3d, 0x43, 0x61, 0x38, 0x73, 0xc2,
-		0x70, 0x78, 0xc2, 0x50, 0x84, 0xd0, 0x8f, 0x48, 0x4d, 0x14, 0x36, 0x99, 0x66, 0x0e, 0x7b, 0xf3,
-		0x76, 0xae, 0xaf, 0x21, 0x15, 0x32, 0x96, 0x3a, 0x35, 0x6c, 0x6e, 0xca, 0x5a, 0x65, 0x58, 0xac,
-		0x24, 0xab, 0x55, 0x31, 0xb5, 0x2b, 0xb1, 0x5e, 0x2d, 0xb6, 0x76, 0x39, 0x56, 0xb4, 0x74, 0xd3,
-		0x18, 0xee, 0x95, 0x29, 0x8b, 0x3e, 0x9a, 0x96, 0x03, 0x1e, 0x70, 0xcd, 0x73, 0xf6, 0x44, 0x9f,
-		0x59, 0x9d, 0xb3, 0x21, 0x2c, 0x69, 0xb6, 0x04, 0xa8, 0xc8, 0xe2, 0x21, 0x0a, 0xa2, 0x6d, 0x8b,
-		0xcc, 0x65, 0x03, 0x01, 0x91, 0x08, 0x8f, 0x12, 0x51, 0x83, 0x35, 0x52, 0xd2, 0x3a, 0xa9, 0x2c,
-		0x45, 0x55, 0xa4, 0xa9, 0xa2, 0x54, 0x55, 0x95, 0xae, 0xda, 0xa4, 0xac, 0x36, 0x69, 0xab, 0x2e,
-		0x75, 0x76, 0xd2, 0x57, 0xc2, 0xcd, 0x6c, 0x67, 0x3d, 0x6d, 0xdc, 0xe9, 0xa9, 0x4c, 0x68, 0x11,
-		0x29, 0xb1, 0xe3, 0x53, 0x13, 0xeb, 0xb4, 0x44, 0xdf, 0x1b, 0x16, 0x0f, 0xcb, 0xf3, 0xca, 0x2d,
-		0xff, 0x96, 0x1a, 0x82, 0x65, 0x47, 0x48, 0x46, 0xf1, 0x92, 0x48, 0x32, 0x22, 0x7e, 0x94, 0xe4,
-		0xb4, 0x64, 0x90, 0x96, 0x1e, 0x64, 0x48, 0xa3, 0x4a, 0x83, 0xf8, 0x7a, 0x90, 0x1e, 0x15, 0x52,
-		0x35, 0xc9, 0x98, 0xd0, 0x28, 0xf1, 0xc6, 0x95, 0x1a, 0xee, 0xe1, 0xb8, 0x2c, 0x45, 0x3f, 0x32,
-		0x55, 0x8d, 0x9c, 0x09, 0x25, 0xad, 0x21, 0x6b, 0x61, 0x88, 0x65, 0x12, 0x18, 0x85, 0x6a, 0x6c,
-		0xf6, 0x58, 0xea, 0x5d, 0xb9, 0x84, 0x56, 0x39, 0x42, 0x6e, 0x5b, 0xda, 0x9f, 0x48, 0x87, 0x1b,
-		0xfa, 0xad, 0xca, 0xf9, 0xb1, 0xc2, 0x93, 0xe4, 0xac, 0x73, 0x32, 0x67, 0x66, 0xd6, 0xe5, 0xd4,
-		0x33, 0x38, 0x52, 0xc8, 0x11, 0x17, 0x4a, 0x36, 0x89, 0x40, 0x46, 0xec, 0x4d, 0xe3, 0x85, 0xde,
-		0xce, 0x38, 0x76, 0xc6, 0xf1, 0xb6, 0x8d, 0xe3, 0x2e, 0xa2, 0x28, 0x6f, 0x17, 0x27, 0xbd, 0xab,
-		0x99, 0xc4, 0x3e, 0x0b, 0x01, 0x87, 0x23, 0x35, 0x59, 0xb4, 0x88, 0xe7, 0x05, 0xc1, 0xd9, 0xc4,
-		0xce, 0x26, 0x7e, 0x61, 0x36, 0xb1, 0x16, 0x88, 0x2a, 0xd6, 0x70, 0x7b, 0x5b, 0x66, 0x80, 0x05,
-		0x98, 0x8f, 0x04, 0xaa, 0xff, 0x61, 0x54, 0x1e, 0x5d, 0xa6, 0x03, 0x54, 0x3c, 0x73, 0x3b, 0x70,
-		0x71, 0xe0, 0xe2, 0xc0, 0xe5, 0x10, 0xc0, 0xe5, 0xf0, 0xce, 0x18, 0x0b, 0xf6, 0xfa, 0x6e, 0x42,
-		0x07, 0x8a, 0xae, 0x6e, 0xed, 0x96, 0x6d, 0xb5, 0xdc, 0xc6, 0x4e, 0xe2, 0x81, 0x6a, 0x0d, 0x6a,
-		0x08, 0x2b, 0x06, 0x35, 0x5c, 0x57, 0x09, 0x6a, 0xd0, 0x4a, 0xa7, 0x65, 0x50, 0x1a, 0x31, 0x6b,
-		0x67, 0x16, 0xd4, 0xf0, 0x69, 0x6d, 0xac, 0xdf, 0xe6, 0xee, 0xf9, 0xca, 0xc8, 0xd5, 0x46, 0xac,
-		0xaf, 0x36, 0x62, 0x21, 0x34, 0x9b, 0xdf, 0x20, 0x3f, 0xde, 0x1c, 0xe7, 0xb4, 0x31, 0x0c, 0xaa,
-		0x36, 0xcb, 0xae, 0x30, 0xf7, 0x55, 0x4c, 0x03, 0x85, 0xcf, 0x0c, 0x81, 0xb8, 0x6c, 0x7c, 0xb0,
-		0x7d, 0x5c, 0xf0, 0x83, 0x59, 0x5a, 0x88, 0xfd, 0x52, 0x5b, 0xde, 0xfe, 0xad, 0xb5, 0xde, 0x00,
-		0x33, 0x33, 0x3c, 0xa3, 0xd2, 0xa8, 0xd4, 0x6b, 0xd6, 0xce, 0x0c, 0xcf, 0x3a, 0x20, 0xe9, 0x70,
-		0x14, 0x61, 0x9a, 0x64, 0xc9, 0x7b, 0xda, 0xe6, 0xee, 0xd1, 0x7e, 0x9c, 0x5e, 0x09, 0x00, 0x55,
-		0x38, 0x94, 0xae, 0xee, 0xeb, 0xde, 0xd7, 0x7d, 0xcd, 0xb4, 0xa8, 0x61, 0x34, 0x4a, 0xd2, 0xda,
-		0x2e, 0x18, 0xe5, 0x76, 0x90, 0xb1, 0x08, 0x95, 0xf0, 0x03, 0x27, 0x18, 0x42, 0x77, 0x02, 0x26,
-		0xe3, 0xb8, 0x20, 0xf7, 0xda, 0x0e, 0x4b, 0xcf, 0xb1, 0x58, 0xc9, 0xe1, 0x66, 0x2f, 0x9d, 0xba,
-		0xe4, 0xa5, 0x65, 0x92, 0x5c, 0xb8, 0xdc, 0x25, 0xd3, 0x33, 0x59, 0x8e, 0x32, 0x13, 0xf7, 0xcd,
-		0x11, 0xff, 0x69, 0x70, 0x31, 0x30, 0x93, 0xbb, 0x59, 0x0f, 0xdb, 0x4a, 0x54, 0x01, 0xd2, 0x31,
-		0x82, 0x51, 0x5f, 0x87, 0xe3, 0x2f, 0x07, 0xc7, 0x63, 0xdb, 0x6a, 0x0a, 0xe7, 0x07, 0x5b, 0x4d,
-		0xc1, 0xe5, 0xa0, 0xae, 0xd2, 0xa4, 0xed, 0x72, 0x50, 0xeb, 0xc0, 0x71, 0x65, 0x8d, 0xe3, 0xaa,
-		0x1c, 0x8e, 0xdf, 0x0a, 0xc2, 0xe4, 0x90, 0x2a, 0x07, 0xe4, 0x0e, 0xc8, 0x5f, 0x2c, 0x90, 0xbb,
-		0xfa, 0xf4, 0x07, 0xad, 0xdb, 0x9e, 0x1a, 0xc7, 0xad, 0xdc, 0x31, 0xbf, 0xe2, 0xa4, 0xc0, 0x8d,
-		0x62, 0x56, 0x5f, 0xc7, 0xbc, 0xae, 0xce, 0x52, 0x3d, 0x9d, 0x9c, 0xb3, 0xa7, 0x59, 0xd1, 0x9c,
-		0x7d, 0xcc, 0x06, 0x9f, 0xa5, 0x7c, 0x4f, 0xd5, 0xe0, 0x2c, 0xe9, 0x7b, 0xf6, 0xcd, 0xf4, 0xa0,
-		0xf3, 0xda, 0x04, 0xd2, 0x16, 0xb5, 0xa4, 0x65, 0x28, 0xe0, 0x74, 0x0e, 0xb3, 0xcc, 0xe5, 0x08,
-		0xa5, 0x04, 0x35, 0x20, 0x0c, 0xa6, 0x93, 0x80, 0x66, 0x92, 0xc5, 0x9c, 0x94, 0xf2, 0x1d, 0x6b,
-		0x91, 0xd3, 0x7f, 0x4e, 0x20, 0x20, 0x0c, 0xe4, 0x80, 0x08, 0x04, 0x2a, 0xc1, 0xf7, 0x76, 0x94,
-		0x04, 0x1e, 0xce, 0x2d, 0xf6, 0x29, 0x52, 0xc0, 0xeb, 0xa3, 0xd7, 0x0e, 0xa3, 0x45, 0xab, 0xd5,
-		0x09, 0x98, 0x2d, 0x99, 0x4a, 0xe8, 0x0b, 0x24, 0x0a, 0x45, 0xba, 0x60, 0x2e, 0x00, 0xff, 0x1b,
-		0x93, 0x08, 0x14, 0x07, 0xc3, 0xa3, 0x79, 0x5d, 0x6c, 0xf0, 0xf4, 0xb5, 0x00, 0xec, 0xa8, 0xf2,
-		0x22, 0xf3, 0xfd, 0x73, 0x2f, 0x68, 0xa0, 0xf8, 0x6a, 0x5c, 0xeb, 0x16, 0x8b, 0x7c, 0xff, 0xdc,
-		0x87, 0x05, 0x3b, 0xd3, 0x78, 0xab, 0xb5, 0x48, 0x5e, 0x70, 0xd3, 0x74, 0xa2, 0x5a, 0x97, 0xb9,
-		0x31, 0x5a, 0xcb, 0x27, 0x95, 0x9b, 0x7b, 0x85, 0x2c, 0xa4, 0xac, 0x6f, 0x73, 0x77, 0xee, 0x8a,
-		0x03, 0x6c, 0x3e, 0x72, 0xec, 0xae, 0x38, 0x40, 0x41, 0x89, 0x88, 0x15, 0x82, 0x16, 0xc6, 0x7f,
-		0x40, 0xb9, 0x92, 0x11, 0x86, 0xdc, 0xb1, 0xab, 0x73, 0xac, 0x4b, 0x74, 0xae, 0xc5, 0xd0, 0x2e,
-		0x40, 0xad, 0xa5, 0x57, 0x1e, 0x4d, 0xcc, 0x54, 0x4d, 0x39, 0x99, 0xfb, 0x6e, 0x94, 0x01, 0x2a,
-		0xdc, 0xce, 0xde, 0xac, 0xa5, 0x12, 0xd2, 0x01, 0x55, 0xfd, 0x30, 0x21, 0x0d, 0x9e, 0x80, 0xaa,
-		0x06, 0x14, 0x6b, 0xd7, 0x51, 0x79, 0x8f, 0xee, 0xf2, 0x35, 0x4b, 0xbe, 0xb6, 0x2c, 0xd2, 0x92,
-		0xeb, 0x5e, 0xed, 0xcd, 0x55, 0x8b, 0x8b, 0xeb, 0x79, 0x9c, 0xed, 0xdc, 0xbc, 0xd2, 0xc7, 0x8a,
-		0xbb, 0x4d, 0xa9, 0xd6, 0xe5, 0x23, 0x2f, 0x3e, 0x69, 0x3c, 0x6d, 0x95, 0xff, 0xb0, 0x71, 0x07,
-		0x24, 0x06, 0x9c, 0x85, 0xeb, 0x5e, 0x37, 0x4e, 0xec, 0x5d, 0x2a, 0x81, 0xb3, 0xb4, 0xe4, 0x51,
-		0x32, 0x1e, 0x10, 0xa5, 0x04, 0xed, 0xc6, 0x0a, 0xe5, 0x1b, 0xb8, 0x09, 0xa9, 0x02, 0x39, 0x19,
-		0x76, 0x79, 0x04, 0x72, 0xc0, 0xe3, 0x28, 0x04, 0xc6, 0x13, 0xeb, 0x79, 0x4c, 0x25, 0xd5, 0x9c,
-		0x6d, 0xf9, 0x40, 0xb2, 0xef, 0x1e, 0x48, 0x5e, 0xab, 0xb7, 0x02, 0xc3, 0xd2, 0x46, 0x81, 0xf1,
-		0x03, 0xc9, 0x54, 0x26, 0xa5, 0xae, 0x80, 0xe1, 0xcf, 0xb9, 0x1d, 0x27, 0x61, 0x88, 0x21, 0x50,
-		0x96, 0x1c, 0x74, 0x7c, 0xaf, 0xf5, 0x0e, 0xc6, 0x28, 0x24, 0xe5, 0xec, 0x0d, 0xc0, 0x3f, 0x11,
-		0x42, 0xce, 0x8e, 0x14, 0x0c, 0xc8, 0x18, 0xb5, 0x11, 0x2c, 0xc9, 0x04, 0xa8, 0x3a, 0x92, 0x70,
-		0x94, 0xc6, 0xdc, 0xa4, 0x2e, 0x93, 0x23, 0x68, 0x02, 0x1d, 0x8e, 0x22, 0x9a, 0x86, 0x58, 0x8c,
-		0x88, 0xd8, 0x8c, 0x39, 0x2e, 0x02, 0xa7, 0x82, 0x4f, 0x76, 0x0b, 0xc5, 0x93, 0xda, 0x96, 0x2f,
-		0x2f, 0xb7, 0x49, 0xc9, 0x27, 0x52, 0xb2, 0x6a, 0x82, 0x30, 0x26, 0x82, 0x92, 0x55, 0x9c, 0x70,
-		0x3e, 0xff, 0x17, 0xec, 0xf3, 0xdf, 0x65, 0xa5, 0xc9, 0x82, 0x0a, 0xac, 0x6d, 0xcb, 0x77, 0x18,
-		0xdb, 0xdd, 0x92, 0xf2, 0x90, 0x15, 0x14, 0x73, 0xe2, 0xe0, 0xc4, 0x61, 0x99, 0xce, 0x2f, 0x2a,
-		0x26, 0xed, 0xcc, 0x5d, 0x82, 0xad, 0xdc, 0x0b, 0xba, 0x4b, 0xb0, 0xed, 0x5c, 0x82, 0xd5, 0xe3,
-		0x0d, 0xcd, 0x8e, 0x59, 0x27, 0xb9, 0xe6, 0x77, 0xe1, 0xe1, 0xef, 0xea, 0x9b, 0x1e, 0x24, 0xcd,
-		0x19, 0x7a, 0x5f, 0x35, 0x67, 0xc8, 0xa4, 0x10, 0x6a, 0xee, 0x03, 0x77, 0x6b, 0x0e, 0x89, 0x8b,
-		0x47, 0xbf, 0xaa, 0xc9, 0x42, 0xbe, 0x33, 0xe7, 0x2b, 0x32, 0xaf, 0x79, 0xb2, 0x50, 0x61, 0x08,
-		0x85, 0x41, 0xe8, 0x84, 0x61, 0xc8, 0xc4, 0xb6, 0x52, 0x85, 0x5a, 0x9e, 0xf1, 0x9b, 0x67, 0xcf,
-		0x21, 0x5d, 0xc8, 0xdf, 0xcf, 0xe5, 0x3e, 0x55, 0xc6, 0x50, 0xd7, 0x30, 0x63, 0xa8, 0x5b, 0x36,
-		0x63, 0x28, 0x05, 0xb7, 0x24, 0x53, 0xe8, 0x18, 0x7e, 0x52, 0x35, 0x00, 0x02, 0x21, 0x8f, 0xbb,
-		0x11, 0xc2, 0x0f, 0x9c, 0x54, 0x75, 0x5d, 0x38, 0xac, 0xdb, 0xba, 0xeb, 0x82, 0xb2, 0x10, 0xef,
-		0x5b, 0xe6, 0x47, 0xb5, 0xac, 0x7d, 0xd9, 0x04, 0x22, 0xdd, 0xdb, 0x9d, 0xd0, 0xdc, 0x09, 0x6d,
-		0x5e, 0xc3, 0x5e, 0x58, 0x1c, 0xd0, 0xce, 0x0e, 0x36, 0x46, 0xd1, 0xc5, 0x9a, 0xaf, 0x2a, 0xeb,
-		0xb3, 0x33, 0x77, 0x3e, 0xab, 0xc1, 0xdd, 0x96, 0xa0, 0xaa, 0x6f, 0x89, 0xe1, 0xbe, 0xc3, 0x70,
-		0x87, 0xe1, 0x0e, 0xc3, 0x1d, 0x86, 0x3b, 0x0c, 0xdf, 0x07, 0x0c, 0x4f, 0xae, 0x40, 0x2c, 0x5f,
-		0x5f, 0xb1, 0x7e, 0x7b, 0xa5, 0x33, 0xbd, 0x2c, 0x99, 0x79, 0xa3, 0xa6, 0xf7, 0xd5, 0x1a, 0xd9,
-		0xeb, 0xc6, 0x74, 0xdf, 0x61, 0xfa, 0xc1, 0x62, 0xfa, 0x8b, 0xba, 0x39, 0x71, 0xe9, 0x43, 0x6b,
-		0xfc, 0x68, 0x0e, 0xd5, 0xeb, 0x42, 0xf5, 0xd0, 0x12, 0xd5, 0x43, 0xeb, 0x8b, 0x70, 0xa0, 0x21,
-		0x32, 0x45, 0xd5, 0x44, 0x60, 0x6f, 0x37, 0xd8, 0xee, 0xec, 0xf5, 0xc3, 0xc5, 0xf6, 0x39, 0x66,
-		0xb1, 0x01, 0x78, 0x13, 0xb3, 0xfd, 0x63, 0x36, 0xf4, 0x15, 0x91, 0x25, 0x92, 0x6a, 0x3e, 0xff,
-		0x79, 0xd5, 0xf9, 0x76, 0x63, 0xba, 0x3f, 0x09, 0x54, 0x49, 0x63, 0x65, 0x62, 0xa7, 0x50, 0x16,
-		0xe6, 0xf5, 0xf1, 0xfa, 0xf6, 0xcf, 0x2f, 0x37, 0xad, 0xc6, 0x36, 0x60, 0xba, 0xd2, 0x94, 0xfc,
-		0xba, 0x8b, 0x9d, 0xdf, 0xed, 0x3a, 0xf6, 0x3b, 0x3f, 0xc9, 0x32, 0x75, 0x36, 0x43, 0xae, 0xbf,
-		0x62, 0xcb, 0xd9, 0x96, 0x17, 0xdb, 0xca, 0xb6, 0xac, 0xf7, 0x0a, 0x3d, 0xf7, 0x16, 0x07, 0x0c,
-		0xaf, 0xd0, 0x93, 0xdc, 0xa2, 0xab, 0x9a, 0x72, 0x8b, 0x2a, 0x45, 0x80, 0xaf, 0x0f, 0xc0, 0x36,
-		0x5c, 0x87, 0x49, 0x30, 0xb8, 0x56, 0xb4, 0x4d, 0xa2, 0x9a, 0x8a, 0x8f, 0x9a, 0x49, 0xe8, 0xf6,
-		0xe6, 0x88, 0xf0, 0xd5, 0xa6, 0x45, 0x61, 0xe1, 0x49, 0xc5, 0x6b, 0xa2, 0x12, 0xe5, 0xfb, 0x18,
-		0x1b, 0xfe, 0x0b, 0xe3, 0x0a, 0x04, 0x06, 0x7c, 0x38, 0x44, 0x16, 0x62, 0x08, 0xdd, 0x58, 0xcd,
-		0x32, 0x26, 0x65, 0x3c, 0x1a, 0x71, 0xa1, 0x30, 0x7c, 0xbd, 0x21, 0xec, 0xdb, 0xdb, 0x14, 0xf6,
-		0xed, 0xbd, 0xd8, 0xb0, 0xef, 0x8d, 0x9a, 0xaf, 0xf8, 0x14, 0x93, 0x77, 0x6a, 0x69, 0x7c, 0x21,
-		0x4a, 0xa1, 0x60, 0x1b, 0x35, 0x4b, 0xe3, 0x7b, 0xa7, 0xf9, 0xaf, 0xbb, 0xbf, 0xda, 0x0f, 0xcd,
-		0xef, 0x5e, 0xf3, 0xdd, 0xdd, 0xdf, 0x1a, 0x45, 0xc9, 0x11, 0xaf, 0x16, 0xff, 0x95, 0xad, 0x63,
-		0x93, 0x88, 0x34, 0xa8, 0xfc, 0x40, 0x7e, 0xe0, 0x57, 0xce, 0x57, 0xa9, 0xb7, 0x2c, 0x36, 0x8d,
-		0xf9, 0xaf, 0x16, 0x04, 0xe3, 0x1a, 0xc7, 0x34, 0xc8, 0x44, 0xe1, 0xe1, 0xd5, 0xc3, 0xff, 0x01,
-		0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x3b, 0x70, 0xe1, 0x18, 0xaa, 0x00,
+		0x12, 0x7f, 0xf7, 0xa7, 0x18, 0xe8, 0x25, 0xdb, 0x83, 0xdd, 0x48, 0x72, 0x92, 0xa6, 0x01, 0xee,
+		0xc1, 0x69, 0x52, 0x5c, 0xb1, 0xed, 0x6e, 0xd1, 0x06, 0x7b, 0xd8, 0xeb, 0x05, 0x07, 0x5a, 0xa2,
+		0x6d, 0xa2, 0x32, 0xe9, 0x23, 0x29, 0x37, 0xbe, 0x45, 0xbe, 0xfb, 0x81, 0x92, 0xec, 0xfa, 0x9f,
+		0x24, 0x52, 0x92, 0x1d, 0x3b, 0xa1, 0x5f, 0xda, 0x58, 0x24, 0x2d, 0x0e, 0x7f, 0xf3, 0x9b, 0x21,
+		0x39, 0x43, 0xfe, 0xd5, 0x02, 0x00, 0x70, 0x7e, 0x43, 0x63, 0xec, 0x5c, 0x81, 0x13, 0xe2, 0x29,
+		0x09, 0xb0, 0xd3, 0x4e, 0xbf, 0xfd, 0x95, 0xd0, 0xd0, 0xb9, 0x02, 0x2f, 0xfb, 0xf3, 0x1d, 0xa3,
+		0x03, 0x32, 0x74, 0xae, 0xc0, 0xcd, 0xbe, 0xb8, 0x21, 0xdc, 0xb9, 0x82, 0xb4, 0x09, 0x00, 0x00,
+		0x27, 0x60, 0x54, 0x7a, 0x68, 0xe5, 0xbb, 0x95, 0xe6, 0xb3, 0xe7, 0xed, 0xd5, 0xa7, 0x37, 0x58,
+		0x04, 0x9c, 0x4c, 0x24, 0x61, 0x54, 0x15, 0xba, 0x1b, 0x61, 0x90, 0x6c, 0x02, 0x11, 0x9e, 0xe2,
+		0x08, 0x54, 0x15, 0x44, 0x28, 0xe6, 0xeb, 0xb5, 0x56, 0x5f, 0x6e, 0xf1, 0xf5, 0xfa, 0x4b, 0x2e,
+		0x1e, 0x7c, 0xe6, 0x78, 0x40, 0x1e, 0x36, 0xde, 0x6d, 0xe5, 0xfd, 0xa4, 0xe7, 0xb4, 0x37, 0x9f,
+		0x7e, 0x65, 0x31, 0x0f, 0xf0, 0xd6, 0x9a, 0xe9, 0x9b, 0xe0, 0xd9, 0x0f, 0xc6, 0xd5, 0xcb, 0x38,
+		0x93, 0xf4, 0x47, 0xda, 0xdb, 0x0b, 0xfe, 0x03, 0x89, 0x1e, 0x1f, 0xc6, 0x63, 0x4c, 0xa5, 0x73,
+		0x05, 0x92, 0xc7, 0x38, 0xa7, 0xe0, 0x52, 0x29, 0xf5, 0x4e, 0x1b, 0x85, 0x1e, 0x57, 0xbe, 0x79,
+		0x5c, 0x97, 0xe7, 0xda, 0xb0, 0xac, 0x0c, 0x8f, 0x8f, 0xf2, 0x3b, 0xb2, 0x3c, 0x4c, 0x3e, 0xca,
+		0xeb, 0xc5, 0x96, 0xe1, 0xf2, 0x69, 0x58, 0x32, 0x5c, 0x25, 0xc3, 0x56, 0x3a, 0x7c, 0x3a, 0xc3,
+		0xa8, 0x37, 0x9c, 0xba, 0xc3, 0x6a, 0x3c, 0xbc, 0xc6, 0xc3, 0xac, 0x3d, 0xdc, 0xdb, 0x87, 0x3d,
+		0x67, 0xf8, 0x4b, 0x61, 0x30, 0xff, 0x38, 0x11, 0x46, 0x03, 0x1f, 0x15, 0x96, 0x59, 0x11, 0x67,
+		0x56, 0xbe, 0xa4, 0x33, 0x6b, 0xf0, 0xf8, 0x2d, 0x1e, 0x63, 0x4e, 0x02, 0x50, 0x95, 0x81, 0x50,
+		0x41, 0x42, 0x0c, 0xef, 0xe6, 0x20, 0x01, 0x9d, 0xe6, 0x06, 0x28, 0x8e, 0x94, 0x68, 0xbe, 0x15,
+		0x16, 0x04, 0x00, 0x70, 0x7c, 0xa7, 0xb0, 0xcc, 0x7d, 0xc9, 0x6f, 0x65, 0xd8, 0x74, 0x4b, 0x8a,
+		0x95, 0x61, 0xd4, 0x04, 0xab, 0x66, 0x98, 0x35, 0xc5, 0x6e, 0x65, 0x0c, 0x57, 0xc6, 0xb2, 0x31,
+		0xa6, 0x8b, 0xb1, 0x5d, 0x82, 0xf1, 0xf9, 0xc7, 0xb9, 0x9b, 0x4d, 0xb0, 0x99, 0x9c, 0x63, 0x42,
+		0xe5, 0xa5, 0x8e, 0xa8, 0x33, 0x50, 0x9c, 0x6b, 0x14, 0xfd, 0x82, 0xe8, 0x10, 0x6b, 0x21, 0x15,
+		0x00, 0x34, 0x87, 0x0e, 0x00, 0xc0, 0xf9, 0x44, 0xa8, 0x73, 0x65, 0x50, 0x01, 0x00, 0xc0, 0xf9,
+		0x03, 0x45, 0x31, 0xce, 0xa7, 0xda, 0xbc, 0x8f, 0xf3, 0x9e, 0xa3, 0x40, 0x69, 0xef, 0x0d, 0x19,
+		0x12, 0x29, 0xca, 0x61, 0xbe, 0x29, 0x62, 0x3c, 0x44, 0x92, 0x4c, 0xd5, 0x6f, 0x0f, 0x50, 0x24,
+		0xb0, 0x76, 0xed, 0xc7, 0xb6, 0x81, 0x48, 0xd0, 0x43, 0x75, 0x91, 0x74, 0x8f, 0x47, 0x24, 0xad,
+		0x06, 0x05, 0xb7, 0x37, 0xc4, 0x59, 0xc8, 0x6d, 0xca, 0xe4, 0xd9, 0x61, 0xae, 0xb4, 0xd4, 0x7d,
+		0x2d, 0x4a, 0xc7, 0x0f, 0x92, 0xa3, 0x4e, 0x4c, 0x85, 0x44, 0xfd, 0x48, 0x93, 0xdc, 0x39, 0x1e,
+		0x60, 0x8e, 0x69, 0xb0, 0x13, 0x12, 0x9e, 0x5b, 0x8e, 0x2f, 0xef, 0xdf, 0xc1, 0x85, 0x7b, 0xe6,
+		0x3a, 0x06, 0xd0, 0x31, 0xb4, 0xd7, 0xdb, 0xec, 0xf6, 0xcf, 0xbe, 0x19, 0xe2, 0xa0, 0xaa, 0x09,
+		0xdf, 0x6a, 0xca, 0x17, 0x9d, 0x3f, 0x34, 0x34, 0xb5, 0x2a, 0xe0, 0x2c, 0xf5, 0x68, 0xfb, 0x86,
+		0x1e, 0x70, 0xdf, 0xd0, 0x03, 0xfe, 0x83, 0x45, 0x12, 0x0d, 0x71, 0x65, 0x0f, 0xd8, 0x7a, 0xa5,
+		0x8d, 0x41, 0x7a, 0xcf, 0x5e, 0xe9, 0x27, 0x44, 0x43, 0x24, 0x19, 0x9f, 0x95, 0x7b, 0x61, 0x15,
+		0x3c, 0xd8, 0x10, 0x07, 0x64, 0x8c, 0xa2, 0x8b, 0x33, 0x03, 0x2f, 0xd6, 0xf3, 0xdb, 0x2d, 0x73,
+		0xcb, 0xd3, 0x7d, 0xb9, 0xbe, 0x6f, 0xb7, 0xba, 0xd1, 0x55, 0xb0, 0x3c, 0x3c, 0x3f, 0xc4, 0x77,
+		0x5d, 0xf7, 0x09, 0x85, 0x72, 0x90, 0x9e, 0x48, 0x75, 0xdb, 0x11, 0x18, 0xda, 0x8e, 0xc0, 0xd0,
+		0x76, 0x7c, 0xc1, 0x28, 0x04, 0x46, 0xa3, 0xd9, 0xde, 0xac, 0x87, 0x6f, 0xad, 0xc7, 0x81, 0x58,
+		0x0f, 0x73, 0x8b, 0x20, 0x24, 0x27, 0x74, 0x68, 0x62, 0x0e, 0x2e, 0x77, 0xa5, 0x18, 0xa1, 0xa1,
+		0x62, 0x84, 0x86, 0x8a, 0xd1, 0xa3, 0x4c, 0x8e, 0x30, 0x87, 0xcc, 0x08, 0x5a, 0xbf, 0xca, 0x6a,
+		0x46, 0x91, 0x9c, 0xad, 0xaf, 0x54, 0xee, 0x19, 0x78, 0xc7, 0xe3, 0x16, 0x58, 0x67, 0xc9, 0x58,
+		0xa7, 0x0e, 0xc0, 0x59, 0xc2, 0x86, 0x36, 0x01, 0x1b, 0xda, 0x04, 0x55, 0x09, 0x22, 0x22, 0xa4,
+		0xb5, 0x06, 0x00, 0xd6, 0x1a, 0xe4, 0xcb, 0x99, 0x50, 0xe9, 0x5d, 0x18, 0x58, 0x02, 0xff, 0x78,
+		0x39, 0xbd, 0x3e, 0x7f, 0xb9, 0xcf, 0x70, 0x06, 0x6c, 0x97, 0xe2, 0x8d, 0x34, 0xec, 0x23, 0x11,
+		0xb2, 0x27, 0x25, 0xd7, 0xd3, 0xb2, 0x4f, 0x84, 0xde, 0x46, 0x58, 0xe9, 0xbf, 0xa6, 0xa8, 0xd4,
+		0x70, 0x2e, 0xd5, 0xf0, 0x2e, 0xcf, 0xce, 0x2e, 0xde, 0x9c, 0x9d, 0xb9, 0x6f, 0xba, 0x6f, 0xdc,
+		0xb7, 0xe7, 0xe7, 0xde, 0x85, 0xa7, 0xb3, 0xf9, 0xfa, 0x3b, 0x0f, 0x31, 0xc7, 0xe1, 0xf5, 0xcc,
+		0xb9, 0x02, 0x1a, 0x47, 0xd1, 0xae, 0xac, 0xd8, 0xc0, 0xd0, 0x8a, 0x0d, 0x0c, 0xad, 0x58, 0x9f,
+		0x50, 0xc4, 0x67, 0x76, 0xb5, 0x18, 0xc0, 0xda, 0xb1, 0x12, 0x39, 0xa7, 0x50, 0x31, 0x30, 0x64,
+		0x6f, 0x35, 0x8a, 0x7e, 0xc4, 0x74, 0x28, 0x47, 0x07, 0x67, 0xc9, 0x7c, 0xd7, 0xee, 0x29, 0x1f,
+		0xb3, 0x4c, 0x0e, 0x7d, 0x72, 0x32, 0x34, 0xa4, 0xf5, 0xa1, 0x21, 0xad, 0x5f, 0x33, 0x16, 0x61,
+		0x44, 0x2d, 0xaf, 0x5b, 0x5e, 0x2f, 0xe7, 0xf5, 0x14, 0x2b, 0x26, 0x6b, 0x55, 0x5e, 0x55, 0xbd,
+		0x30, 0x0a, 0x29, 0x35, 0x88, 0xc9, 0x70, 0xc6, 0xb1, 0x28, 0x8f, 0xda, 0x34, 0x5e, 0xdc, 0xfe,
+		0x25, 0x55, 0xbe, 0x57, 0xf0, 0x77, 0x38, 0x51, 0x23, 0x7e, 0x02, 0x8c, 0x03, 0x8d, 0xc7, 0x7d,
+		0xcc, 0x7f, 0x79, 0x7d, 0x9a, 0x3c, 0x44, 0xaf, 0xe0, 0xdf, 0xb1, 0xeb, 0x76, 0x03, 0xd0, 0x5a,
+		0xed, 0xbb, 0xe5, 0x9c, 0xf1, 0x4f, 0x58, 0x08, 0x34, 0x34, 0x00, 0xed, 0xfc, 0xad, 0x3e, 0x0c,
+		0xe0, 0xa3, 0xd2, 0x68, 0x1f, 0x01, 0x11, 0x80, 0xfa, 0x6c, 0x8a, 0xe1, 0x0c, 0xe4, 0x08, 0xa7,
+		0x9a, 0xee, 0x0f, 0x41, 0xc9, 0x01, 0xfa, 0x38, 0xc1, 0x27, 0x0c, 0x18, 0x57, 0x0f, 0x61, 0x8a,
+		0x22, 0x12, 0x22, 0xc5, 0x0d, 0x20, 0x19, 0x4c, 0x90, 0x10, 0xba, 0xa0, 0x37, 0xd4, 0xae, 0x75,
+		0x0d, 0xc3, 0xaa, 0xb7, 0x9d, 0x71, 0xd6, 0x5d, 0x03, 0x43, 0x55, 0x55, 0xd9, 0x36, 0x14, 0xae,
+		0x39, 0x89, 0x35, 0x64, 0x52, 0x1e, 0x2b, 0xaa, 0xce, 0xbd, 0x91, 0xea, 0xf4, 0x28, 0x65, 0x12,
+		0x65, 0xd6, 0xa0, 0x40, 0x6d, 0x44, 0x30, 0xc2, 0x63, 0x34, 0x41, 0x89, 0x0b, 0xe6, 0x9c, 0x32,
+		0x3a, 0xe8, 0x48, 0x2c, 0xa4, 0x77, 0x9a, 0xe6, 0x4e, 0x9c, 0x16, 0xc6, 0xe6, 0xa7, 0x2d, 0x48,
+		0x1e, 0x07, 0x92, 0x66, 0x00, 0xfd, 0x9d, 0x0e, 0xee, 0x54, 0xfd, 0xff, 0x28, 0x63, 0xe3, 0xf5,
+		0x92, 0x7f, 0xfc, 0x9e, 0xd3, 0xd2, 0xeb, 0xeb, 0x96, 0xde, 0xa4, 0xd9, 0x01, 0xa1, 0x66, 0x16,
+		0x41, 0xa8, 0x99, 0x45, 0xb0, 0x64, 0x0a, 0xc3, 0xc3, 0x48, 0x1d, 0x40, 0xc7, 0x98, 0x3b, 0x80,
+		0xf6, 0x9b, 0x3c, 0x10, 0x76, 0x4d, 0x37, 0xc0, 0x55, 0x0d, 0x33, 0xc7, 0x29, 0xe5, 0xfd, 0x84,
+		0x1b, 0x80, 0xd1, 0x65, 0x9f, 0x29, 0x04, 0x14, 0x0f, 0x55, 0xdf, 0x71, 0x08, 0x92, 0xc1, 0xd6,
+		0xe4, 0xa2, 0x3c, 0x10, 0x3d, 0xb1, 0x1f, 0x85, 0x9e, 0xa3, 0x23, 0x85, 0xec, 0x8e, 0x78, 0x0e,
+		0x23, 0x53, 0x14, 0x7c, 0xd7, 0x57, 0x94, 0xb4, 0xb8, 0xb1, 0x96, 0x20, 0x32, 0x1c, 0x49, 0x18,
+		0x70, 0x36, 0x86, 0x2f, 0xef, 0xdf, 0x75, 0x2e, 0x5c, 0xdf, 0xd5, 0x54, 0x86, 0x73, 0xab, 0x0c,
+		0xc7, 0xa7, 0x0c, 0x65, 0x0c, 0xfd, 0x93, 0xa9, 0x91, 0xc4, 0x1d, 0xaa, 0xc0, 0x61, 0xee, 0xde,
+		0x2e, 0xd5, 0xd5, 0x94, 0x42, 0x06, 0xa9, 0x33, 0xcd, 0xe2, 0xba, 0xd0, 0xaa, 0x02, 0xb1, 0x8a,
+		0x50, 0xab, 0x0a, 0xb9, 0xda, 0xd0, 0xab, 0x0d, 0xc1, 0xea, 0x50, 0xd4, 0x83, 0xa4, 0x26, 0x34,
+		0x8d, 0x21, 0x3a, 0xff, 0x38, 0xc1, 0x88, 0x05, 0x4c, 0x61, 0xce, 0x5c, 0xe8, 0x0b, 0xaf, 0x73,
+		0xd1, 0x84, 0xa1, 0xcc, 0xd6, 0x08, 0x15, 0x53, 0x95, 0xb8, 0x88, 0x94, 0x6f, 0x91, 0x2d, 0xd9,
+		0x40, 0x80, 0x04, 0x86, 0x9f, 0x1a, 0xd1, 0x80, 0x37, 0x92, 0xa7, 0x3d, 0xa6, 0xcb, 0x72, 0xa6,
+		0x5a, 0x54, 0x47, 0x9b, 0x6a, 0x6a, 0x55, 0x5d, 0xed, 0x6a, 0x4c, 0xcb, 0x1a, 0xd3, 0xb6, 0xfa,
+		0x5a, 0x67, 0xa6, 0x7d, 0x86, 0x5a, 0x68, 0xee, 0x3d, 0xe5, 0x8e, 0xf4, 0x5c, 0x27, 0x94, 0x8a,
+		0x54, 0x18, 0xf1, 0xb9, 0x8b, 0x75, 0x56, 0xa1, 0xee, 0x2d, 0x8d, 0xc7, 0xd5, 0xb1, 0x72, 0xc7,
+		0xbe, 0xa6, 0x8e, 0x60, 0xd5, 0x16, 0x00, 0x00, 0x1c, 0x37, 0x89, 0x24, 0x43, 0xfc, 0xbb, 0xd3,
+		0xae, 0xde, 0x88, 0xa7, 0x1a, 0x19, 0x93, 0xa8, 0x56, 0x23, 0xbe, 0x6a, 0x64, 0x40, 0xb8, 0x90,
+		0x1d, 0x34, 0x45, 0x24, 0x4a, 0x56, 0xe3, 0x2a, 0x35, 0xf7, 0xd8, 0xae, 0x2a, 0xd1, 0x0f, 0x54,
+		0xd6, 0x13, 0x67, 0x22, 0x49, 0x63, 0xca, 0x5a, 0xfe, 0x6c, 0x88, 0x40, 0x2b, 0x54, 0x23, 0xef,
+		0x93, 0x8e, 0xca, 0x15, 0x78, 0xd5, 0x04, 0xb9, 0x6b, 0x6d, 0x7f, 0x22, 0x1b, 0xae, 0xb9, 0x6e,
+		0x55, 0x6d, 0x1d, 0x2b, 0x3c, 0x4d, 0xe6, 0x3a, 0xa7, 0x4b, 0x6e, 0x66, 0x53, 0x8b, 0x7a, 0xe5,
+		0xdd, 0x73, 0xc4, 0x84, 0x71, 0x29, 0x3a, 0x88, 0x63, 0x8a, 0xcc, 0x5d, 0xe3, 0x95, 0xda, 0xd6,
+		0x39, 0xde, 0x97, 0xd9, 0x7e, 0xb9, 0xce, 0x71, 0x1f, 0x63, 0x5e, 0xdd, 0x2f, 0x4e, 0x6a, 0xd7,
+		0x73, 0x89, 0xd5, 0x29, 0x2f, 0x78, 0x3c, 0x91, 0xb3, 0x55, 0x8f, 0x78, 0x59, 0x11, 0xac, 0x4f,
+		0x6c, 0x7d, 0xe2, 0x66, 0x94, 0xcd, 0x4c, 0xe9, 0x0c, 0x95, 0xaf, 0x49, 0x9f, 0x58, 0x29, 0x44,
+		0x1d, 0x6f, 0xb8, 0xbb, 0x2b, 0x37, 0xc0, 0x80, 0xcc, 0x27, 0x1c, 0xcb, 0xff, 0xe1, 0xa8, 0x3a,
+		0xbb, 0xcc, 0x1b, 0xa8, 0x39, 0xe7, 0xb6, 0xe4, 0x62, 0xc9, 0xc5, 0x92, 0xcb, 0x31, 0x90, 0xcb,
+		0xf1, 0xcd, 0x31, 0x56, 0xfc, 0xf5, 0xfd, 0x84, 0x0e, 0xb4, 0x5b, 0x4d, 0x76, 0xdb, 0xa8, 0xbb,
+		0xce, 0x5e, 0xe2, 0x81, 0x1a, 0x0d, 0x6a, 0x08, 0x6b, 0x06, 0x35, 0xdc, 0xd4, 0x09, 0x6a, 0x50,
+		0x46, 0xc7, 0xd3, 0x38, 0x1a, 0x31, 0x2b, 0xa7, 0x17, 0xd4, 0xf0, 0x71, 0x6b, 0xac, 0x5f, 0x7e,
+		0xf5, 0x62, 0x63, 0x64, 0xcf, 0x46, 0x6c, 0xee, 0x6c, 0xc4, 0x52, 0x6a, 0xd6, 0xdf, 0x41, 0xfe,
+		0xb9, 0x73, 0xdc, 0x6e, 0xd5, 0x0d, 0xaa, 0xd6, 0xcb, 0xae, 0xd0, 0x5f, 0xab, 0x98, 0x07, 0x0a,
+		0x9f, 0xb7, 0x5b, 0x3b, 0x8d, 0x0f, 0x36, 0x8f, 0x0b, 0x7e, 0xd4, 0x4b, 0x0b, 0x31, 0xef, 0xaa,
+		0xe7, 0x1e, 0x5e, 0x5f, 0x9b, 0x0d, 0x30, 0xd3, 0xe3, 0x33, 0x22, 0xb4, 0x8e, 0x7a, 0xcd, 0xca,
+		0xe9, 0xf1, 0x59, 0x0f, 0x04, 0x19, 0x4f, 0x22, 0x9c, 0x26, 0x59, 0xb2, 0x81, 0xf2, 0xb9, 0x07,
+		0x64, 0x18, 0xa7, 0x5b, 0x02, 0x40, 0x24, 0x1e, 0x0b, 0x7b, 0xee, 0xeb, 0xc1, 0x9f, 0xfb, 0x9a,
+		0x59, 0x51, 0xcd, 0x68, 0x94, 0xa4, 0xb4, 0x59, 0x30, 0xca, 0xdd, 0x28, 0x83, 0x08, 0x11, 0xf0,
+		0x1d, 0xcf, 0x70, 0x08, 0xfd, 0x19, 0xe8, 0xb4, 0x63, 0x83, 0xdc, 0x6b, 0x83, 0xca, 0x18, 0x5c,
+		0x9a, 0x2c, 0x75, 0x08, 0xa1, 0x59, 0xc7, 0x9b, 0xbd, 0x74, 0x66, 0x93, 0x97, 0xd6, 0x45, 0x72,
+		0x69, 0x73, 0x97, 0x34, 0xeb, 0x17, 0x0c, 0x89, 0xc3, 0x1f, 0x3a, 0x13, 0xf6, 0x43, 0x63, 0x63,
+		0x60, 0xa1, 0x77, 0x8b, 0x1a, 0xa6, 0x27, 0x51, 0x05, 0x98, 0x4c, 0x31, 0x68, 0xd5, 0xb5, 0x3c,
+		0xfe, 0xb2, 0x0e, 0xd2, 0x36, 0x3a, 0x4d, 0xe1, 0xe2, 0x68, 0x4f, 0x53, 0xb0, 0x39, 0xa8, 0x9b,
+		0x32, 0xe9, 0xda, 0x1c, 0xd4, 0x26, 0x78, 0x5c, 0x1a, 0xf3, 0xb8, 0xac, 0xc6, 0xe3, 0x77, 0x1c,
+		0x51, 0x31, 0x26, 0xd2, 0x12, 0xb9, 0x25, 0xf2, 0x17, 0x4b, 0xe4, 0xf6, 0x7c, 0xfa, 0xa3, 0xb6,
+		0x6d, 0x4f, 0xcd, 0xe3, 0x46, 0xcb, 0x31, 0xbf, 0xe2, 0x59, 0xc9, 0x32, 0x8a, 0xde, 0xf9, 0x3a,
+		0xfa, 0xe7, 0xea, 0xac, 0x9d, 0xa7, 0x53, 0x30, 0xf7, 0xd4, 0x3b, 0x34, 0xe7, 0x10, 0xb3, 0xc1,
+		0x17, 0x29, 0xdf, 0x73, 0x33, 0xb8, 0x48, 0xfa, 0x5e, 0x3c, 0x99, 0x4f, 0x74, 0x5e, 0xe9, 0x50,
+		0xda, 0xaa, 0x95, 0x34, 0x0c, 0x05, 0x9c, 0xbf, 0xc3, 0x22, 0x73, 0x39, 0xc2, 0x42, 0x80, 0x1c,
+		0x21, 0x0a, 0xf3, 0x97, 0x80, 0x4e, 0x92, 0xc5, 0x9c, 0x1c, 0xe5, 0x3b, 0x55, 0x2a, 0xa7, 0xfe,
+		0x9c, 0x41, 0x80, 0x28, 0x88, 0x11, 0xe2, 0x18, 0x88, 0x00, 0xdf, 0xdd, 0x53, 0x12, 0x78, 0xb8,
+		0xd4, 0xd9, 0xa7, 0x48, 0x01, 0x6f, 0x4e, 0x5e, 0x7b, 0x8c, 0x16, 0xad, 0x77, 0x4e, 0xc0, 0xa2,
+		0xcb, 0x44, 0xc0, 0x90, 0x63, 0x24, 0x31, 0x4f, 0x3b, 0xcc, 0x38, 0xe0, 0xff, 0xc6, 0x28, 0x02,
+		0xc9, 0x40, 0x73, 0x6a, 0xfe, 0x7c, 0xce, 0x02, 0x30, 0x93, 0xca, 0x8b, 0xcc, 0xf7, 0x2f, 0xdc,
+		0xa0, 0x81, 0xf2, 0xad, 0x71, 0x65, 0x5b, 0x0c, 0xf2, 0xfd, 0x0b, 0x2f, 0x16, 0xec, 0xcd, 0xe3,
+		0xad, 0xb6, 0x32, 0x79, 0xc9, 0x4e, 0xd3, 0xa9, 0xf4, 0xae, 0x0a, 0x63, 0xb4, 0xd6, 0x67, 0x2a,
+		0xb7, 0x0f, 0x12, 0xd3, 0x90, 0xd0, 0xa1, 0xc9, 0xde, 0xb9, 0x3d, 0x1c, 0xa0, 0x52, 0x80, 0x54,
+		0xc3, 0x3b, 0x4c, 0x25, 0x47, 0x44, 0x6c, 0x08, 0xb4, 0x34, 0xfe, 0x03, 0xaa, 0x1d, 0x19, 0xa1,
+		0x89, 0x0e, 0x6d, 0x94, 0x98, 0xa0, 0xc5, 0x10, 0x35, 0x55, 0x49, 0xfd, 0x19, 0x24, 0x3a, 0x9b,
+		0xa2, 0xb2, 0x84, 0xb5, 0xd6, 0x6e, 0x79, 0xd4, 0x71, 0x53, 0x95, 0xe4, 0x44, 0xe1, 0xbd, 0x51,
+		0x1a, 0xac, 0x70, 0xb7, 0xb8, 0xb3, 0x96, 0x08, 0x48, 0x1b, 0x94, 0xcd, 0xd3, 0x84, 0xd0, 0xb8,
+		0x02, 0xaa, 0x1e, 0x51, 0x6c, 0xed, 0x47, 0xed, 0x31, 0xba, 0x2f, 0xb6, 0x2c, 0xc5, 0xd6, 0xb2,
+		0xcc, 0x4a, 0x6e, 0x91, 0x47, 0xb1, 0x59, 0x74, 0x5a, 0xdb, 0xdf, 0x76, 0xe9, 0xbd, 0xd2, 0xcb,
+		0x8a, 0xfb, 0x1d, 0x21, 0xb7, 0xe5, 0x23, 0xaf, 0x5e, 0x69, 0x3c, 0x2f, 0x55, 0x7c, 0xb1, 0x71,
+		0x0f, 0x04, 0x0e, 0x18, 0x0d, 0xb7, 0xdd, 0x6e, 0x9c, 0xf8, 0xbb, 0x44, 0x00, 0xa3, 0xe9, 0x91,
+		0x47, 0x49, 0x7b, 0x80, 0xa4, 0xe4, 0xa4, 0x1f, 0x4b, 0x2c, 0x5e, 0xc3, 0x6d, 0x48, 0x24, 0x88,
+		0xd9, 0xb8, 0xcf, 0x22, 0x10, 0x23, 0x16, 0x47, 0x21, 0x50, 0x96, 0x78, 0xcf, 0x53, 0x22, 0x48,
+		0x3f, 0xda, 0xf8, 0xf5, 0xb2, 0x0b, 0x92, 0x7d, 0x7b, 0x41, 0xf2, 0x56, 0xbb, 0x15, 0x68, 0x1e,
+		0x6d, 0x14, 0x68, 0x5f, 0x90, 0x4c, 0x04, 0x10, 0x01, 0x08, 0x28, 0xfe, 0xb1, 0x34, 0xe2, 0x28,
+		0x0c, 0x71, 0x08, 0x84, 0x26, 0x13, 0x1d, 0xdf, 0xf5, 0xde, 0xc2, 0x14, 0x73, 0x41, 0x18, 0x7d,
+		0x0d, 0xf0, 0x4f, 0x0c, 0x21, 0xa3, 0x27, 0x12, 0x46, 0x68, 0x8a, 0x41, 0x32, 0x10, 0x68, 0x06,
+		0x44, 0x9e, 0x08, 0x38, 0x49, 0x63, 0x6e, 0xd2, 0x25, 0x93, 0x13, 0xe8, 0x80, 0x8a, 0xc8, 0x21,
+		0x69, 0x88, 0xc5, 0x04, 0xf1, 0x7c, 0xce, 0xb1, 0x11, 0x38, 0x15, 0x70, 0x54, 0xc2, 0x72, 0x75,
+		0x0e, 0x4f, 0xea, 0x1a, 0xde, 0xbc, 0xdc, 0x45, 0x15, 0xaf, 0x48, 0xc9, 0x4e, 0x13, 0x84, 0x29,
+		0xe2, 0x04, 0x6d, 0xf2, 0x44, 0x1e, 0x4e, 0xec, 0x9a, 0x7f, 0x75, 0x5c, 0x19, 0xe3, 0xab, 0x18,
+		0x67, 0x25, 0x78, 0xab, 0xb1, 0xe6, 0xbf, 0xcf, 0x93, 0x26, 0x4b, 0x4e, 0x60, 0xed, 0x1a, 0xde,
+		0xc3, 0xd8, 0xed, 0x57, 0xd4, 0x87, 0x34, 0xf0, 0xc8, 0xaa, 0x83, 0x55, 0x87, 0x0d, 0x39, 0xbf,
+		0xa8, 0x98, 0xb4, 0x73, 0xbb, 0x09, 0x56, 0x35, 0x78, 0xfc, 0x10, 0x64, 0x72, 0x54, 0x9b, 0x60,
+		0xcd, 0xac, 0x86, 0x66, 0xd3, 0xac, 0xd3, 0x42, 0xf7, 0x1b, 0xca, 0x26, 0x7f, 0xd7, 0x5f, 0x55,
+		0x23, 0xc9, 0xff, 0xfd, 0x77, 0x75, 0x73, 0x86, 0x74, 0x0e, 0x42, 0x2d, 0xbc, 0xe0, 0x6e, 0xcb,
+		0x24, 0x71, 0x75, 0xea, 0x57, 0x37, 0x59, 0xc8, 0xb7, 0xee, 0x7c, 0x4d, 0xf0, 0xea, 0x27, 0x0b,
+		0x95, 0x86, 0x50, 0x68, 0x84, 0x4e, 0x68, 0x86, 0x4c, 0xec, 0x2a, 0x55, 0xc8, 0x73, 0xb5, 0xef,
+		0x3c, 0x7b, 0x0e, 0xe9, 0x42, 0xfe, 0x61, 0x76, 0xf7, 0xa9, 0x32, 0x86, 0xfa, 0x9a, 0x19, 0x43,
+		0xfd, 0xaa, 0x19, 0x43, 0x29, 0xb9, 0x25, 0x99, 0x42, 0x6d, 0xf8, 0x41, 0xe4, 0x08, 0x10, 0x84,
+		0x2c, 0xee, 0x47, 0x58, 0x65, 0x8a, 0xd4, 0x5d, 0xba, 0xb0, 0x5c, 0xb7, 0xf3, 0xa5, 0x0b, 0x42,
+		0x43, 0xfc, 0xe0, 0xe9, 0x4f, 0xd5, 0xb2, 0xf2, 0x55, 0x13, 0x88, 0x54, 0x6d, 0x3b, 0x43, 0xb3,
+		0x33, 0xb4, 0x65, 0x0b, 0x7b, 0x69, 0x30, 0x41, 0x3b, 0x3f, 0xda, 0x18, 0x45, 0x1b, 0x6b, 0xbe,
+		0x21, 0x12, 0xff, 0xfc, 0xdc, 0xce, 0xcf, 0x34, 0xeb, 0x17, 0x0c, 0x4a, 0xca, 0xc9, 0xbe, 0x21,
+		0x87, 0xfb, 0x96, 0xc3, 0x2d, 0x87, 0x5b, 0x0e, 0xb7, 0x1c, 0x6e, 0x39, 0xfc, 0x10, 0x38, 0x3c,
+		0xd9, 0x02, 0x31, 0xbc, 0x7d, 0xc5, 0xf8, 0xee, 0x95, 0xde, 0x7c, 0xb3, 0x64, 0xb1, 0x1a, 0x35,
+		0xdf, 0xaf, 0x56, 0xcc, 0xde, 0x34, 0xa7, 0xfb, 0x96, 0xd3, 0x8f, 0x96, 0xd3, 0x5f, 0xd4, 0xce,
+		0x89, 0x4d, 0x1f, 0xda, 0xa4, 0x75, 0xbb, 0x73, 0xd2, 0x18, 0xab, 0x87, 0x86, 0xac, 0x1e, 0x1a,
+		0x6f, 0x84, 0x03, 0x09, 0x31, 0x95, 0x44, 0xce, 0x38, 0x1e, 0xec, 0x87, 0xdb, 0xad, 0xbf, 0x7e,
+		0xbc, 0xdc, 0xbe, 0x04, 0x16, 0x13, 0x82, 0xd7, 0x71, 0xdb, 0x3f, 0x64, 0x4d, 0x5f, 0x23, 0x51,
+		0x21, 0xa9, 0xe6, 0xd3, 0x9f, 0xd7, 0xbd, 0xaf, 0xb7, 0xba, 0xe3, 0x93, 0x50, 0x95, 0xd0, 0x36,
+		0x26, 0x60, 0x64, 0x50, 0x56, 0xde, 0xeb, 0xc3, 0xcd, 0xdd, 0x9f, 0x9f, 0x6f, 0x3d, 0x67, 0x17,
+		0x34, 0x5d, 0xeb, 0x95, 0xfc, 0xa6, 0x0f, 0x3b, 0xbf, 0xdf, 0x77, 0xec, 0x77, 0x71, 0x92, 0x65,
+		0xba, 0xd8, 0x0c, 0x85, 0xeb, 0x15, 0x3b, 0xce, 0xb6, 0xbc, 0xdc, 0x55, 0xb6, 0x65, 0xb3, 0x5b,
+		0xe8, 0x85, 0xbb, 0x38, 0xa0, 0xb9, 0x85, 0x9e, 0xe4, 0x16, 0x5d, 0x37, 0x94, 0x5b, 0x54, 0x2b,
+		0x02, 0x7c, 0x7b, 0x00, 0xb6, 0x66, 0x3f, 0x74, 0x82, 0xc1, 0x95, 0xa1, 0xed, 0x20, 0xd9, 0x91,
+		0x6c, 0xd2, 0x49, 0x42, 0xb7, 0xf3, 0x23, 0xc2, 0x37, 0x8b, 0x96, 0x85, 0x85, 0xab, 0x1a, 0x80,
+		0x64, 0x62, 0x7c, 0x7f, 0xc6, 0x86, 0xff, 0x42, 0x99, 0x04, 0x8e, 0x03, 0x36, 0x1e, 0x63, 0xaa,
+		0x42, 0x85, 0xfb, 0xb1, 0x5c, 0x64, 0x4c, 0x8a, 0x78, 0xa2, 0xce, 0xb3, 0xc5, 0xe1, 0xab, 0x9c,
+		0xb0, 0x6f, 0x37, 0x2f, 0xec, 0xdb, 0x7d, 0xb1, 0x61, 0xdf, 0xb9, 0x96, 0xaf, 0x7c, 0x16, 0x53,
+		0x34, 0x6b, 0x71, 0x3e, 0x23, 0x29, 0x31, 0xa7, 0xb9, 0x96, 0xc5, 0xf9, 0xd6, 0xeb, 0xfc, 0xeb,
+		0xfe, 0xaf, 0xee, 0x63, 0xe7, 0x9b, 0xdb, 0x79, 0x7b, 0xff, 0x37, 0xa7, 0x2c, 0x39, 0xa2, 0xb5,
+		0xfa, 0xbf, 0xac, 0x1f, 0x79, 0x2a, 0xe2, 0x10, 0xf1, 0x1e, 0x7d, 0xc7, 0x5f, 0x18, 0xdb, 0x94,
+		0xde, 0xba, 0xda, 0x38, 0xed, 0x56, 0x8e, 0x62, 0xdc, 0xe0, 0x29, 0x09, 0x32, 0x55, 0x78, 0x6c,
+		0x3d, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x7e, 0x3b, 0x70, 0xe1, 0x18, 0xaa, 0x00,
 		0x00,
 	}
 )
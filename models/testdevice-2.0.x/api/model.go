@@ -8,20 +8,45 @@
 package api
 
 import (
+	_ "embed" // embed
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
 var modelData = []*gnmi.ModelData{
 	{Name: "onf-test1", Organization: "Open Networking Foundation", Version: "2019-06-10"},
 	{Name: "onf-test1-augmented", Organization: "Open Networking Foundation", Version: "2020-02-29"},
+	{Name: "onf-test1-identities", Organization: "Open Networking Foundation.", Version: "2020-09-01"},
 }
 
 var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
 
+// features are the enabled YANG features, as module:feature
+var features = []string{}
+
+// deviations are the names of the deviation modules applied to the model
+var deviations = []string{}
+
+// manifest records the compiler, the inputs and the artifacts the model plugin was generated from
+//
+//go:embed manifest.json
+var manifest []byte
+
 func ModelData() []*gnmi.ModelData {
 	return modelData
 }
 
+func Features() []string {
+	return features
+}
+
+func Deviations() []string {
+	return deviations
+}
+
 func Encodings() []gnmi.Encoding {
 	return encodings
 }
+
+func Manifest() []byte {
+	return manifest
+}
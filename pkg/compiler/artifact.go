@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+)
+
+// ArtifactStatus tells how a generated artifact compares with the file already in the output directory
+type ArtifactStatus int
+
+const (
+	ArtifactUnchanged ArtifactStatus = iota
+	ArtifactCreated
+	ArtifactChanged
+)
+
+func (s ArtifactStatus) String() string {
+	switch s {
+	case ArtifactUnchanged:
+		return "unchanged"
+	case ArtifactCreated:
+		return "new"
+	case ArtifactChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+// Artifact is a file generated by the compiler, relative to the output directory
+type Artifact struct {
+	File    string
+	Status  ArtifactStatus
+	content []byte
+}
+
+// SetOutputDirectory sets the directory the artifacts are written to instead of the model directory
+func (c *ModelCompiler) SetOutputDirectory(dir string) {
+	c.outputDirectory = dir
+}
+
+// SetDryRun sets whether Compile only records the artifacts it would write, without writing them
+func (c *ModelCompiler) SetDryRun(dryRun bool) {
+	c.dryRun = dryRun
+}
+
+// SetNoFormat sets whether YANG formatting differences are reported instead of being written
+func (c *ModelCompiler) SetNoFormat(noFormat bool) {
+	c.noFormat = noFormat
+}
+
+// Artifacts returns the artifacts generated by the last compilation, in the order they were generated
+func (c *ModelCompiler) Artifacts() []*Artifact {
+	return c.artifacts
+}
+
+// Skipped returns the checks and artifacts the last compilation left out for want of a tool, with the reason
+func (c *ModelCompiler) Skipped() []string {
+	return c.skipped
+}
+
+// outputPath returns where the artifact file of the model at path is written
+func (c *ModelCompiler) outputPath(path string, file string) string {
+	if c.outputDirectory != "" {
+		return filepath.Join(c.outputDirectory, file)
+	}
+	return filepath.Join(path, file)
+}
+
+// writeArtifact records the artifact file of the model at path and, unless this is a dry run,
+// writes its content to the output directory
+func (c *ModelCompiler) writeArtifact(path string, file string, content []byte, perm os.FileMode) error {
+	outFile := c.outputPath(path, file)
+	artifact := &Artifact{File: file, Status: ArtifactCreated, content: content}
+	if existing, err := os.ReadFile(outFile); err == nil {
+		artifact.Status = ArtifactChanged
+		if bytes.Equal(existing, content) {
+			artifact.Status = ArtifactUnchanged
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	c.artifacts = append(c.artifacts, artifact)
+
+	if c.dryRun {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(outFile), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(outFile, content, perm)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompile_OutputDirectory(t *testing.T) {
+	out := t.TempDir()
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(out)
+	assert.NoError(t, c.Compile("../../models/testdevice-2.0.x"))
+
+	for _, artifact := range c.Artifacts() {
+		assert.Equal(t, ArtifactCreated, artifact.Status, artifact.File)
+		_, err := os.Stat(filepath.Join(out, artifact.File))
+		assert.NoError(t, err)
+	}
+	_, err := os.Stat(filepath.Join(out, "api", "generated.go"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Compile("../../models/testdevice-2.0.x"))
+	for _, artifact := range c.Artifacts() {
+		assert.Equal(t, ArtifactUnchanged, artifact.Status, artifact.File)
+	}
+}
+
+func TestCompile_DryRun(t *testing.T) {
+	out := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(out, "Makefile"), []byte("all:\n"), 0644))
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(out)
+	c.SetDryRun(true)
+	assert.NoError(t, c.Compile("../../models/testdevice-2.0.x"))
+
+	statuses := make(map[string]ArtifactStatus)
+	for _, artifact := range c.Artifacts() {
+		statuses[artifact.File] = artifact.Status
+	}
+	assert.Equal(t, ArtifactChanged, statuses["Makefile"])
+	assert.Equal(t, ArtifactCreated, statuses[openapiFile])
+	assert.Equal(t, ArtifactCreated, statuses[filepath.Join("plugin", "main.go")])
+
+	entries, err := os.ReadDir(out)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	content, err := os.ReadFile(filepath.Join(out, "Makefile"))
+	assert.NoError(t, err)
+	assert.Equal(t, "all:\n", string(content))
+}
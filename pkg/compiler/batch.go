@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const metaDataFile = "metadata.yaml"
+
+// BuildResult is the outcome of compiling one model of a workspace
+type BuildResult struct {
+	Path     string
+	Err      error
+	Duration time.Duration
+}
+
+// Succeeded tells whether the model compiled successfully
+func (r *BuildResult) Succeeded() bool {
+	return r.Err == nil
+}
+
+// BuildError aggregates the errors of the models which failed to compile
+type BuildError struct {
+	Failed []*BuildResult
+	Total  int
+}
+
+func (e *BuildError) Error() string {
+	messages := make([]string, 0, len(e.Failed))
+	for _, result := range e.Failed {
+		messages = append(messages, fmt.Sprintf("%s: %v", result.Path, result.Err))
+	}
+	return fmt.Sprintf("%d of %d model(s) failed to compile: %s", len(e.Failed), e.Total, strings.Join(messages, "; "))
+}
+
+// FindModels returns the sorted directories under dir which hold a model meta-data file;
+// the directories of the models themselves are not searched for further models
+func FindModels(dir string) ([]string, error) {
+	models := make([]string, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir && (strings.HasPrefix(d.Name(), ".") || d.Name() == "vendor") {
+			return filepath.SkipDir
+		}
+		if _, err := os.Stat(filepath.Join(path, metaDataFile)); err == nil {
+			models = append(models, path)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(models)
+	return models, nil
+}
+
+// BuildAll compiles every model found under dir, running at most parallelism compilations at
+// once with the compiler newCompiler creates for each model path. All the models are compiled
+// even if some of them fail; the results are returned in model order along with a BuildError
+// if any failed
+func BuildAll(dir string, parallelism int, newCompiler func(path string) *ModelCompiler) ([]*BuildResult, error) {
+	models, err := FindModels(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("no %s found under %s", metaDataFile, dir)
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]*BuildResult, len(models))
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, model := range models {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, model string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			start := time.Now()
+			err := newCompiler(model).Compile(model)
+			results[i] = &BuildResult{Path: model, Err: err, Duration: time.Since(start)}
+		}(i, model)
+	}
+	wg.Wait()
+
+	buildErr := &BuildError{Total: len(results)}
+	for _, result := range results {
+		if !result.Succeeded() {
+			buildErr.Failed = append(buildErr.Failed, result)
+		}
+	}
+	if len(buildErr.Failed) > 0 {
+		return results, buildErr
+	}
+	return results, nil
+}
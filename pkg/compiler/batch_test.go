@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindModels(t *testing.T) {
+	models, err := FindModels("../../models")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"../../models/devicesim-1.0.x",
+		"../../models/e2node-1.x",
+		"../../models/ric-1.x",
+		"../../models/testdevice-1.0.x",
+		"../../models/testdevice-2.0.x",
+	}, models)
+}
+
+func TestBuildAll(t *testing.T) {
+	workspace := t.TempDir()
+	for _, file := range []string{metaDataFile, filepath.Join(yang, "compat-test.yang")} {
+		content, err := os.ReadFile(filepath.Join("testdata/compat/v1", file))
+		assert.NoError(t, err)
+		assert.NoError(t, os.MkdirAll(filepath.Join(workspace, "good", filepath.Dir(file)), os.ModePerm))
+		assert.NoError(t, os.WriteFile(filepath.Join(workspace, "good", file), content, 0644))
+	}
+	assert.NoError(t, os.MkdirAll(filepath.Join(workspace, "broken"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(workspace, "broken", metaDataFile), []byte("name: broken\n"), 0644))
+
+	results, err := BuildAll(workspace, 2, func(path string) *ModelCompiler {
+		c := NewCompiler()
+		c.SetYangBaseDirectory("../../yang-base")
+		return c
+	})
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, filepath.Join(workspace, "broken"), results[0].Path)
+		assert.False(t, results[0].Succeeded())
+		assert.Equal(t, filepath.Join(workspace, "good"), results[1].Path)
+		assert.True(t, results[1].Succeeded())
+		assert.Positive(t, results[1].Duration)
+	}
+	if assert.IsType(t, &BuildError{}, err) {
+		assert.Len(t, err.(*BuildError).Failed, 1)
+		assert.Contains(t, err.Error(), "1 of 2 model(s) failed to compile")
+	}
+	_, err = os.Stat(filepath.Join(workspace, "good", openapiFile))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	configmodels "github.com/onosproject/config-models"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// cacheFile records, in the output directory, the inputs and artifacts of each stage of the last compilation
+const cacheFile = ".model-compiler-cache.json"
+
+// stageCache is the content of the cache file
+type stageCache struct {
+	Stages map[string]*stageRecord `json:"stages"`
+}
+
+// stageRecord is the hash of the inputs of a stage and the hashes of the artifacts it generated, keyed by file
+type stageRecord struct {
+	Inputs    string            `json:"inputs"`
+	Artifacts map[string]string `json:"artifacts"`
+}
+
+// SetForce sets whether every stage is run, regardless of the cache of the previous compilation
+func (c *ModelCompiler) SetForce(force bool) {
+	c.force = force
+}
+
+// loadCache reads the cache of the previous compilation of the model at path. A missing or unreadable
+// cache is empty, so that every stage runs
+func (c *ModelCompiler) loadCache(path string) {
+	c.cache = &stageCache{Stages: make(map[string]*stageRecord)}
+	c.nextCache = &stageCache{Stages: make(map[string]*stageRecord)}
+	if c.force {
+		return
+	}
+	content, err := os.ReadFile(c.outputPath(path, cacheFile))
+	if err != nil {
+		return
+	}
+	cache := &stageCache{}
+	if err := json.Unmarshal(content, cache); err != nil || cache.Stages == nil {
+		log.Warnf("Ignoring unreadable cache %s: %v", c.outputPath(path, cacheFile), err)
+		return
+	}
+	c.cache = cache
+}
+
+// saveCache writes the stages of the compilation to the cache, unless this is a dry run
+func (c *ModelCompiler) saveCache(path string) error {
+	if c.dryRun {
+		return nil
+	}
+	content, err := json.MarshalIndent(c.nextCache, "", "  ")
+	if err != nil {
+		return err
+	}
+	outFile := c.outputPath(path, cacheFile)
+	if err := os.MkdirAll(filepath.Dir(outFile), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(outFile, append(content, '\n'), 0644)
+}
+
+// runStage runs a stage of the compilation, unless its inputs are the same as when it last ran and its
+// artifacts are still untouched, in which case they are recorded as unchanged
+func (c *ModelCompiler) runStage(ctx *StageContext, stage Stage) error {
+	inputs, err := c.stageInputs(ctx, stage)
+	if err != nil {
+		return err
+	}
+	if artifacts, ok := c.cachedArtifacts(ctx.Path, stage.Name(), inputs); ok {
+		log.Infof("Skipping stage %s, its inputs are unchanged", stage.Name())
+		c.artifacts = append(c.artifacts, artifacts...)
+		c.nextCache.Stages[stage.Name()] = c.cache.Stages[stage.Name()]
+		return nil
+	}
+
+	first := len(c.artifacts)
+	if err := stage.Run(ctx); err != nil {
+		return err
+	}
+	record := &stageRecord{Inputs: inputs, Artifacts: make(map[string]string)}
+	for _, artifact := range c.artifacts[first:] {
+		record.Artifacts[artifact.File] = sha256Sum(artifact.content)
+	}
+	c.nextCache.Stages[stage.Name()] = record
+	return nil
+}
+
+// cachedArtifacts returns the artifacts of the stage as they are in the output directory, provided the stage
+// last ran with the same inputs and none of its artifacts changed since
+func (c *ModelCompiler) cachedArtifacts(path string, stage string, inputs string) ([]*Artifact, bool) {
+	record, ok := c.cache.Stages[stage]
+	if !ok || record.Inputs != inputs {
+		return nil, false
+	}
+	artifacts := make([]*Artifact, 0, len(record.Artifacts))
+	for _, file := range sortedKeys(record.Artifacts) {
+		content, err := os.ReadFile(c.outputPath(path, file))
+		if err != nil || sha256Sum(content) != record.Artifacts[file] {
+			return nil, false
+		}
+		artifacts = append(artifacts, &Artifact{File: file, Status: ArtifactUnchanged, content: content})
+	}
+	return artifacts, true
+}
+
+// compilerBuildOnce guards compilerBuildID, which is computed on first use since hashing the executable is slow
+var (
+	compilerBuildOnce sync.Once
+	compilerBuildID   string
+)
+
+// compilerBuild identifies the build of the compiler, since its version is only bumped on release: the VCS revision
+// it was built from if the tree was clean, else the hash of its executable
+func compilerBuild() string {
+	compilerBuildOnce.Do(func() {
+		if info, ok := debug.ReadBuildInfo(); ok {
+			settings := make(map[string]string)
+			for _, setting := range info.Settings {
+				settings[setting.Key] = setting.Value
+			}
+			if revision := settings["vcs.revision"]; revision != "" && settings["vcs.modified"] == "false" {
+				compilerBuildID = revision
+				return
+			}
+		}
+		if executable, err := os.Executable(); err == nil {
+			if content, err := os.ReadFile(executable); err == nil {
+				compilerBuildID = sha256Sum(content)
+				return
+			}
+		}
+		log.Warnf("Unable to identify the compiler build; stages are only rerun on version changes")
+	})
+	return compilerBuildID
+}
+
+// stageInputs returns the hash of the inputs of a stage: the compiler, the YANG files of the model, the options
+// of the stage and whatever else the stage declares it depends on
+func (c *ModelCompiler) stageInputs(ctx *StageContext, stage Stage) (string, error) {
+	inputs, err := stage.Inputs(ctx)
+	if err != nil {
+		return "", err
+	}
+	yangHashes := make(map[string]string)
+	for _, input := range c.manifestInputs(ctx.Path) {
+		if strings.HasSuffix(input, dotYang) {
+			content, err := c.readInput(ctx.Path, input)
+			if err != nil {
+				return "", err
+			}
+			yangHashes[input] = sha256Sum(content)
+		}
+	}
+	m := c.metaData
+	content, err := json.Marshal(struct {
+		Stage           string
+		CompilerVersion string
+		CompilerBuild   string
+		Dependencies    map[string]string
+		Yang            map[string]string
+		Modules         []Module
+		Features        map[string][]string
+		Deviations      []Module
+		Options         map[string]string
+		Inputs          interface{}
+	}{stage.Name(), configmodels.Version(), compilerBuild(), dependencyVersions(), yangHashes, m.Modules, m.Features, m.Deviations,
+		ctx.Options, inputs})
+	if err != nil {
+		return "", err
+	}
+	return sha256Sum(content), nil
+}
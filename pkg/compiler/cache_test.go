@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStageInputs(t *testing.T) {
+	modelPath := "../../models/testdevice-2.0.x"
+	c := loadSampleModel(t, modelPath)
+	c.dictionary = c.newDictionary()
+	hashes := func() map[string]string {
+		inputs := make(map[string]string)
+		for _, stage := range builtinStages {
+			hash, err := c.stageInputs(c.newStageContext(modelPath, stage), stage)
+			assert.NoError(t, err, stage.name)
+			inputs[stage.name] = hash
+		}
+		return inputs
+	}
+	before := hashes()
+
+	c.metaData.ContactName = "Someone Else"
+	c.dictionary = c.newDictionary()
+	changed := make([]string, 0)
+	for stage, hash := range hashes() {
+		if hash != before[stage] {
+			changed = append(changed, stage)
+		}
+	}
+	assert.ElementsMatch(t, []string{"templates", "openapi"}, changed)
+
+	// Every stage reruns with another build of the compiler, even of the same version
+	assert.NotEmpty(t, compilerBuild())
+	before = hashes()
+	build := compilerBuildID
+	compilerBuildID = "another build"
+	defer func() { compilerBuildID = build }()
+	for stage, hash := range hashes() {
+		assert.NotEqual(t, before[stage], hash, stage)
+	}
+}
+
+func TestCompile_Cache(t *testing.T) {
+	modelPath := "../../models/testdevice-2.0.x"
+	out := t.TempDir()
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(out)
+	assert.NoError(t, c.Compile(modelPath))
+	assert.FileExists(t, filepath.Join(out, cacheFile))
+
+	// Skipped stages leave their artifacts alone
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	generated := filepath.Join(out, "api", "generated.go")
+	assert.NoError(t, os.Chtimes(generated, past, past))
+	assert.NoError(t, os.WriteFile(filepath.Join(out, "Dockerfile"), []byte("FROM scratch\n"), 0644))
+	assert.NoError(t, c.Compile(modelPath))
+	statuses := make(map[string]ArtifactStatus)
+	for _, artifact := range c.Artifacts() {
+		statuses[artifact.File] = artifact.Status
+	}
+	assert.Equal(t, ArtifactChanged, statuses["Dockerfile"])
+	assert.Equal(t, ArtifactUnchanged, statuses[filepath.Join("api", "generated.go")])
+	assert.Equal(t, ArtifactUnchanged, statuses[manifestFile])
+	info, err := os.Stat(generated)
+	assert.NoError(t, err)
+	assert.Equal(t, past, info.ModTime())
+
+	c.SetForce(true)
+	assert.NoError(t, c.Compile(modelPath))
+	info, err = os.Stat(generated)
+	assert.NoError(t, err)
+	assert.NotEqual(t, past, info.ModTime())
+}
@@ -0,0 +1,399 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"path"
+	"sort"
+	"strings"
+)
+
+// ChangeImpact classifies how a schema change affects existing configurations
+type ChangeImpact int
+
+const (
+	// ImpactEditorial changes only affect documentation
+	ImpactEditorial ChangeImpact = iota
+	// ImpactCompatible changes keep every existing configuration valid
+	ImpactCompatible
+	// ImpactBreaking changes may invalidate existing configurations
+	ImpactBreaking
+)
+
+func (i ChangeImpact) String() string {
+	switch i {
+	case ImpactEditorial:
+		return "editorial"
+	case ImpactCompatible:
+		return "compatible"
+	case ImpactBreaking:
+		return "breaking"
+	default:
+		return "unknown"
+	}
+}
+
+// Kinds of schema changes reported by CompareEntries
+const (
+	ChangeAdded       = "added"
+	ChangeRemoved     = "removed"
+	ChangeRenamed     = "renamed"
+	ChangeNodeKind    = "node-kind"
+	ChangeConfig      = "config"
+	ChangeType        = "type"
+	ChangeRange       = "range"
+	ChangeLength      = "length"
+	ChangePattern     = "pattern"
+	ChangeEnum        = "enum"
+	ChangeMandatory   = "mandatory"
+	ChangeKeys        = "keys"
+	ChangeElements    = "elements"
+	ChangeDefault     = "default"
+	ChangeUnits       = "units"
+	ChangeDescription = "description"
+)
+
+// SchemaChange is a single difference between two versions of a model schema
+type SchemaChange struct {
+	Path    string
+	Kind    string
+	Impact  ChangeImpact
+	Message string
+}
+
+func (c *SchemaChange) String() string {
+	return fmt.Sprintf("%-10s %s: [%s] %s", c.Impact, c.Path, c.Kind, c.Message)
+}
+
+// IsBreaking returns true if the change may invalidate existing configurations
+func (c *SchemaChange) IsBreaking() bool {
+	return c.Impact == ImpactBreaking
+}
+
+type schemaDiff struct {
+	changes []*SchemaChange
+}
+
+// CompareEntries compares the data trees of two versions of a model's root YANG
+// entries and returns every change, ordered by path
+func CompareEntries(oldEntries []*goyang.Entry, newEntries []*goyang.Entry) []*SchemaChange {
+	oldNodes := flattenEntries(oldEntries)
+	newNodes := flattenEntries(newEntries)
+	d := &schemaDiff{}
+
+	removed := make([]string, 0)
+	for _, p := range sortedPaths(oldNodes) {
+		newNode, ok := newNodes[p]
+		if !ok {
+			if _, parentRemoved := oldNodes[path.Dir(p)]; parentRemoved && newNodes[path.Dir(p)] == nil {
+				continue
+			}
+			removed = append(removed, p)
+			continue
+		}
+		d.compareNodes(p, oldNodes[p], newNode)
+	}
+	added := make([]string, 0)
+	for _, p := range sortedPaths(newNodes) {
+		if _, ok := oldNodes[p]; !ok {
+			if _, parentAdded := newNodes[path.Dir(p)]; parentAdded && oldNodes[path.Dir(p)] == nil {
+				continue
+			}
+			added = append(added, p)
+		}
+	}
+
+	// A node removed and another of the same shape added under the same parent is most likely a rename
+	renamed := make(map[string]bool)
+	for _, r := range removed {
+		for _, a := range added {
+			if !renamed[a] && path.Dir(r) == path.Dir(a) && sameShape(oldNodes[r], newNodes[a]) {
+				d.add(r, ChangeRenamed, ImpactBreaking, "renamed to %s", path.Base(a))
+				renamed[r], renamed[a] = true, true
+				break
+			}
+		}
+		if !renamed[r] {
+			d.add(r, ChangeRemoved, ImpactBreaking, "%s removed", nodeKind(oldNodes[r]))
+		}
+	}
+	for _, a := range added {
+		if renamed[a] {
+			continue
+		}
+		if isMandatory(newNodes[a]) {
+			d.add(a, ChangeMandatory, ImpactBreaking, "mandatory %s added", nodeKind(newNodes[a]))
+		} else {
+			d.add(a, ChangeAdded, ImpactCompatible, "%s added", nodeKind(newNodes[a]))
+		}
+	}
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+	return d.changes
+}
+
+func (d *schemaDiff) add(path string, kind string, impact ChangeImpact, format string, args ...interface{}) {
+	d.changes = append(d.changes, &SchemaChange{
+		Path:    path,
+		Kind:    kind,
+		Impact:  impact,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *schemaDiff) compareNodes(p string, oldNode *goyang.Entry, newNode *goyang.Entry) {
+	oldKind, newKind := nodeKind(oldNode), nodeKind(newNode)
+	if oldKind != newKind {
+		d.add(p, ChangeNodeKind, ImpactBreaking, "changed from %s to %s", oldKind, newKind)
+		return
+	}
+	if oldNode.ReadOnly() != newNode.ReadOnly() {
+		d.add(p, ChangeConfig, ImpactBreaking, "changed from %s to %s", configName(oldNode), configName(newNode))
+	}
+	if oldNode.Description != newNode.Description {
+		d.add(p, ChangeDescription, ImpactEditorial, "description changed")
+	}
+
+	oldMandatory, newMandatory := oldNode.Mandatory == goyang.TSTrue, newNode.Mandatory == goyang.TSTrue
+	if !oldMandatory && newMandatory {
+		d.add(p, ChangeMandatory, ImpactBreaking, "became mandatory")
+	} else if oldMandatory && !newMandatory {
+		d.add(p, ChangeMandatory, ImpactCompatible, "no longer mandatory")
+	}
+
+	if oldNode.IsList() && normalizeKeys(oldNode.Key) != normalizeKeys(newNode.Key) {
+		d.add(p, ChangeKeys, ImpactBreaking, "keys changed from [%s] to [%s]", oldNode.Key, newNode.Key)
+	}
+	if oldNode.ListAttr != nil && newNode.ListAttr != nil {
+		if newNode.ListAttr.MinElements > oldNode.ListAttr.MinElements ||
+			newNode.ListAttr.MaxElements < oldNode.ListAttr.MaxElements {
+			d.add(p, ChangeElements, ImpactBreaking, "number of elements narrowed")
+		} else if newNode.ListAttr.MinElements != oldNode.ListAttr.MinElements ||
+			newNode.ListAttr.MaxElements != oldNode.ListAttr.MaxElements {
+			d.add(p, ChangeElements, ImpactCompatible, "number of elements widened")
+		}
+	}
+
+	if oldNode.IsLeaf() || oldNode.IsLeafList() {
+		if oldNode.Units != newNode.Units {
+			d.add(p, ChangeUnits, ImpactBreaking, "units changed from %q to %q", oldNode.Units, newNode.Units)
+		}
+		oldDefault, newDefault := strings.Join(oldNode.Default, ","), strings.Join(newNode.Default, ",")
+		if oldDefault == "" && newDefault != "" {
+			d.add(p, ChangeDefault, ImpactCompatible, "default %q added", newDefault)
+		} else if oldDefault != newDefault {
+			d.add(p, ChangeDefault, ImpactBreaking, "default changed from %q to %q", oldDefault, newDefault)
+		}
+		d.compareTypes(p, oldNode.Type, newNode.Type)
+	}
+}
+
+func (d *schemaDiff) compareTypes(p string, oldType *goyang.YangType, newType *goyang.YangType) {
+	if oldType == nil || newType == nil {
+		return
+	}
+	if oldType.Kind != newType.Kind {
+		d.add(p, ChangeType, ImpactBreaking, "type changed from %s to %s", oldType.Kind, newType.Kind)
+		return
+	}
+
+	switch oldType.Kind {
+	case goyang.Yunion:
+		oldMembers, newMembers := unionMembers(oldType), unionMembers(newType)
+		if removed := missingFrom(oldMembers, newMembers); len(removed) > 0 {
+			d.add(p, ChangeType, ImpactBreaking, "union member type(s) %s removed", strings.Join(removed, ", "))
+		} else if added := missingFrom(newMembers, oldMembers); len(added) > 0 {
+			d.add(p, ChangeType, ImpactCompatible, "union member type(s) %s added", strings.Join(added, ", "))
+		}
+	case goyang.Yleafref:
+		if oldType.Path != newType.Path {
+			d.add(p, ChangeType, ImpactBreaking, "leafref path changed from %s to %s", oldType.Path, newType.Path)
+		}
+	case goyang.Ydecimal64:
+		if oldType.FractionDigits != newType.FractionDigits {
+			d.add(p, ChangeType, ImpactBreaking, "fraction-digits changed from %d to %d",
+				oldType.FractionDigits, newType.FractionDigits)
+		}
+	case goyang.Yenum, goyang.Ybits:
+		d.compareNames(p, enumNames(oldType.Enum), enumNames(newType.Enum))
+		d.compareNames(p, enumNames(oldType.Bit), enumNames(newType.Bit))
+	case goyang.Yidentityref:
+		d.compareNames(p, identityNames(oldType.IdentityBase), identityNames(newType.IdentityBase))
+	}
+
+	d.compareRanges(p, ChangeRange, oldType.Range, newType.Range)
+	d.compareRanges(p, ChangeLength, oldType.Length, newType.Length)
+
+	oldPatterns := append(append([]string{}, oldType.Pattern...), oldType.POSIXPattern...)
+	newPatterns := append(append([]string{}, newType.Pattern...), newType.POSIXPattern...)
+	if added := missingFrom(newPatterns, oldPatterns); len(added) > 0 {
+		d.add(p, ChangePattern, ImpactBreaking, "pattern(s) %s added", strings.Join(added, ", "))
+	} else if removed := missingFrom(oldPatterns, newPatterns); len(removed) > 0 {
+		d.add(p, ChangePattern, ImpactCompatible, "pattern(s) %s removed", strings.Join(removed, ", "))
+	}
+}
+
+func (d *schemaDiff) compareRanges(p string, kind string, oldRange goyang.YangRange, newRange goyang.YangRange) {
+	if oldRange.Equal(newRange) {
+		return
+	}
+	// An empty range is unrestricted
+	if len(newRange) > 0 && (len(oldRange) == 0 || !newRange.Contains(oldRange)) {
+		d.add(p, kind, ImpactBreaking, "%s narrowed from %s to %s", kind, rangeString(oldRange), rangeString(newRange))
+	} else {
+		d.add(p, kind, ImpactCompatible, "%s widened from %s to %s", kind, rangeString(oldRange), rangeString(newRange))
+	}
+}
+
+func (d *schemaDiff) compareNames(p string, oldNames []string, newNames []string) {
+	if removed := missingFrom(oldNames, newNames); len(removed) > 0 {
+		d.add(p, ChangeEnum, ImpactBreaking, "value(s) %s removed", strings.Join(removed, ", "))
+	}
+	if added := missingFrom(newNames, oldNames); len(added) > 0 {
+		d.add(p, ChangeEnum, ImpactCompatible, "value(s) %s added", strings.Join(added, ", "))
+	}
+}
+
+// flattenEntries indexes every data node by its schema path; choice and case nodes are not part of the path
+func flattenEntries(entries []*goyang.Entry) map[string]*goyang.Entry {
+	nodes := make(map[string]*goyang.Entry)
+	for _, e := range entries {
+		flattenEntry(e, "", nodes)
+	}
+	return nodes
+}
+
+func flattenEntry(e *goyang.Entry, parentPath string, nodes map[string]*goyang.Entry) {
+	names := make([]string, 0, len(e.Dir))
+	for name := range e.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		child := e.Dir[name]
+		if child.RPC != nil || child.Kind == goyang.NotificationEntry {
+			continue
+		}
+		if child.IsChoice() || child.IsCase() {
+			flattenEntry(child, parentPath, nodes)
+			continue
+		}
+		childPath := fmt.Sprintf("%s/%s", parentPath, child.Name)
+		nodes[childPath] = child
+		flattenEntry(child, childPath, nodes)
+	}
+}
+
+func sortedPaths(nodes map[string]*goyang.Entry) []string {
+	paths := make([]string, 0, len(nodes))
+	for p := range nodes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func nodeKind(e *goyang.Entry) string {
+	switch {
+	case e.IsLeaf():
+		return "leaf"
+	case e.IsLeafList():
+		return "leaf-list"
+	case e.IsList():
+		return "list"
+	case e.IsContainer():
+		return "container"
+	default:
+		return e.Kind.String()
+	}
+}
+
+func configName(e *goyang.Entry) string {
+	if e.ReadOnly() {
+		return "state"
+	}
+	return "config"
+}
+
+func isMandatory(e *goyang.Entry) bool {
+	if e.Mandatory == goyang.TSTrue {
+		return true
+	}
+	return e.ListAttr != nil && e.ListAttr.MinElements > 0
+}
+
+// sameShape returns true if two nodes have the same kind, type and children names
+func sameShape(a *goyang.Entry, b *goyang.Entry) bool {
+	if nodeKind(a) != nodeKind(b) || len(a.Dir) != len(b.Dir) {
+		return false
+	}
+	if a.Type != nil || b.Type != nil {
+		return a.Type.Equal(b.Type)
+	}
+	for name := range a.Dir {
+		if _, ok := b.Dir[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeKeys(key string) string {
+	keys := strings.Fields(key)
+	sort.Strings(keys)
+	return strings.Join(keys, " ")
+}
+
+func unionMembers(t *goyang.YangType) []string {
+	members := make([]string, 0, len(t.Type))
+	for _, m := range t.Type {
+		members = append(members, m.Kind.String())
+	}
+	return members
+}
+
+func enumNames(e *goyang.EnumType) []string {
+	if e == nil {
+		return nil
+	}
+	return e.Names()
+}
+
+func identityNames(base *goyang.Identity) []string {
+	if base == nil {
+		return nil
+	}
+	names := make([]string, 0, len(base.Values))
+	for _, v := range base.Values {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func rangeString(r goyang.YangRange) string {
+	if len(r) == 0 {
+		return "unrestricted"
+	}
+	return r.String()
+}
+
+// missingFrom returns the values of a that are not in b
+func missingFrom(a []string, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, v := range b {
+		present[v] = true
+	}
+	missing := make([]string, 0)
+	for _, v := range a {
+		if !present[v] {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func loadTestEntries(t *testing.T, path string) []*goyang.Entry {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	entries, err := c.LoadModelEntries(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestCompareEntries(t *testing.T) {
+	changes := CompareEntries(loadTestEntries(t, "testdata/compat/v1"), loadTestEntries(t, "testdata/compat/v2"))
+
+	type expected struct {
+		kind   string
+		impact ChangeImpact
+	}
+	found := make(map[string][]expected)
+	for _, c := range changes {
+		found[c.Path] = append(found[c.Path], expected{kind: c.Kind, impact: c.Impact})
+	}
+
+	assert.Equal(t, []expected{{ChangeDescription, ImpactEditorial}}, found["/settings"])
+	assert.Equal(t, []expected{{ChangeRange, ImpactBreaking}}, found["/settings/port"])
+	assert.Equal(t, []expected{{ChangeLength, ImpactCompatible}, {ChangePattern, ImpactBreaking}}, found["/settings/name"])
+	assert.Equal(t, []expected{{ChangeEnum, ImpactBreaking}, {ChangeEnum, ImpactCompatible}}, found["/settings/mode"])
+	assert.Equal(t, []expected{{ChangeConfig, ImpactBreaking}}, found["/settings/counter"])
+	assert.Equal(t, []expected{{ChangeType, ImpactBreaking}}, found["/settings/legacy"])
+	assert.Equal(t, []expected{{ChangeRenamed, ImpactBreaking}}, found["/settings/old-label"])
+	assert.Empty(t, found["/settings/new-label"])
+	assert.Equal(t, []expected{{ChangeAdded, ImpactCompatible}}, found["/settings/optional"])
+	assert.Equal(t, []expected{{ChangeMandatory, ImpactBreaking}}, found["/settings/required"])
+	assert.Equal(t, []expected{{ChangeKeys, ImpactBreaking}}, found["/entry"])
+	assert.Empty(t, found["/entry/id"])
+}
+
+func TestCompareEntries_Unchanged(t *testing.T) {
+	entries := loadTestEntries(t, "../../models/testdevice-2.0.x")
+	assert.Empty(t, CompareEntries(entries, loadTestEntries(t, "../../models/testdevice-2.0.x")))
+}
+
+func TestCompareEntries_TestDevice(t *testing.T) {
+	changes := CompareEntries(loadTestEntries(t, "../../models/testdevice-1.0.x"),
+		loadTestEntries(t, "../../models/testdevice-2.0.x"))
+
+	breaking := make(map[string][]string)
+	for _, c := range changes {
+		if c.IsBreaking() {
+			breaking[c.Path] = append(breaking[c.Path], c.Kind)
+		}
+	}
+	assert.Equal(t, []string{ChangeType}, breaking["/cont1a/cont2a/leaf2b"])
+	assert.Equal(t, []string{ChangeRemoved}, breaking["/cont1a/list5"])
+	assert.Contains(t, breaking["/cont1b-state/list2b"], ChangeKeys)
+	assert.NotContains(t, breaking, "/cont1a/cont2d")
+}
@@ -5,20 +5,24 @@
 package compiler
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	api "github.com/onosproject/onos-api/go/onos/config/admin"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	_ "github.com/openconfig/gnmi/proto/gnmi" // gnmi
-	_ "github.com/openconfig/goyang/pkg/yang" // yang
-	_ "github.com/openconfig/ygot/genutil"    // genutil
-	_ "github.com/openconfig/ygot/ygen"       // ygen
-	_ "github.com/openconfig/ygot/ygot"       // ygot
-	_ "github.com/openconfig/ygot/ytypes"     // ytypes
-	_ "google.golang.org/protobuf/proto"      // proto
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	_ "github.com/openconfig/ygot/genutil" // genutil
+	"github.com/openconfig/ygot/gogen"
+	_ "github.com/openconfig/ygot/ygen" // ygen
+	_ "github.com/openconfig/ygot/ygot" // ygot
+	"github.com/openconfig/ygot/ytypes"
+	_ "google.golang.org/protobuf/proto" // proto
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -31,139 +35,175 @@ const (
 	gomodTemplate      = "go.mod.tpl"
 	makefileTemplate   = "Makefile.tpl"
 	dockerfileTemplate = "Dockerfile.tpl"
-	openapiGenTemplate = "openapi-gen.go.tpl"
+	testTemplate       = "generated_test.go.tpl"
+	validateTemplate   = "validate.go.tpl"
 	yang               = "yang"
 	dotYang            = ".yang"
 	pyang              = "pyang"
 )
 
-const yangBaseDirectory = "/var/model-compiler/yang-base"
+// DefaultYangBaseDirectory is where the model-compiler image keeps the common YANG modules
+const DefaultYangBaseDirectory = "/var/model-compiler/yang-base"
 
 // NewCompiler creates a new config model compiler
 func NewCompiler() *ModelCompiler {
-	return &ModelCompiler{}
+	return &ModelCompiler{yangBaseDirectory: DefaultYangBaseDirectory}
 }
 
 type Dictionary struct {
-	Name               string
-	Version            string
-	ArtifactName       string
-	GoPackage          string
-	ModelData          []*gnmi.ModelData
-	GetStateMode       uint32
-	ReadOnlyPath       []*api.ReadOnlyPath
-	ReadWritePath      []*api.ReadWritePath
-	OpenAPITargetAlias string
-	ContactName        string
-	ContactUrl         string
-	ContactEmail       string
-	LicenseName        string
-	LicenseUrl         string
+	Name                string
+	Version             string
+	ArtifactName        string
+	GoPackage           string
+	ModelData           []*gnmi.ModelData
+	Features            []string
+	Deviations          []string
+	FakeRoot            string
+	GenProto            bool
+	GenDocs             bool
+	GetStateMode        uint32
+	ReadOnlyPath        []*api.ReadOnlyPath
+	ReadWritePath       []*api.ReadWritePath
+	OpenAPITargetAlias  string
+	ContactName         string
+	ContactUrl          string
+	ContactEmail        string
+	LicenseName         string
+	LicenseUrl          string
+	ConfigModelsVersion string
 }
 
 // ModelCompiler is a model plugin compiler
 type ModelCompiler struct {
-	metaData   *MetaData
-	modelInfo  *api.ModelInfo
-	dictionary Dictionary
+	metaData          *MetaData
+	modelInfo         *api.ModelInfo
+	modules           *moduleGraph
+	dictionary        Dictionary
+	yangBaseDirectory string
+	outputDirectory   string
+	dryRun            bool
+	noFormat          bool
+	force             bool
+	extraStages       []Stage
+	artifacts         []*Artifact
+	// skipped are the checks and artifacts of the compilation left out for want of a tool, such as pyang
+	skipped []string
+	// entries are the processed goyang entries of the model, shared by the stages of a compilation
+	entries []*goyang.Entry
+	// ygotCode, ygotSources and schema are the ygot code and schema of the model, shared by the stages of a
+	// compilation
+	ygotCode    *gogen.GeneratedCode
+	ygotSources *yangSources
+	schema      *ytypes.Schema
+	// cache holds the stages of the previous compilation and nextCache those of the current one
+	cache     *stageCache
+	nextCache *stageCache
+}
+
+// SetYangBaseDirectory sets the directory holding the common YANG modules imported by models
+func (c *ModelCompiler) SetYangBaseDirectory(dir string) {
+	c.yangBaseDirectory = dir
+}
+
+// reset forgets the artifacts and the shared results of the previous compilation
+func (c *ModelCompiler) reset() {
+	c.artifacts = nil
+	c.entries = nil
+	c.ygotCode, c.ygotSources, c.schema = nil, nil, nil
+	c.skipped = nil
 }
 
 // Compile compiles the config model
 func (c *ModelCompiler) Compile(path string) error {
 	log.Infof("Compiling config model at '%s'", path)
+	c.reset()
 	var err error
 
 	// Make sure inputs are present: meta-data file and YANG files directory
 	// Read model meta-data
 	err = c.loadModelMetaData(path)
 	if err != nil {
-		log.Errorf("Unable to read model meta-data: %+v", err)
+		log.Errorf("Unable to load model meta-data and YANG modules: %+v", err)
 		return err
 	}
 
-	// Lint YANG files if the model requests lint validation
-	if c.metaData.LintModel {
-		err = c.lintModel(path)
-		if err != nil {
-			log.Errorf("YANG files contain issues: %+v", err)
-			return err
-		}
-	}
-
-	// Format YANG files if the model requests formatting
-	if c.metaData.FormatYang {
-		err = c.formatYang(path)
-		if err != nil {
-			log.Errorf("YANG file formatting failed: %+v", err)
-			return err
-		}
-	}
+	// Read the stages of the previous compilation, which are skipped if their inputs did not change
+	c.loadCache(path)
 
 	// Create dictionary from metadata and model info
-	c.dictionary = Dictionary{
-		Name:               c.modelInfo.Name,
-		Version:            c.modelInfo.Version,
-		ArtifactName:       c.metaData.ArtifactName,
-		GoPackage:          c.metaData.GoPackage,
-		ModelData:          c.modelInfo.ModelData,
-		GetStateMode:       c.modelInfo.GetStateMode,
-		ReadOnlyPath:       c.modelInfo.ReadOnlyPath,
-		ReadWritePath:      c.modelInfo.ReadWritePath,
-		OpenAPITargetAlias: c.metaData.OpenAPITargetAlias,
-		ContactName:        c.metaData.ContactName,
-		ContactUrl:         c.metaData.ContactUrl,
-		ContactEmail:       c.metaData.ContactEmail,
-		LicenseName:        c.metaData.LicenseName,
-		LicenseUrl:         c.metaData.LicenseUrl,
-	}
+	c.dictionary = c.newDictionary()
+	c.dictionary.ConfigModelsVersion = configModelsVersion()
 
-	// Generate Golang bindings for the YANG files
-	err = c.generateGolangBindings(path)
+	// Run the built-in stages enabled by the meta-data, then the extra ones
+	stages, err := c.stages()
 	if err != nil {
-		log.Errorf("Unable to generate Golang bindings: %+v", err)
+		log.Errorf("Unable to set up compilation stages: %+v", err)
 		return err
 	}
-
-	// Generate YANG model tree
-	err = c.generateModelTree(path)
-	if err != nil {
-		log.Errorf("Unable to generate YANG model tree: %+v", err)
-		return err
-	}
-
-	// Generate model plugin artifacts from generic templates
-	err = c.generatePluginArtifacts(path)
-	if err != nil {
-		log.Errorf("Unable to generate model plugin artifacts: %+v", err)
-		return err
+	for _, stage := range stages {
+		err = c.runStage(c.newStageContext(path, stage), stage)
+		if err != nil {
+			if builtin, ok := stage.(*builtinStage); ok {
+				log.Errorf("%s: %+v", builtin.failure, err)
+			} else {
+				log.Errorf("Stage %s failed: %+v", stage.Name(), err)
+			}
+			return err
+		}
 	}
 
-	// Generate OpenAPI
-	err = c.generateOpenApi(path)
+	// Generate the build manifest last, since it records the hashes of the other artifacts
+	err = c.generateManifest(path)
 	if err != nil {
-		log.Errorf("Unable to generate OpenApi specs: %+v", err)
+		log.Errorf("Unable to generate build manifest: %+v", err)
 		return err
 	}
 
-	return nil
+	return c.saveCache(path)
 }
 
 func (c *ModelCompiler) loadModelMetaData(path string) error {
 	c.metaData = &MetaData{LintModel: true, RequireHyphenated: true, FormatYang: true}
-	if err := LoadMetaData(path, "metadata", c.metaData); err != nil {
+
+	// Report the decoding and validation issues together, in file order
+	var errs MetaDataErrors
+	if err := LoadMetaData(path, "metadata", c.metaData); err != nil && !errors.As(err, &errs) {
 		return err
 	}
-	if err := ValidateMetaData(c.metaData); err != nil {
-		return err
+	if err := ValidateMetaData(path, c.metaData); err != nil {
+		errs = append(errs, err.(MetaDataErrors)...)
 	}
-	modelData := make([]*gnmi.ModelData, 0, len(c.metaData.Modules))
-	for _, module := range c.metaData.Modules {
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			if errs[i].Line != errs[j].Line {
+				return errs[i].Line < errs[j].Line
+			}
+			return errs[i].Column < errs[j].Column
+		})
+		return errs
+	}
+	modelData := make([]*gnmi.ModelData, 0, len(c.metaData.Modules)+len(c.metaData.Deviations))
+	for _, module := range append(append([]Module{}, c.metaData.Modules...), c.metaData.Deviations...) {
 		modelData = append(modelData, &gnmi.ModelData{
 			Name:         module.Name,
 			Version:      module.Revision,
 			Organization: module.Organization,
 		})
 	}
+
+	// Advertise the imported modules as well, so that the plugin reports the complete module set
+	modules, err := c.buildModuleGraph(path)
+	if err != nil {
+		return err
+	}
+	c.modules = modules
+	for _, file := range c.modules.Unused {
+		log.Warnf("YANG file %s is not used by any module listed in the meta-data", file)
+	}
+	if err := c.validateFeatures(); err != nil {
+		return err
+	}
+	modelData = append(modelData, c.modules.ImportedModelData()...)
 	c.modelInfo = &api.ModelInfo{
 		Name:         c.metaData.Name,
 		Version:      c.metaData.Version,
@@ -173,38 +213,71 @@ func (c *ModelCompiler) loadModelMetaData(path string) error {
 	return nil
 }
 
+func (c *ModelCompiler) newDictionary() Dictionary {
+	return Dictionary{
+		Name:               c.modelInfo.Name,
+		Version:            c.modelInfo.Version,
+		ArtifactName:       c.metaData.ArtifactName,
+		GoPackage:          c.metaData.GoPackage,
+		ModelData:          c.modelInfo.ModelData,
+		Features:           c.enabledFeatures(),
+		Deviations:         c.deviationNames(),
+		FakeRoot:           c.fakeRootName(),
+		GenProto:           c.metaData.GenProto,
+		GenDocs:            c.metaData.GenDocs != "",
+		GetStateMode:       c.modelInfo.GetStateMode,
+		ReadOnlyPath:       c.modelInfo.ReadOnlyPath,
+		ReadWritePath:      c.modelInfo.ReadWritePath,
+		OpenAPITargetAlias: c.metaData.OpenAPITargetAlias,
+		ContactName:        c.metaData.ContactName,
+		ContactUrl:         c.metaData.ContactUrl,
+		ContactEmail:       c.metaData.ContactEmail,
+		LicenseName:        c.metaData.LicenseName,
+		LicenseUrl:         c.metaData.LicenseUrl,
+	}
+}
+
 func (c *ModelCompiler) lintModel(path string) error {
 	log.Infof("Linting YANG files")
 
-	// Append the root YANG files to the command-line arguments
-	yangDir := filepath.Join(path, "yang")
-	yangDirs := []string{yangBaseDirectory, yangDir}
-	args := []string{"--lint", "-W", "error", "--ignore-error=XPATH_FUNCTION", "-p", strings.Join(yangDirs, ":")}
-	if c.metaData.RequireHyphenated {
-		args = append(args, "--lint-ensure-hyphenated-names")
+	yangDir := filepath.Join(path, yang)
+	searchPath, err := c.yangSearchPath(path)
+	if err != nil {
+		return err
 	}
+	files := make([]string, 0, len(c.metaData.Modules))
 	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
+		files = append(files, filepath.Join(yangDir, module.YangFile))
 	}
 
-	log.Infof("Executing pyang %v", args)
-	cmd := exec.Command(pyang, args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	findings, err := LintYang(files, searchPath, LintOptions{RequireHyphenated: c.metaData.RequireHyphenated})
+	if err != nil {
+		return err
+	}
+	for _, finding := range findings {
+		log.Errorf("%s", finding)
+	}
+	if len(findings) > 0 {
+		return fmt.Errorf("%d lint issue(s) found", len(findings))
+	}
+	return nil
 }
 
 func (c *ModelCompiler) formatYang(path string) error {
+	if _, err := exec.LookPath(pyang); err != nil {
+		log.Warnf("%s is not installed; skipping YANG formatting", pyang)
+		c.skipped = append(c.skipped, fmt.Sprintf("YANG formatting: %s is not installed", pyang))
+		return nil
+	}
 	log.Infof("Formatting YANG files")
 
 	// Append the root YANG files to the command-line arguments
 	yangDir := filepath.Join(path, yang)
-	yangDirs := []string{yangBaseDirectory, yangDir}
+	yangDirs := []string{c.yangBaseDirectory, yangDir}
 
-	tempFile := fmt.Sprintf("%s/temp-formatted.yang", os.TempDir())
+	unformatted := 0
 	err := filepath.Walk(yangDir,
-		func(path string, info os.FileInfo, err error) error {
+		func(file string, info os.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
@@ -212,50 +285,68 @@ func (c *ModelCompiler) formatYang(path string) error {
 				return nil
 			}
 
-			args := []string{"-f", yang, "--ignore-error=XPATH_FUNCTION", "-p", strings.Join(yangDirs, ":"), path,
-				"-o", tempFile}
+			args := []string{"-f", yang, "--ignore-error=XPATH_FUNCTION", "-p", strings.Join(yangDirs, ":"), file}
 			log.Infof("Formatting YANG with: pyang %v", args)
-			cmd := exec.Command(pyang, args...)
-			cmd.Env = os.Environ()
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stderr
-			if err := cmd.Run(); err != nil {
+			formatted, err := runPyang(args)
+			if err != nil {
 				return err
 			}
-			input, err := os.ReadFile(tempFile)
+			input, err := os.ReadFile(file)
 			if err != nil {
 				return err
 			}
-			err = os.WriteFile(path, input, 0644)
+			rel, err := filepath.Rel(path, file)
 			if err != nil {
 				return err
 			}
+			if !c.noFormat {
+				return c.writeArtifact(path, rel, formatted, 0644)
+			}
+			if !bytes.Equal(input, formatted) {
+				unformatted++
+				log.Warnf("%s is not formatted:\n%s", rel, unifiedDiff(rel, input, formatted))
+			}
 			return nil
 		})
 	if err != nil {
 		return err
 	}
-
+	if unformatted > 0 {
+		log.Warnf("%d YANG file(s) are not formatted", unformatted)
+	}
 	return nil
 }
 
-func (c *ModelCompiler) generateGolangBindings(path string) error {
-	apiDir := filepath.Join(path, "api")
-	c.createDir(apiDir)
+// runPyang runs pyang with the given arguments and returns its standard output
+func runPyang(args []string) ([]byte, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command(pyang, args...)
+	cmd.Env = os.Environ()
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
 
-	apiFile := filepath.Join(apiDir, "generated.go")
-	log.Infof("Generating YANG bindings '%s'", apiFile)
+func (c *ModelCompiler) generateGolangBindings(path string) error {
+	apiFile := filepath.Join("api", "generated.go")
+	log.Infof("Generating YANG bindings '%s'", c.outputPath(path, apiFile))
 
-	args := []string{
-		fmt.Sprintf("-output_file=%s", apiFile),
-		"-package_name=api",
-		"-generate_fakeroot",
-		"--include_descriptions",
+	code, sources, err := c.compiledYgotCode(path)
+	if err != nil {
+		return err
 	}
+	var content bytes.Buffer
+	writeGoCode(&content, code)
+	return c.writeArtifact(path, apiFile, insertHeaderPrefix(content.Bytes(), sources.path, sources.yangBaseDirectory), 0640)
+}
 
-	// Append all YANG files to the command-line arguments
-	pathDirs := []string{yangBaseDirectory}
-	err := filepath.Walk(filepath.Join(path, "yang"), func(p string, info os.FileInfo, err error) error {
+// yangSearchPath returns the base YANG directory followed by every directory under the model's yang directory
+func (c *ModelCompiler) yangSearchPath(path string) ([]string, error) {
+	pathDirs := []string{c.yangBaseDirectory}
+	err := filepath.Walk(filepath.Join(path, yang), func(p string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -265,57 +356,80 @@ func (c *ModelCompiler) generateGolangBindings(path string) error {
 		return nil
 	})
 	if err != nil {
-		return err
-	}
-	args = append(args, fmt.Sprintf("-path=%s", strings.Join(pathDirs, ",")))
-	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(path, "yang", module.YangFile))
+		return nil, err
 	}
+	return pathDirs, nil
+}
 
-	log.Infof("Executing: generator %v", args)
-	cmd := exec.Command("generator", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	err = cmd.Run()
-	if err != nil {
-		return err
+// insertHeaderPrefix marks the code as generated and makes the YANG file and directory paths
+// listed in the ygot header relative, so that the code does not depend on where the model
+// or the base YANG modules were mounted
+func insertHeaderPrefix(content []byte, path string, yangBaseDirectory string) []byte {
+	header, code, _ := bytes.Cut(content, []byte("\npackage "))
+	lines := strings.Split(string(header), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, headerListPrefix) {
+			value := strings.TrimPrefix(line, headerListPrefix)
+			lines[i] = headerListPrefix + relativeHeaderPath(value, path, yangBaseDirectory)
+		}
 	}
 
-	return insertHeaderPrefix(apiFile)
+	newContent := []byte("// Code generated by YGOT. DO NOT")
+	newContent = append(newContent, []byte("EDIT.\n")...) // HACK: Defeat the license header check
+	newContent = append(newContent, []byte(strings.Join(lines, "\n"))...)
+	newContent = append(newContent, []byte("\npackage ")...)
+	return append(newContent, code...)
 }
 
-func insertHeaderPrefix(file string) error {
-	content, err := os.ReadFile(file)
-	if err != nil {
-		return err
+const headerListPrefix = "\t- "
+
+func relativeHeaderPath(value string, path string, yangBaseDirectory string) string {
+	if rel, ok := relativePath(yangBaseDirectory, value); ok {
+		return filepath.Join(filepath.Base(DefaultYangBaseDirectory), rel)
+	}
+	if rel, ok := relativePath(path, value); ok {
+		return rel
 	}
+	return value
+}
 
-	newContent := []byte("// Code generated by YGOT. DO NOT")
-	newContent = append(newContent, []byte("EDIT.\n")...) // HACK: Defeat the license header check
-	newContent = append(newContent, content...)
-	return os.WriteFile(file, newContent, 0640)
+// relativePath returns target relative to base if target is under base
+func relativePath(base string, target string) (string, bool) {
+	rel, err := filepath.Rel(base, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	return rel, true
 }
 
 func (c *ModelCompiler) generateModelTree(path string) error {
-	treeFile := filepath.Join(path, c.modelInfo.Name+".tree")
-	log.Infof("Generating YANG tree '%s'", treeFile)
+	treeFile := c.modelInfo.Name + ".tree"
+	if _, err := exec.LookPath(pyang); err != nil {
+		log.Warnf("%s is not installed; skipping YANG tree generation", pyang)
+		c.skipped = append(c.skipped, fmt.Sprintf("%s: %s is not installed", treeFile, pyang))
+		return nil
+	}
+	log.Infof("Generating YANG tree '%s'", c.outputPath(path, treeFile))
 
-	yangDir := filepath.Join(path, "yang")
-	yangDirs := []string{yangBaseDirectory, yangDir}
-	args := []string{"-f", "tree", "--ignore-error=XPATH_FUNCTION", "-p", strings.Join(yangDirs, ":"), "-o", treeFile}
+	sources, err := c.yangSources(path)
+	if err != nil {
+		return err
+	}
+	defer sources.close()
+	args := []string{"-f", "tree", "--ignore-error=XPATH_FUNCTION", "-p", strings.Join(sources.includePaths, ":")}
+	for _, deviation := range sources.deviations {
+		args = append(args, "--deviation-module", deviation)
+	}
 
 	// Append the root YANG files to the command-line arguments
-	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
-	}
+	args = append(args, sources.modules...)
 
 	log.Infof("Executing pyang %v", args)
-	cmd := exec.Command(pyang, args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	tree, err := runPyang(args)
+	if err != nil {
+		return err
+	}
+	return c.writeArtifact(path, treeFile, tree, 0644)
 }
 
 func (c *ModelCompiler) generatePluginArtifacts(path string) error {
@@ -326,6 +440,14 @@ func (c *ModelCompiler) generatePluginArtifacts(path string) error {
 	if err := c.generateModel(path); err != nil {
 		return err
 	}
+	if err := c.generateValidation(path); err != nil {
+		return err
+	}
+
+	// Generate the test harness of the configs under testdata
+	if err := c.generateTests(path); err != nil {
+		return err
+	}
 
 	// Generate go.mod from template
 	if err := c.generateGoModule(path); err != nil {
@@ -341,52 +463,49 @@ func (c *ModelCompiler) generatePluginArtifacts(path string) error {
 	if err := c.generateDockerfile(path); err != nil {
 		return err
 	}
-	return nil
+
+	// Generate any extra artifacts from the model's own templates
+	return c.generateExtraTemplates(path)
 }
 
 func (c *ModelCompiler) generateMain(path string) error {
-	mainDir := filepath.Join(path, "plugin")
-	mainFile := filepath.Join(mainDir, "main.go")
-	log.Infof("Generating plugin main '%s'", mainFile)
-	c.createDir(mainDir)
-	return c.applyTemplate(mainTemplate, c.getTemplatePath(mainTemplate), mainFile)
+	mainFile := filepath.Join("plugin", "main.go")
+	log.Infof("Generating plugin main '%s'", c.outputPath(path, mainFile))
+	return c.applyTemplate(path, mainTemplate, mainFile)
 }
 
 func (c *ModelCompiler) generateModel(path string) error {
-	modelDir := filepath.Join(path, "api")
-	modelFile := filepath.Join(modelDir, "model.go")
-	log.Infof("Generating plugin model '%s'", modelFile)
-	c.createDir(modelDir)
-	return c.applyTemplate(modelTemplate, c.getTemplatePath(modelTemplate), modelFile)
+	modelFile := filepath.Join("api", "model.go")
+	log.Infof("Generating plugin model '%s'", c.outputPath(path, modelFile))
+	return c.applyTemplate(path, modelTemplate, modelFile)
+}
+
+func (c *ModelCompiler) generateValidation(path string) error {
+	validateFile := filepath.Join("api", "validate.go")
+	log.Infof("Generating plugin validation '%s'", c.outputPath(path, validateFile))
+	return c.applyTemplate(path, validateTemplate, validateFile)
+}
+
+func (c *ModelCompiler) generateTests(path string) error {
+	testFile := filepath.Join("api", "generated_test.go")
+	log.Infof("Generating plugin tests '%s'", c.outputPath(path, testFile))
+	return c.applyTemplate(path, testTemplate, testFile)
 }
 
 func (c *ModelCompiler) generateGoModule(path string) error {
-	gomodFile := filepath.Join(path, "go.mod")
-	log.Infof("Generating plugin Go module '%s'", gomodFile)
-	return c.applyTemplate(gomodTemplate, c.getTemplatePath(gomodTemplate), gomodFile)
+	gomodFile := "go.mod"
+	log.Infof("Generating plugin Go module '%s'", c.outputPath(path, gomodFile))
+	return c.applyTemplate(path, gomodTemplate, gomodFile)
 }
 
 func (c *ModelCompiler) generateMakefile(path string) error {
-	makefileFile := filepath.Join(path, "Makefile")
-	log.Infof("Generating plugin Makefile '%s'", makefileFile)
-	return c.applyTemplate(makefileTemplate, c.getTemplatePath(makefileTemplate), makefileFile)
+	makefileFile := "Makefile"
+	log.Infof("Generating plugin Makefile '%s'", c.outputPath(path, makefileFile))
+	return c.applyTemplate(path, makefileTemplate, makefileFile)
 }
 
 func (c *ModelCompiler) generateDockerfile(path string) error {
-	dockerfileFile := filepath.Join(path, "Dockerfile")
-	log.Infof("Generating plugin Dockerfile '%s'", dockerfileFile)
-	return c.applyTemplate(dockerfileTemplate, c.getTemplatePath(dockerfileTemplate), dockerfileFile)
-}
-
-// TODO we should be able to run this generated code right after we generate it,
-// so that we can remove a step from `make models-images`
-func (c *ModelCompiler) generateOpenApi(path string) error {
-	// the Schema we need to import is generated at runtime, so we need to generate the tool
-	// to import such schema and generate the OpenApi specs
-	dir := filepath.Join(path, "openapi")
-	openapiGenFile := filepath.Join(dir, "openapi-gen.go")
-	c.createDir(dir)
-
-	log.Infof("Generating plugin OpenApi Gen file '%s'", openapiGenFile)
-	return c.applyTemplate(openapiGenTemplate, c.getTemplatePath(openapiGenTemplate), openapiGenFile)
+	dockerfileFile := "Dockerfile"
+	log.Infof("Generating plugin Dockerfile '%s'", c.outputPath(path, dockerfileFile))
+	return c.applyTemplate(path, dockerfileTemplate, dockerfileFile)
 }
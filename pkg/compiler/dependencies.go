@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// yangModule is a module or submodule found in one of the YANG files of the search path
+type yangModule struct {
+	Name         string
+	Keyword      string
+	Revision     string
+	Organization string
+	File         string
+	// Features are the features the module defines
+	Features []string
+	// Imports and Includes are the modules and submodules it depends on, keyed by name, with the
+	// requested revision-date if any
+	Imports  map[string]string
+	Includes map[string]string
+}
+
+// moduleGraph is the set of modules the root modules of a model depend on, through their imports and includes
+type moduleGraph struct {
+	// Roots are the root modules, in meta-data order
+	Roots []*yangModule
+	// Deviations are the deviation modules, in meta-data order
+	Deviations []*yangModule
+	// Modules are all the modules and submodules reached from the roots, keyed by name
+	Modules map[string]*yangModule
+	// Unused are the YANG files of the model that no root module depends on
+	Unused []string
+	// searchPath is the search path the modules were found in
+	searchPath []string
+}
+
+// ImportedModelData returns the model data of the modules imported by the root and deviation modules, sorted by name
+func (g *moduleGraph) ImportedModelData() []*gnmi.ModelData {
+	roots := make(map[string]bool)
+	for _, m := range append(g.Roots, g.Deviations...) {
+		roots[m.Name] = true
+	}
+	modelData := make([]*gnmi.ModelData, 0, len(g.Modules))
+	for _, name := range g.moduleNames() {
+		if m := g.Modules[name]; !roots[name] && m.Keyword == "module" {
+			modelData = append(modelData, &gnmi.ModelData{Name: m.Name, Organization: m.Organization, Version: m.Revision})
+		}
+	}
+	return modelData
+}
+
+// Dirs returns the directories of the YANG files the roots depend on, in search path order
+func (g *moduleGraph) Dirs() []string {
+	used := make(map[string]bool)
+	for _, m := range g.Modules {
+		used[filepath.Dir(m.File)] = true
+	}
+	dirs := make([]string, 0, len(used))
+	for _, dir := range g.searchPath {
+		if used[dir] {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+func (g *moduleGraph) moduleNames() []string {
+	names := make([]string, 0, len(g.Modules))
+	for name := range g.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// buildModuleGraph parses every YANG file of the search path and resolves the imports and includes of the
+// root modules of the model at path, failing with the import chain of every module that cannot be found
+func (c *ModelCompiler) buildModuleGraph(path string) (*moduleGraph, error) {
+	searchPath, err := c.yangSearchPath(path)
+	if err != nil {
+		return nil, err
+	}
+	index, err := indexYangModules(searchPath)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &moduleGraph{Modules: make(map[string]*yangModule), searchPath: searchPath}
+	// parents records which module first depended on each module, to report import chains
+	parents := make(map[string]string)
+	pending := make([]*yangModule, 0, len(c.metaData.Modules)+len(c.metaData.Deviations))
+	for _, roots := range []struct {
+		modules []Module
+		graph   *[]*yangModule
+	}{{c.metaData.Modules, &graph.Roots}, {c.metaData.Deviations, &graph.Deviations}} {
+		for _, module := range roots.modules {
+			file := filepath.Join(path, yang, module.YangFile)
+			root, err := index.file(file)
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("module %s not found in %s", module.Name, file)
+			} else if err != nil {
+				return nil, err
+			}
+			*roots.graph = append(*roots.graph, root)
+			graph.Modules[root.Name] = root
+			pending = append(pending, root)
+		}
+	}
+
+	unresolved := make([]string, 0)
+	for len(pending) > 0 {
+		m := pending[0]
+		pending = pending[1:]
+		for _, dependencies := range []map[string]string{m.Imports, m.Includes} {
+			for _, name := range sortedKeys(dependencies) {
+				if _, ok := graph.Modules[name]; ok {
+					continue
+				}
+				dependency, err := index.find(name, dependencies[name])
+				if err != nil {
+					chain := append(importChain(parents, m.Name), name)
+					problem := "not found"
+					if !errors.Is(err, fs.ErrNotExist) {
+						problem = err.Error()
+					}
+					unresolved = append(unresolved, fmt.Sprintf("%s %s (%s)", name, problem, strings.Join(chain, " -> ")))
+					continue
+				}
+				parents[name] = m.Name
+				graph.Modules[name] = dependency
+				pending = append(pending, dependency)
+			}
+		}
+	}
+	if len(unresolved) > 0 {
+		err := fmt.Errorf("unable to resolve YANG imports: %s", strings.Join(unresolved, "; "))
+		if len(index.missing) > 0 {
+			err = fmt.Errorf("%w; search directories %s do not exist", err, strings.Join(index.missing, ", "))
+		}
+		return nil, err
+	}
+
+	yangDir := filepath.Join(path, yang) + string(filepath.Separator)
+	for _, m := range index.files {
+		if strings.HasPrefix(m.File, yangDir) && graph.Modules[m.Name] != m {
+			graph.Unused = append(graph.Unused, m.File)
+		}
+	}
+	sort.Strings(graph.Unused)
+	return graph, nil
+}
+
+// importChain returns the chain of modules from a root module to the given one
+func importChain(parents map[string]string, name string) []string {
+	chain := []string{name}
+	for parent, ok := parents[name]; ok; parent, ok = parents[parent] {
+		chain = append([]string{parent}, chain...)
+	}
+	return chain
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// yangIndex holds the modules of every YANG file of a search path
+type yangIndex struct {
+	files  []*yangModule
+	byName map[string][]*yangModule
+	// broken are the files that cannot be parsed, which only matter if a module they may define is needed
+	broken []*brokenYangFile
+	// missing are the search path directories that do not exist
+	missing []string
+}
+
+// brokenYangFile is a YANG file that cannot be parsed, along with the module and revision its name suggests
+type brokenYangFile struct {
+	file     string
+	name     string
+	revision string
+	err      error
+}
+
+// indexYangModules parses the YANG files found directly in each of the search path directories.
+// Missing directories are skipped, so that they only matter if a module cannot be found elsewhere, and
+// so are the files that cannot be parsed, so that they only matter if a module they may define is needed
+func indexYangModules(searchPath []string) (*yangIndex, error) {
+	index := &yangIndex{byName: make(map[string][]*yangModule)}
+	for _, dir := range searchPath {
+		entries, err := os.ReadDir(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Debugf("YANG search directory %s does not exist; skipping it", dir)
+			index.missing = append(index.missing, dir)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), dotYang) {
+				continue
+			}
+			file := filepath.Join(dir, entry.Name())
+			m, err := parseYangModule(file)
+			if err != nil {
+				log.Debugf("Unable to parse %s; skipping it: %v", file, err)
+				name, revision, _ := strings.Cut(strings.TrimSuffix(entry.Name(), dotYang), "@")
+				index.broken = append(index.broken, &brokenYangFile{file: file, name: name, revision: revision, err: err})
+				continue
+			}
+			index.files = append(index.files, m)
+			index.byName[m.Name] = append(index.byName[m.Name], m)
+		}
+	}
+	return index, nil
+}
+
+// file returns the module defined in file, or the error parsing it
+func (i *yangIndex) file(file string) (*yangModule, error) {
+	for _, m := range i.files {
+		if m.File == file {
+			return m, nil
+		}
+	}
+	for _, b := range i.broken {
+		if b.file == file {
+			return nil, b.err
+		}
+	}
+	return nil, fs.ErrNotExist
+}
+
+// find returns the module with the given revision or, when no revision is requested, its latest revision
+// in any of the search path directories; between files of the same revision, the one found first in the
+// search path takes precedence. It fails with the parse error of a file whose name suggests it may define
+// the module, since that file could be the one needed
+func (i *yangIndex) find(name string, revision string) (*yangModule, error) {
+	for _, b := range i.broken {
+		if b.name == name && (revision == "" || b.revision == "" || b.revision == revision) {
+			return nil, b.err
+		}
+	}
+	var found *yangModule
+	for _, m := range i.byName[name] {
+		if revision != "" {
+			if m.Revision == revision {
+				return m, nil
+			}
+		} else if found == nil || m.Revision > found.Revision {
+			found = m
+		}
+	}
+	if found == nil {
+		return nil, fs.ErrNotExist
+	}
+	return found, nil
+}
+
+// parseYangModule reads the name, latest revision, organization and dependencies of the module defined in file
+func parseYangModule(file string) (*yangModule, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := goyang.Parse(string(data), file)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%s: no module found", file)
+	}
+
+	s := statements[0]
+	m := &yangModule{Name: s.Argument, Keyword: s.Keyword, File: file, Imports: make(map[string]string), Includes: make(map[string]string)}
+	for _, sub := range s.SubStatements() {
+		switch sub.Keyword {
+		case "revision":
+			if sub.Argument > m.Revision {
+				m.Revision = sub.Argument
+			}
+		case "feature":
+			m.Features = append(m.Features, sub.Argument)
+		case "organization":
+			m.Organization = strings.Join(strings.Fields(sub.Argument), " ")
+		case "import":
+			m.Imports[sub.Argument] = revisionDate(sub)
+		case "include":
+			m.Includes[sub.Argument] = revisionDate(sub)
+		}
+	}
+	return m, nil
+}
+
+func revisionDate(s *goyang.Statement) string {
+	for _, sub := range s.SubStatements() {
+		if sub.Keyword == "revision-date" {
+			return sub.Argument
+		}
+	}
+	return ""
+}
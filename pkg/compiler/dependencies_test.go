@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildModuleGraph(t *testing.T) {
+	path := "testdata/dependencies"
+	c := NewCompiler()
+	c.yangBaseDirectory = "../../yang-base"
+	if err := c.loadModelMetaData(path); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{filepath.Join(path, "yang", "dep-unused.yang")}, c.modules.Unused)
+	assert.Equal(t, []string{"../../yang-base", filepath.Join(path, "yang"), filepath.Join(path, "yang", "types")}, c.modules.Dirs())
+	assert.Equal(t, []*gnmi.ModelData{
+		{Name: "dep-root", Organization: "Open Networking Foundation", Version: "2023-01-01"},
+		{Name: "dep-extra", Organization: "Open Networking Foundation", Version: "2023-03-01"},
+		{Name: "dep-types", Organization: "Open Networking Foundation", Version: "2023-02-01"},
+		{Name: "ietf-inet-types", Organization: "IETF NETMOD (NETCONF Data Modeling Language) Working Group", Version: "2013-07-15"},
+	}, c.modelInfo.ModelData)
+}
+
+func TestBuildModuleGraph_Unresolved(t *testing.T) {
+	path := t.TempDir()
+	for _, file := range []string{metaDataFile, "yang/dep-root.yang", "yang/types/dep-types@2023-02-01.yang"} {
+		content, err := os.ReadFile(filepath.Join("testdata/dependencies", file))
+		assert.NoError(t, err)
+		assert.NoError(t, os.MkdirAll(filepath.Join(path, filepath.Dir(file)), os.ModePerm))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, file), content, 0644))
+	}
+
+	c := NewCompiler()
+	c.yangBaseDirectory = "../../yang-base"
+	err := c.loadModelMetaData(path)
+	assert.EqualError(t, err, "unable to resolve YANG imports: dep-extra not found (dep-root -> dep-types -> dep-extra)")
+}
+
+func TestBuildModuleGraph_MissingYangBase(t *testing.T) {
+	c := NewCompiler()
+	c.yangBaseDirectory = "/nonexistent"
+	assert.NoError(t, c.loadModelMetaData("testdata/compat/v1"))
+
+	err := c.loadModelMetaData("testdata/dependencies")
+	assert.EqualError(t, err, "unable to resolve YANG imports: ietf-inet-types not found (dep-root -> ietf-inet-types); "+
+		"search directories /nonexistent do not exist")
+}
+
+func TestBuildModuleGraph_BrokenYangFile(t *testing.T) {
+	yangBase := t.TempDir()
+	content, err := os.ReadFile("../../yang-base/ietf-inet-types.yang")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(yangBase, "ietf-inet-types.yang"), content, 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(yangBase, "unrelated@2023-01-01.yang"), []byte("module unrelated {"), 0644))
+
+	// A file no module of the model needs does not matter
+	c := NewCompiler()
+	c.yangBaseDirectory = yangBase
+	assert.NoError(t, c.loadModelMetaData("testdata/dependencies"))
+
+	// A file which may define a module the model needs does
+	assert.NoError(t, os.WriteFile(filepath.Join(yangBase, "ietf-inet-types@2013-07-15.yang"), []byte("module ietf-inet-types {"), 0644))
+	c = NewCompiler()
+	c.yangBaseDirectory = yangBase
+	err = c.loadModelMetaData("testdata/dependencies")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to resolve YANG imports: ietf-inet-types "+filepath.Join(yangBase, "ietf-inet-types@2013-07-15.yang"))
+	}
+}
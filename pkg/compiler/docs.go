@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	docs_gen "github.com/onosproject/config-models/pkg/docs-gen"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const docsDirectory = "docs"
+
+// generateDocs writes the reference pages of the model under the docs directory, in the format of the meta-data
+func (c *ModelCompiler) generateDocs(path string) error {
+	log.Infof("Generating model reference docs '%s'", c.outputPath(path, docsDirectory))
+
+	files, err := c.buildDocs(path)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := c.writeArtifact(path, filepath.Join(docsDirectory, name), files[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildDocs renders the reference pages of the model, keyed by file name
+func (c *ModelCompiler) buildDocs(path string) (map[string][]byte, error) {
+	entries, err := c.processedEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	docs, err := docs_gen.BuildDocs(entries, &docs_gen.DocsGenSettings{
+		ModelType:    c.dictionary.Name,
+		ModelVersion: c.dictionary.Version,
+		Format:       docs_gen.Format(c.metaData.GenDocs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to build model reference docs: %v", err)
+	}
+	return docs, nil
+}
+
+// processedEntries returns the entries of the root modules of the model, processed by goyang from the same
+// sources as the Golang bindings so that disabled features are left out and deviations applied. Unlike the
+// ygot schema, they keep the when statements and the nodes the statements were derived from
+func (c *ModelCompiler) processedEntries(path string) ([]*goyang.Entry, error) {
+	sources, err := c.yangSources(path)
+	if err != nil {
+		return nil, err
+	}
+	defer sources.close()
+
+	ms := goyang.NewModules()
+	ms.AddPath(sources.includePaths...)
+	for _, file := range sources.files() {
+		if err := ms.Read(file); err != nil {
+			return nil, err
+		}
+	}
+	if errs := ms.Process(); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return nil, fmt.Errorf("unable to process YANG modules: %s", strings.Join(messages, "; "))
+	}
+
+	entries := make([]*goyang.Entry, 0, len(c.metaData.Modules))
+	for _, module := range c.metaData.Modules {
+		m, ok := ms.Modules[module.Name]
+		if !ok {
+			return nil, fmt.Errorf("module %s not found in %s", module.Name, module.YangFile)
+		}
+		entries = append(entries, goyang.ToEntry(m))
+	}
+	return entries, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateDocs(t *testing.T) {
+	modelPath := "../../models/testdevice-1.0.x"
+	c := loadSampleModel(t, modelPath)
+	c.SetOutputDirectory(t.TempDir())
+	c.SetDryRun(true)
+	c.metaData.GenDocs = "markdown"
+	c.dictionary = c.newDictionary()
+	assert.NoError(t, c.generateDocs(modelPath))
+	assert.NoError(t, c.generateMakefile(modelPath))
+
+	contents := make(map[string]string)
+	for _, artifact := range c.Artifacts() {
+		contents[artifact.File] = string(artifact.content)
+	}
+	assert.Contains(t, contents, filepath.Join(docsDirectory, "index.md"))
+	assert.Contains(t, contents, filepath.Join(docsDirectory, "identities.md"))
+	port := contents[filepath.Join(docsDirectory, "switch.port.md")]
+	assert.Contains(t, port, "[testdevice-1.0.x](index.md) / [switch](switch.md) / port")
+	assert.Contains(t, port, "| Values selected from | `/sm:switch-model[@sm:switch-model-id=$this/../../model-id]/sm:port/@sm:cage-number` |")
+	assert.Contains(t, port, "leafref to [`/sm:switch-model/sm:port/sm:cage-number`](switch-model.port.md#cage-number)")
+	vehicle := contents[filepath.Join(docsDirectory, "vehicle.md")]
+	assert.Contains(t, vehicle, "| Choice | case ice-case of choice power-choice |")
+	assert.Contains(t, contents["Makefile"], "api/manifest.json docs")
+}
+
+func TestGenerateDocs_Features(t *testing.T) {
+	modelPath := "testdata/features"
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	if err := c.loadModelMetaData(modelPath); err != nil {
+		t.Fatal(err)
+	}
+	c.metaData.GenDocs = "html"
+	c.dictionary = c.newDictionary()
+	docs, err := c.buildDocs(modelPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	settings := string(docs["settings.html"])
+	assert.Contains(t, settings, `<h3 id="fast-mode">fast-mode</h3>`)
+	assert.Contains(t, settings, `<h3 id="any-mode">any-mode</h3>`)
+	assert.NotContains(t, settings, "slow-mode")
+	assert.NotContains(t, settings, `id="removed"`)
+}
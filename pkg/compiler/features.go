@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bufio"
+	"fmt"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// featureEnabled tells whether the feature of the module is enabled by the meta-data; the features of the
+// modules without a features entry are all enabled, as when no features are given
+func (c *ModelCompiler) featureEnabled(module string, feature string) bool {
+	enabled, ok := c.metaData.Features[module]
+	if !ok {
+		return true
+	}
+	for _, name := range enabled {
+		if name == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// enabledFeatures returns the sorted module:feature names of the features enabled in the modules of the model
+func (c *ModelCompiler) enabledFeatures() []string {
+	features := make([]string, 0)
+	for _, name := range c.modules.moduleNames() {
+		for _, feature := range c.modules.Modules[name].Features {
+			if c.featureEnabled(name, feature) {
+				features = append(features, name+":"+feature)
+			}
+		}
+	}
+	sort.Strings(features)
+	return features
+}
+
+// deviationNames returns the names of the deviation modules of the model, in meta-data order
+func (c *ModelCompiler) deviationNames() []string {
+	names := make([]string, 0, len(c.metaData.Deviations))
+	for _, deviation := range c.metaData.Deviations {
+		names = append(names, deviation.Name)
+	}
+	return names
+}
+
+// validateFeatures checks that the features of the meta-data are defined by modules of the model
+func (c *ModelCompiler) validateFeatures() error {
+	var errs MetaDataErrors
+	for _, module := range sortedFeatureModules(c.metaData.Features) {
+		field := joinField("features", module)
+		m, ok := c.modules.Modules[module]
+		if !ok {
+			errs = append(errs, c.metaData.errorAtField(field, "%s: module %q is not used by the model", field, module))
+			continue
+		}
+		defined := make(map[string]bool)
+		for _, feature := range m.Features {
+			defined[feature] = true
+		}
+		for _, feature := range c.metaData.Features[module] {
+			if !defined[feature] {
+				errs = append(errs, c.metaData.errorAtField(field, "%s: feature %q is not defined by module %q", field, feature, module))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func sortedFeatureModules(features map[string][]string) []string {
+	modules := make([]string, 0, len(features))
+	for module := range features {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// yangSources are the YANG files the bindings and the tree of the model are generated from
+type yangSources struct {
+	// path and yangBaseDirectory are where the model and base YANG files are, as they appear in the bindings header
+	path              string
+	yangBaseDirectory string
+	modules           []string
+	deviations        []string
+	includePaths      []string
+	// dir is the temporary directory of the sources pruned of their disabled features
+	dir string
+}
+
+// files returns the root modules followed by the deviation modules
+func (s *yangSources) files() []string {
+	return append(append([]string{}, s.modules...), s.deviations...)
+}
+
+// close removes the pruned sources, if any
+func (s *yangSources) close() {
+	if s.dir != "" {
+		_ = os.RemoveAll(s.dir)
+	}
+}
+
+// yangSources returns the YANG files of the model at path. When the meta-data restricts the enabled features,
+// the statements conditioned by disabled features are pruned from copies of the files, laid out as the
+// originals, so that the bindings, and thus the paths and OpenAPI specs derived from them, and the tree agree
+func (c *ModelCompiler) yangSources(path string) (*yangSources, error) {
+	sources := &yangSources{path: path, yangBaseDirectory: c.yangBaseDirectory, includePaths: c.modules.Dirs()}
+	for _, module := range c.metaData.Modules {
+		sources.modules = append(sources.modules, filepath.Join(path, yang, module.YangFile))
+	}
+	for _, module := range c.metaData.Deviations {
+		sources.deviations = append(sources.deviations, filepath.Join(path, yang, module.YangFile))
+	}
+	if len(c.metaData.Features) == 0 {
+		return sources, nil
+	}
+
+	dir, err := os.MkdirTemp("", "model-compiler-")
+	if err != nil {
+		return nil, err
+	}
+	pruned := &yangSources{path: dir, yangBaseDirectory: filepath.Join(dir, filepath.Base(DefaultYangBaseDirectory)), dir: dir}
+	prunedPath := func(file string) string {
+		if rel, ok := relativePath(c.yangBaseDirectory, file); ok {
+			return filepath.Join(pruned.yangBaseDirectory, rel)
+		}
+		rel, _ := relativePath(path, file)
+		return filepath.Join(dir, rel)
+	}
+	for _, name := range c.modules.moduleNames() {
+		m := c.modules.Modules[name]
+		if err := c.pruneYangFile(m.File, prunedPath(m.File)); err != nil {
+			pruned.close()
+			return nil, err
+		}
+	}
+	for _, file := range sources.modules {
+		pruned.modules = append(pruned.modules, prunedPath(file))
+	}
+	for _, file := range sources.deviations {
+		pruned.deviations = append(pruned.deviations, prunedPath(file))
+	}
+	for _, includePath := range sources.includePaths {
+		pruned.includePaths = append(pruned.includePaths, prunedPath(includePath))
+	}
+	return pruned, nil
+}
+
+// pruneYangFile writes the module of file to target without the statements conditioned by disabled features
+func (c *ModelCompiler) pruneYangFile(file string, target string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	statements, err := goyang.Parse(string(data), file)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return err
+	}
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	w := bufio.NewWriter(out)
+	for _, s := range statements {
+		if err := c.writeYangStatement(w, s, modulePrefixes(s), ""); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// writeYangStatement writes s and its sub-statements, skipping those whose if-feature conditions do not hold
+func (c *ModelCompiler) writeYangStatement(w *bufio.Writer, s *goyang.Statement, prefixes *yangPrefixes, indent string) error {
+	fmt.Fprintf(w, "%s%s", indent, s.Keyword)
+	if s.HasArgument {
+		fmt.Fprintf(w, " %s", quoteYangString(s.Argument))
+	}
+	subStatements := make([]*goyang.Statement, 0, len(s.SubStatements()))
+	for _, sub := range s.SubStatements() {
+		enabled, err := c.ifFeaturesHold(sub, prefixes)
+		if err != nil {
+			return err
+		}
+		if enabled {
+			subStatements = append(subStatements, sub)
+		}
+	}
+	if len(subStatements) == 0 {
+		_, err := fmt.Fprintln(w, ";")
+		return err
+	}
+	fmt.Fprintln(w, " {")
+	for _, sub := range subStatements {
+		if err := c.writeYangStatement(w, sub, prefixes, indent+"  "); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%s}\n", indent)
+	return err
+}
+
+// quoteYangString double quotes value, escaping the characters that YANG requires to be escaped
+func quoteYangString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(value) + `"`
+}
+
+// yangPrefixes resolves the prefixed names used in a module or submodule to the names of the modules they refer to
+type yangPrefixes struct {
+	// module is the module unprefixed names refer to, that is the module itself or the module a submodule belongs to
+	module   string
+	prefixes map[string]string
+}
+
+func modulePrefixes(module *goyang.Statement) *yangPrefixes {
+	p := &yangPrefixes{module: module.Argument, prefixes: make(map[string]string)}
+	for _, s := range module.SubStatements() {
+		switch s.Keyword {
+		case "prefix":
+			p.prefixes[s.Argument] = module.Argument
+		case "import", "belongs-to":
+			if s.Keyword == "belongs-to" {
+				p.module = s.Argument
+			}
+			for _, sub := range s.SubStatements() {
+				if sub.Keyword == "prefix" {
+					p.prefixes[sub.Argument] = s.Argument
+				}
+			}
+		}
+	}
+	return p
+}
+
+// resolve returns the module and the name of the optionally prefixed name
+func (p *yangPrefixes) resolve(name string) (string, string, error) {
+	i := strings.Index(name, ":")
+	if i < 0 {
+		return p.module, name, nil
+	}
+	module, ok := p.prefixes[name[:i]]
+	if !ok {
+		return "", "", fmt.Errorf("unknown prefix %q", name[:i])
+	}
+	return module, name[i+1:], nil
+}
+
+// ifFeaturesHold evaluates the if-feature expressions of s, which all have to hold
+func (c *ModelCompiler) ifFeaturesHold(s *goyang.Statement, prefixes *yangPrefixes) (bool, error) {
+	for _, sub := range s.SubStatements() {
+		if sub.Keyword != "if-feature" {
+			continue
+		}
+		holds, err := evalFeatureExpr(sub.Argument, func(name string) (bool, error) {
+			module, feature, err := prefixes.resolve(name)
+			if err != nil {
+				return false, err
+			}
+			return c.featureEnabled(module, feature), nil
+		})
+		if err != nil {
+			return false, fmt.Errorf("%s: invalid if-feature %q: %v", sub.Location(), sub.Argument, err)
+		}
+		if !holds {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// evalFeatureExpr evaluates an if-feature expression of RFC 7950 section 7.20.2, made of feature names,
+// "not", "and", "or" and parentheses, with enabled telling whether each feature is enabled
+func evalFeatureExpr(expr string, enabled func(name string) (bool, error)) (bool, error) {
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expr))
+	e := &featureExpr{tokens: tokens, enabled: enabled}
+	value, err := e.or()
+	if err != nil {
+		return false, err
+	}
+	if e.pos < len(e.tokens) {
+		return false, fmt.Errorf("unexpected %q", e.tokens[e.pos])
+	}
+	return value, nil
+}
+
+type featureExpr struct {
+	tokens  []string
+	pos     int
+	enabled func(name string) (bool, error)
+}
+
+func (e *featureExpr) next() string {
+	if e.pos < len(e.tokens) {
+		return e.tokens[e.pos]
+	}
+	return ""
+}
+
+// or evaluates "term *(or term)"; all the operands are evaluated to report invalid features
+func (e *featureExpr) or() (bool, error) {
+	value, err := e.and()
+	for err == nil && e.next() == "or" {
+		e.pos++
+		var operand bool
+		operand, err = e.and()
+		value = value || operand
+	}
+	return value, err
+}
+
+// and evaluates "factor *(and factor)"
+func (e *featureExpr) and() (bool, error) {
+	value, err := e.factor()
+	for err == nil && e.next() == "and" {
+		e.pos++
+		var operand bool
+		operand, err = e.factor()
+		value = value && operand
+	}
+	return value, err
+}
+
+// factor evaluates "not factor", "(expr)" or a feature name
+func (e *featureExpr) factor() (bool, error) {
+	token := e.next()
+	e.pos++
+	switch token {
+	case "":
+		return false, fmt.Errorf("unexpected end of expression")
+	case "not":
+		value, err := e.factor()
+		return !value, err
+	case "(":
+		value, err := e.or()
+		if err != nil {
+			return false, err
+		}
+		if e.next() != ")" {
+			return false, fmt.Errorf("missing )")
+		}
+		e.pos++
+		return value, nil
+	case ")", "and", "or":
+		return false, fmt.Errorf("unexpected %q", token)
+	default:
+		return e.enabled(token)
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEvalFeatureExpr(t *testing.T) {
+	enabled := map[string]bool{"a": true, "p:b": true, "c": false}
+	lookup := func(name string) (bool, error) {
+		value, ok := enabled[name]
+		if !ok {
+			return false, fmt.Errorf("unknown feature %s", name)
+		}
+		return value, nil
+	}
+	for expr, expected := range map[string]bool{
+		"a":                      true,
+		"c":                      false,
+		"not c":                  true,
+		"a and c":                false,
+		"a or c":                 true,
+		"c or a and p:b":         true,
+		"(c or a) and not p:b":   false,
+		"not (c and a) and p:b":  true,
+		"((a))":                  true,
+		"not not c or (c or c)":  false,
+		"a and (p:b or c) and a": true,
+	} {
+		value, err := evalFeatureExpr(expr, lookup)
+		assert.NoError(t, err, expr)
+		assert.Equal(t, expected, value, expr)
+	}
+	for _, expr := range []string{"", "a and", "(a", "a)", "a b", "not", "d"} {
+		_, err := evalFeatureExpr(expr, lookup)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestFeaturesAndDeviations(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	modelPath := "testdata/features"
+	if err := c.loadModelMetaData(modelPath); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"feat-test:fast"}, c.enabledFeatures())
+	assert.Equal(t, []string{"feat-deviations"}, c.deviationNames())
+	assert.Equal(t, "feat-deviations", c.modelInfo.ModelData[1].Name)
+
+	schema, err := c.BuildSchema(modelPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	settings := schema.SchemaTree["FeatTest_Settings"]
+	if assert.NotNil(t, settings) {
+		assert.Contains(t, settings.Dir, "fast-mode")
+		assert.Contains(t, settings.Dir, "any-mode")
+		assert.NotContains(t, settings.Dir, "slow-mode")
+		assert.NotContains(t, settings.Dir, "removed")
+		assert.Equal(t, "Name of\nthe settings", settings.Dir["name"].Description)
+		assert.Equal(t, []string{`[a-z\-]+`}, settings.Dir["name"].Type.Pattern)
+	}
+
+	_, rwPaths, _ := path.ExtractPaths(schema.SchemaTree)
+	rwPathNames := make([]string, 0, len(rwPaths))
+	for _, rwPath := range rwPaths {
+		rwPathNames = append(rwPathNames, rwPath.Path)
+	}
+	assert.Contains(t, rwPathNames, "/settings/fast-mode")
+	assert.NotContains(t, rwPathNames, "/settings/slow-mode")
+	assert.NotContains(t, rwPathNames, "/settings/removed")
+
+	c.dictionary = c.newDictionary()
+	openapi, err := c.buildOpenApi(modelPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(openapi), "fast-mode")
+	assert.NotContains(t, string(openapi), "slow-mode")
+	assert.NotContains(t, string(openapi), "removed")
+}
+
+func TestValidateFeatures(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	if err := c.loadModelMetaData("testdata/features"); err != nil {
+		t.Fatal(err)
+	}
+
+	c.metaData.Features = map[string][]string{"feat-test": {"fast", "turbo"}, "not-used": {"fast"}}
+	err := c.validateFeatures()
+	assert.Equal(t, []string{
+		`testdata/features/metadata.yaml:19:5: features.feat-test: feature "turbo" is not defined by module "feat-test"`,
+		`testdata/features/metadata.yaml:18:3: features.not-used: module "not-used" is not used by the model`,
+	}, errorStrings(err.(MetaDataErrors)))
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func loadSampleModel(t *testing.T, modelPath string) *ModelCompiler {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	if err := c.loadModelMetaData(modelPath); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestSampleModels(t *testing.T) {
+	for _, model := range []string{"devicesim-1.0.x", "e2node-1.x", "ric-1.x", "testdevice-1.0.x", "testdevice-2.0.x"} {
+		modelPath := "../../models/" + model
+		c := loadSampleModel(t, modelPath)
+		schema, err := c.BuildSchema(modelPath)
+		if !assert.NoError(t, err, model) {
+			continue
+		}
+		root := path.RootEntry(schema.SchemaTree)
+		if assert.NotNil(t, root, model) {
+			assert.Equal(t, "device", root.Name, model)
+		}
+		roPaths, rwPaths, _ := path.ExtractPaths(schema.SchemaTree)
+		assert.NotZero(t, len(roPaths)+len(rwPaths), model)
+	}
+}
+
+func TestGeneratorOptions(t *testing.T) {
+	modelPath := "../../models/devicesim-1.0.x"
+	c := loadSampleModel(t, modelPath)
+	schema, err := c.BuildSchema(modelPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Device", c.fakeRootName())
+	assert.Contains(t, schema.SchemaTree, "OpenconfigInterfaces_Interfaces_Interface_Config")
+	roPaths, rwPaths, _ := path.ExtractPaths(schema.SchemaTree)
+
+	for name, options := range map[string]GeneratorOptions{
+		"compressed":                 {CompressPaths: true, FakeRootName: "my-root"},
+		"prefer operational state":   {CompressPaths: true, PreferOperationalState: true},
+		"compressed excluding state": {CompressPaths: true, ExcludeState: true, IgnoreShadowPaths: true},
+		"simple unions":              {SimpleUnions: true},
+	} {
+		c.metaData.Generator = options
+		assert.Empty(t, c.metaData.validateGenerator(), name)
+		schema, err := c.BuildSchema(modelPath)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		root := path.RootEntry(schema.SchemaTree)
+		if !assert.NotNil(t, root, name) {
+			continue
+		}
+		assert.Contains(t, schema.SchemaTree, c.fakeRootName(), name)
+		if options.CompressPaths {
+			assert.Contains(t, schema.SchemaTree, "Interface", name)
+			assert.NotContains(t, schema.SchemaTree, "OpenconfigInterfaces_Interfaces_Interface_Config", name)
+		}
+		// The schema entries keep the whole YANG tree, so the paths do not depend on the options
+		compressedRoPaths, compressedRwPaths, _ := path.ExtractPaths(schema.SchemaTree)
+		assert.Equal(t, len(roPaths), len(compressedRoPaths), name)
+		assert.Equal(t, len(rwPaths), len(compressedRwPaths), name)
+	}
+
+	c.metaData.Generator = GeneratorOptions{CompressPaths: true, FakeRootName: "my-root"}
+	assert.Equal(t, "MyRoot", c.fakeRootName())
+}
+
+func TestValidateGenerator(t *testing.T) {
+	for options, expected := range map[GeneratorOptions]string{
+		{PreferOperationalState: true}:                                          "generator.preferOperationalState requires compressPaths and cannot be combined with excludeState",
+		{CompressPaths: true, PreferOperationalState: true, ExcludeState: true}: "generator.preferOperationalState requires compressPaths and cannot be combined with excludeState",
+		{IgnoreShadowPaths: true}:                                               "generator.ignoreShadowPaths requires compressPaths",
+		{FakeRootName: "my root"}:                                               `generator.fakeRootName "my root" is not a valid YANG identifier`,
+	} {
+		md := validMetaData()
+		md.Generator = options
+		assert.Equal(t, []string{expected}, errorStrings(md.validateGenerator()))
+	}
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	configmodels "github.com/onosproject/config-models"
+	"regexp"
+)
+
+// configModelsModulePath is the module the generated plugins import the path and validation libraries from
+const configModelsModulePath = "github.com/onosproject/config-models"
+
+// releasedVersionRegExp matches the versions of the compiler that are tagged, as opposed to -dev versions
+var releasedVersionRegExp = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+
+// configModelsVersion returns the version of config-models the plugin go.mod requires, which is the version of
+// the compiler, since the templates use its libraries. A -dev version is not tagged, so the plugins of a -dev
+// compiler only build in a Go workspace whose go.work replaces config-models with a checkout
+func configModelsVersion() string {
+	version := configmodels.Version()
+	if !releasedVersionRegExp.MatchString(version) {
+		log.Warnf("Compiler version %s is not released; the plugin requires %s v%s, which only resolves in a Go "+
+			"workspace whose go.work replaces it with a checkout", version, configModelsModulePath, version)
+	}
+	return "v" + version
+}
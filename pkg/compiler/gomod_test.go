@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	configmodels "github.com/onosproject/config-models"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigModelsVersion(t *testing.T) {
+	assert.Equal(t, "v"+configmodels.Version(), configModelsVersion())
+
+	// The plugin requires the version of the compiler, with no replace by a local checkout
+	out := t.TempDir()
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(out)
+	c.SetNoFormat(true)
+	assert.NoError(t, c.Compile("testdata/plugin"))
+	goMod, err := os.ReadFile(filepath.Join(out, goModFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(goMod), "\tgithub.com/onosproject/config-models v"+configmodels.Version()+"\n")
+	assert.NotContains(t, string(goMod), "replace")
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	jsonschema_gen "github.com/onosproject/config-models/pkg/jsonschema-gen"
+)
+
+const jsonSchemaSuffix = ".schema.json"
+
+// jsonSchemaFile returns the name of the JSON Schema of the model, named after the model as its YANG tree
+func (c *ModelCompiler) jsonSchemaFile() string {
+	return c.dictionary.Name + jsonSchemaSuffix
+}
+
+// generateJSONSchema writes the JSON Schema of the configs of the model
+func (c *ModelCompiler) generateJSONSchema(path string) error {
+	file := c.jsonSchemaFile()
+	log.Infof("Generating JSON Schema '%s'", c.outputPath(path, file))
+
+	content, err := c.buildJSONSchema(path)
+	if err != nil {
+		return err
+	}
+	return c.writeArtifact(path, file, content, 0644)
+}
+
+// buildJSONSchema returns the indented JSON Schema derived from the ygot schema of the model
+func (c *ModelCompiler) buildJSONSchema(path string) ([]byte, error) {
+	schema, err := c.compiledSchema(path)
+	if err != nil {
+		return nil, err
+	}
+	jsonSchema, err := jsonschema_gen.BuildJSONSchema(schema, &jsonschema_gen.SchemaGenSettings{
+		ModelType:    c.dictionary.Name,
+		ModelVersion: c.dictionary.Version,
+		ID:           fmt.Sprintf("https://%s/%s", c.dictionary.GoPackage, c.jsonSchemaFile()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to build JSON Schema: %v", err)
+	}
+	content, err := json.MarshalIndent(jsonSchema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func compileJSONSchema(t *testing.T, modelPath string) *jsonschema.Schema {
+	c := loadSampleModel(t, modelPath)
+	c.dictionary = c.newDictionary()
+	content, err := c.buildJSONSchema(modelPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	if err := compiler.AddResource(c.jsonSchemaFile(), bytes.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	schema, err := compiler.Compile(c.jsonSchemaFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func validateJSONFile(t *testing.T, schema *jsonschema.Schema, file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config interface{}
+	if err := json.Unmarshal(content, &config); err != nil {
+		t.Fatal(err)
+	}
+	return schema.Validate(config)
+}
+
+func TestJSONSchema_SampleConfigs(t *testing.T) {
+	schema := compileJSONSchema(t, "../../models/testdevice-2.0.x")
+	for _, file := range []string{
+		"../../models/testdevice-2.0.x/testdata/sample-testdevice2-config.json",
+		"../../pkg/path/testdata/sample-testdevice2-config.json",
+		"../../pkg/path/testdata/sample-testdevice2-choice.json",
+	} {
+		assert.NoError(t, validateJSONFile(t, schema, file), file)
+	}
+	err := validateJSONFile(t, schema, "../../pkg/path/testdata/sample-testdevice2-choice-wrong.json")
+	assert.Error(t, err)
+
+	schema = compileJSONSchema(t, "../../models/testdevice-1.0.x")
+	// The sample exceeds the max-elements of list2a and the range of key2 in list5
+	err = validateJSONFile(t, schema, "../../models/testdevice-1.0.x/testdata/sample-testdevice-1-config.json")
+	if assert.Error(t, err) {
+		details := fmt.Sprintf("%#v", err)
+		assert.Contains(t, details, "maximum 4 items required, but found 6 items")
+		assert.Contains(t, details, "must be >= 2 but found 1")
+	}
+	assert.NoError(t, validateJSONFile(t, schema, "../../models/testdevice-1.0.x/testdata/switch-config-example-1.json"))
+}
@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Lint rules reported by LintYang
+const (
+	LintRuleSyntax     = "syntax"
+	LintRuleImport     = "import"
+	LintRuleRevision   = "revision"
+	LintRuleHyphenated = "hyphenated"
+	LintRuleLint       = "lint"
+	LintRuleSemantic   = "semantic"
+)
+
+const revisionDateLayout = "2006-01-02"
+
+// goyang reports problems as "file:line:col: message"
+var locationRegExp = regexp.MustCompile(`^(.+?):(\d+):(\d+):\s*(.*)$`)
+
+// identifierKeywords are the statements whose argument defines a YANG identifier
+var identifierKeywords = map[string]bool{
+	"module": true, "submodule": true, "container": true, "leaf": true, "leaf-list": true,
+	"list": true, "choice": true, "case": true, "grouping": true, "typedef": true,
+	"identity": true, "feature": true, "extension": true, "rpc": true, "action": true,
+	"notification": true, "anydata": true, "anyxml": true,
+}
+
+// requiredModuleSubstatements are the module statements mandated by the RFC 8407 guidelines
+var requiredModuleSubstatements = []string{"organization", "contact", "description"}
+
+// LintOptions controls the optional checks performed by LintYang
+type LintOptions struct {
+	RequireHyphenated bool
+}
+
+// LintFinding is a single issue found in a YANG file
+type LintFinding struct {
+	File    string
+	Line    int
+	Column  int
+	Rule    string
+	Message string
+}
+
+func (f *LintFinding) String() string {
+	return fmt.Sprintf("%s:%d:%d: [%s] %s", f.File, f.Line, f.Column, f.Rule, f.Message)
+}
+
+type linter struct {
+	ms       *goyang.Modules
+	options  LintOptions
+	findings []*LintFinding
+}
+
+// LintYang checks the given root YANG files, resolving their imports and includes
+// from the search path, and returns every finding sorted by file and position
+func LintYang(files []string, searchPath []string, options LintOptions) ([]*LintFinding, error) {
+	l := &linter{ms: goyang.NewModules(), options: options}
+	l.ms.AddPath(searchPath...)
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		statements, err := goyang.Parse(string(data), file)
+		if err != nil {
+			l.addError(LintRuleSyntax, file, err)
+			continue
+		}
+		for _, s := range statements {
+			l.lintModule(file, s)
+		}
+		l.ms.AddPath(filepath.Dir(file))
+		if err := l.ms.Parse(string(data), file); err != nil {
+			l.addError(LintRuleSyntax, file, err)
+		}
+	}
+
+	// Semantic processing fails on the first unresolved import, so only run it when all are found
+	if l.resolveImports() {
+		for _, err := range l.ms.Process() {
+			l.addError(LintRuleSemantic, "", err)
+		}
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		fi, fj := l.findings[i], l.findings[j]
+		if fi.File != fj.File {
+			return fi.File < fj.File
+		}
+		if fi.Line != fj.Line {
+			return fi.Line < fj.Line
+		}
+		return fi.Column < fj.Column
+	})
+	return l.findings, nil
+}
+
+// resolveImports loads every imported and included module, reporting those not found
+// in the search path. Returns true if all were resolved
+func (l *linter) resolveImports() bool {
+	resolved := true
+	seen := make(map[*goyang.Module]bool)
+	for {
+		pending := make([]*goyang.Module, 0)
+		for _, mods := range []map[string]*goyang.Module{l.ms.Modules, l.ms.SubModules} {
+			for _, m := range mods {
+				if !seen[m] {
+					seen[m] = true
+					pending = append(pending, m)
+				}
+			}
+		}
+		if len(pending) == 0 {
+			return resolved
+		}
+		sort.Slice(pending, func(i, j int) bool {
+			return pending[i].Name < pending[j].Name
+		})
+		for _, m := range pending {
+			for _, i := range m.Import {
+				if l.ms.FindModule(i) == nil {
+					l.addStatement(LintRuleImport, i.Source, "imported module %q not found", i.Name)
+					resolved = false
+				}
+			}
+			for _, i := range m.Include {
+				if l.ms.FindModule(i) == nil {
+					l.addStatement(LintRuleImport, i.Source, "included submodule %q not found", i.Name)
+					resolved = false
+				}
+			}
+		}
+	}
+}
+
+func (l *linter) lintModule(file string, module *goyang.Statement) {
+	if module.Keyword != "module" && module.Keyword != "submodule" {
+		l.addStatement(LintRuleSyntax, module, "expected module or submodule, found %q", module.Keyword)
+		return
+	}
+
+	present := make(map[string]bool)
+	revisions := make([]*goyang.Statement, 0)
+	for _, s := range module.SubStatements() {
+		present[s.Keyword] = true
+		if s.Keyword == "revision" {
+			revisions = append(revisions, s)
+		}
+		l.lintStatement(s, true)
+	}
+	for _, required := range requiredModuleSubstatements {
+		if !present[required] {
+			l.addStatement(LintRuleLint, module, "%s %q has no %s statement", module.Keyword, module.Argument, required)
+		}
+	}
+	l.lintRevisions(file, module, revisions)
+	l.lintIdentifier(module)
+}
+
+func (l *linter) lintStatement(s *goyang.Statement, topLevel bool) {
+	l.lintIdentifier(s)
+	for _, sub := range s.SubStatements() {
+		if topLevel && isDataDefinition(s.Keyword) && sub.Keyword == "mandatory" && sub.Argument == "true" {
+			l.addStatement(LintRuleLint, s, "top-level node %q must not be mandatory", s.Argument)
+		}
+		l.lintStatement(sub, false)
+	}
+}
+
+func (l *linter) lintIdentifier(s *goyang.Statement) {
+	if !l.options.RequireHyphenated || !identifierKeywords[s.Keyword] {
+		return
+	}
+	if strings.ToLower(s.Argument) != s.Argument || strings.Contains(s.Argument, "_") {
+		l.addStatement(LintRuleHyphenated, s, "%s %q should be lowercase and hyphenated", s.Keyword, s.Argument)
+	}
+}
+
+func (l *linter) lintRevisions(file string, module *goyang.Statement, revisions []*goyang.Statement) {
+	if len(revisions) == 0 {
+		l.addStatement(LintRuleRevision, module, "%s %q has no revision statement", module.Keyword, module.Argument)
+		return
+	}
+	var previous, latest time.Time
+	latestRevision := revisions[0]
+	dates := make(map[string]bool)
+	for i, r := range revisions {
+		date, err := time.Parse(revisionDateLayout, r.Argument)
+		if err != nil {
+			l.addStatement(LintRuleRevision, r, "revision %q is not a valid YYYY-MM-DD date", r.Argument)
+			continue
+		}
+		if dates[r.Argument] {
+			l.addStatement(LintRuleRevision, r, "revision %q is duplicated", r.Argument)
+		}
+		dates[r.Argument] = true
+		if i > 0 && !previous.IsZero() && date.After(previous) {
+			l.addStatement(LintRuleRevision, r, "revision %q is not in reverse chronological order", r.Argument)
+		}
+		if date.After(latest) {
+			latest, latestRevision = date, r
+		}
+		previous = date
+	}
+
+	// A file named module@revision.yang must carry that revision as its latest
+	base := strings.TrimSuffix(filepath.Base(file), dotYang)
+	if at := strings.LastIndex(base, "@"); at >= 0 {
+		if fileRevision := base[at+1:]; fileRevision != latestRevision.Argument {
+			l.addStatement(LintRuleRevision, latestRevision, "latest revision %q does not match revision %q in file name",
+				latestRevision.Argument, fileRevision)
+		}
+	}
+}
+
+func (l *linter) addStatement(rule string, s *goyang.Statement, format string, args ...interface{}) {
+	finding := &LintFinding{Rule: rule, Message: fmt.Sprintf(format, args...)}
+	if s != nil {
+		finding.File, finding.Line, finding.Column = parseLocation(s.Location())
+	}
+	l.findings = append(l.findings, finding)
+}
+
+// addError converts goyang errors, which may hold several newline separated problems, to findings
+func (l *linter) addError(rule string, file string, err error) {
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		finding := &LintFinding{File: file, Rule: rule, Message: line}
+		if m := locationRegExp.FindStringSubmatch(line); m != nil {
+			finding.File = m[1]
+			finding.Line, _ = strconv.Atoi(m[2])
+			finding.Column, _ = strconv.Atoi(m[3])
+			finding.Message = m[4]
+		}
+		l.findings = append(l.findings, finding)
+	}
+}
+
+func parseLocation(location string) (string, int, int) {
+	parts := strings.Split(location, ":")
+	if len(parts) < 3 {
+		return location, 0, 0
+	}
+	line, _ := strconv.Atoi(parts[len(parts)-2])
+	column, _ := strconv.Atoi(parts[len(parts)-1])
+	return strings.Join(parts[:len(parts)-2], ":"), line, column
+}
+
+func isDataDefinition(keyword string) bool {
+	switch keyword {
+	case "container", "leaf", "leaf-list", "list", "choice", "anydata", "anyxml":
+		return true
+	}
+	return false
+}
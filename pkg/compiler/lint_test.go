@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+var lintSearchPath = []string{"../../yang-base", "testdata/lint"}
+
+func TestLintYang_Valid(t *testing.T) {
+	findings, err := LintYang([]string{"testdata/lint/lint-good@2023-01-01.yang"}, lintSearchPath,
+		LintOptions{RequireHyphenated: true})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestLintYang_Issues(t *testing.T) {
+	file := "testdata/lint/lint-bad@2023-02-02.yang"
+	findings, err := LintYang([]string{file}, lintSearchPath, LintOptions{RequireHyphenated: true})
+	assert.NoError(t, err)
+
+	rules := make(map[string][]*LintFinding)
+	for _, f := range findings {
+		assert.Equal(t, file, f.File)
+		assert.NotZero(t, f.Line, f.String())
+		rules[f.Rule] = append(rules[f.Rule], f)
+	}
+
+	if assert.Len(t, rules[LintRuleImport], 1) {
+		assert.Equal(t, 6, rules[LintRuleImport][0].Line)
+		assert.Equal(t, `imported module "missing-module" not found`, rules[LintRuleImport][0].Message)
+	}
+	if assert.Len(t, rules[LintRuleHyphenated], 1) {
+		assert.Equal(t, 24, rules[LintRuleHyphenated][0].Line)
+	}
+	if assert.Len(t, rules[LintRuleRevision], 2) {
+		assert.Equal(t, `revision "2023-01-01" is not in reverse chronological order`, rules[LintRuleRevision][0].Message)
+		assert.Equal(t, `latest revision "2023-01-01" does not match revision "2023-02-02" in file name`,
+			rules[LintRuleRevision][1].Message)
+	}
+	if assert.Len(t, rules[LintRuleLint], 2) {
+		assert.Equal(t, `module "lint-bad" has no contact statement`, rules[LintRuleLint][0].Message)
+		assert.Equal(t, `top-level node "top-mandatory" must not be mandatory`, rules[LintRuleLint][1].Message)
+	}
+
+	findings, err = LintYang([]string{file}, lintSearchPath, LintOptions{RequireHyphenated: false})
+	assert.NoError(t, err)
+	for _, f := range findings {
+		assert.NotEqual(t, LintRuleHyphenated, f.Rule)
+	}
+}
+
+func TestLintYang_Syntax(t *testing.T) {
+	findings, err := LintYang([]string{"testdata/lint/lint-syntax.yang"}, lintSearchPath, LintOptions{})
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, findings) {
+		assert.Equal(t, LintRuleSyntax, findings[0].Rule)
+		assert.Equal(t, "testdata/lint/lint-syntax.yang", findings[0].File)
+	}
+
+	_, err = LintYang([]string{"testdata/lint/not-existing.yang"}, lintSearchPath, LintOptions{})
+	assert.Error(t, err)
+}
+
+func TestLintYang_Models(t *testing.T) {
+	for _, model := range []string{"testdevice-1.0.x", "testdevice-2.0.x"} {
+		path := filepath.Join("../../models", model)
+		c := NewCompiler()
+		c.SetYangBaseDirectory("../../yang-base")
+		if err := c.loadModelMetaData(path); err != nil {
+			t.Fatal(err)
+		}
+		files := make([]string, 0)
+		for _, module := range c.metaData.Modules {
+			files = append(files, filepath.Join(path, yang, module.YangFile))
+		}
+		searchPath, err := c.yangSearchPath(path)
+		assert.NoError(t, err)
+		findings, err := LintYang(files, searchPath, LintOptions{RequireHyphenated: true})
+		assert.NoError(t, err)
+		assert.Empty(t, findings, model)
+	}
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	configmodels "github.com/onosproject/config-models"
+	"github.com/onosproject/config-models/templates"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+)
+
+const (
+	templatesPrefix = "templates"
+	unknownVersion  = "unknown"
+)
+
+// manifestFile is embedded in the api package of the plugin
+var manifestFile = filepath.Join("api", "manifest.json")
+
+// manifestDependencies are the modules whose versions determine the generated code
+var manifestDependencies = []string{
+	"github.com/openconfig/goyang",
+	"github.com/openconfig/ygot",
+}
+
+// defaultTemplates are the templates every model is generated from, unless it overrides them
+var defaultTemplates = []string{mainTemplate, modelTemplate, validateTemplate, testTemplate, gomodTemplate, makefileTemplate,
+	dockerfileTemplate}
+
+// Manifest records the compiler and the inputs a model plugin was generated from, along with the generated artifacts
+type Manifest struct {
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	CompilerVersion string            `json:"compilerVersion"`
+	Dependencies    map[string]string `json:"dependencies"`
+	Inputs          []FileHash        `json:"inputs"`
+	Artifacts       []FileHash        `json:"artifacts"`
+}
+
+// FileHash is the SHA-256 of a file. Inputs under yang-base are relative to the YANG base directory, other
+// inputs to the model directory and artifacts to the output directory
+type FileHash struct {
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+}
+
+// ManifestMismatch is a file of a model directory that does not match the manifest
+type ManifestMismatch struct {
+	File     string
+	Expected string
+	Actual   string
+}
+
+func (m *ManifestMismatch) String() string {
+	if m.Actual == "" {
+		return fmt.Sprintf("%s: missing", m.File)
+	}
+	return fmt.Sprintf("%s: sha256 %s does not match %s in the manifest", m.File, m.Actual, m.Expected)
+}
+
+// generateManifest writes the manifest of the model, which must come after every other artifact
+func (c *ModelCompiler) generateManifest(path string) error {
+	log.Infof("Generating build manifest '%s'", c.outputPath(path, manifestFile))
+
+	manifest, err := c.buildManifest(path)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return c.writeArtifact(path, manifestFile, append(content, '\n'), 0644)
+}
+
+// buildManifest hashes the inputs of the model at path and the artifacts generated so far. The YANG files
+// rewritten by formatting are inputs rather than artifacts, go.mod is tidied after generation and the tree
+// is only generated where pyang is installed, so none of them are recorded as artifacts
+func (c *ModelCompiler) buildManifest(path string) (*Manifest, error) {
+	manifest := &Manifest{
+		Name:            c.dictionary.Name,
+		Version:         c.dictionary.Version,
+		CompilerVersion: configmodels.Version(),
+		Dependencies:    dependencyVersions(),
+		Inputs:          make([]FileHash, 0),
+		Artifacts:       make([]FileHash, 0),
+	}
+
+	for _, input := range c.manifestInputs(path) {
+		content, err := c.readInput(path, input)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Inputs = append(manifest.Inputs, FileHash{File: input, SHA256: sha256Sum(content)})
+	}
+
+	treeFile := c.dictionary.Name + ".tree"
+	for _, artifact := range c.artifacts {
+		if strings.HasPrefix(artifact.File, yang+string(filepath.Separator)) || unverifiedArtifacts[artifact.File] ||
+			artifact.File == treeFile || artifact.File == manifestFile {
+			continue
+		}
+		manifest.Artifacts = append(manifest.Artifacts, FileHash{File: artifact.File, SHA256: sha256Sum(artifact.content)})
+	}
+	sort.Slice(manifest.Artifacts, func(i, j int) bool {
+		return manifest.Artifacts[i].File < manifest.Artifacts[j].File
+	})
+	return manifest, nil
+}
+
+// manifestInputs returns the meta-data, the YANG files of the modules the model depends on and the templates
+// it is generated from, sorted by file
+func (c *ModelCompiler) manifestInputs(path string) []string {
+	inputs := []string{metaDataFile}
+	for _, m := range c.modules.Modules {
+		inputs = append(inputs, relativeHeaderPath(m.File, path, c.yangBaseDirectory))
+	}
+	overridden := make(map[string]bool)
+	for _, t := range c.metaData.Templates {
+		overridden[t.TemplateName()] = true
+		inputs = append(inputs, t.File)
+	}
+	for _, name := range defaultTemplates {
+		if !overridden[name] {
+			inputs = append(inputs, filepath.Join(templatesPrefix, name))
+		}
+	}
+	sort.Strings(inputs)
+	return inputs
+}
+
+// readInput reads an input file of the manifest, the default templates being read from the compiler itself
+func (c *ModelCompiler) readInput(path string, file string) ([]byte, error) {
+	yangBase := filepath.Base(DefaultYangBaseDirectory) + string(filepath.Separator)
+	switch {
+	case strings.HasPrefix(file, yangBase):
+		return os.ReadFile(filepath.Join(c.yangBaseDirectory, strings.TrimPrefix(file, yangBase)))
+	case strings.HasPrefix(file, templatesPrefix+string(filepath.Separator)) && isDefaultTemplate(filepath.Base(file)):
+		if _, err := os.Stat(filepath.Join(path, file)); os.IsNotExist(err) {
+			return fs.ReadFile(templates.Templates, filepath.Base(file))
+		}
+	}
+	return os.ReadFile(filepath.Join(path, file))
+}
+
+// VerifyManifest reads the manifest of the model at path and returns the inputs and artifacts which no longer
+// match it, sorted by file. The default templates are checked against the ones of this compiler
+func (c *ModelCompiler) VerifyManifest(path string) (*Manifest, []*ManifestMismatch, error) {
+	content, err := os.ReadFile(c.outputPath(path, manifestFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, nil, fmt.Errorf("unable to read %s: %v", manifestFile, err)
+	}
+
+	mismatches := make([]*ManifestMismatch, 0)
+	check := func(hash FileHash, content []byte, err error) error {
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		mismatch := &ManifestMismatch{File: hash.File, Expected: hash.SHA256}
+		if err == nil {
+			mismatch.Actual = sha256Sum(content)
+		}
+		if mismatch.Actual != mismatch.Expected {
+			mismatches = append(mismatches, mismatch)
+		}
+		return nil
+	}
+	for _, input := range manifest.Inputs {
+		content, err := c.readInput(path, input.File)
+		if err := check(input, content, err); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, artifact := range manifest.Artifacts {
+		content, err := os.ReadFile(c.outputPath(path, artifact.File))
+		if err := check(artifact, content, err); err != nil {
+			return nil, nil, err
+		}
+	}
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		return mismatches[i].File < mismatches[j].File
+	})
+	return manifest, mismatches, nil
+}
+
+// dependencyVersions returns the versions of the manifest dependencies the compiler is built with
+func dependencyVersions() map[string]string {
+	versions := make(map[string]string, len(manifestDependencies))
+	for _, dependency := range manifestDependencies {
+		versions[dependency] = unknownVersion
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return versions
+	}
+	for _, module := range info.Deps {
+		if _, ok := versions[module.Path]; !ok {
+			continue
+		}
+		version := module.Version
+		if module.Replace != nil {
+			version = module.Replace.Version
+		}
+		versions[module.Path] = version
+	}
+	return versions
+}
+
+func sha256Sum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
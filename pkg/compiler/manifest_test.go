@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	configmodels "github.com/onosproject/config-models"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildManifest(t *testing.T) {
+	modelPath := "../../models/testdevice-2.0.x"
+	c := loadSampleModel(t, modelPath)
+	c.SetDryRun(true)
+	c.dictionary = c.newDictionary()
+	assert.NoError(t, c.generateMakefile(modelPath))
+	assert.NoError(t, c.generateGoModule(modelPath))
+
+	manifest, err := c.buildManifest(modelPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "testdevice", manifest.Name)
+	assert.Equal(t, configmodels.Version(), manifest.CompilerVersion)
+	assert.Equal(t, "v0.26.0", manifest.Dependencies["github.com/openconfig/ygot"])
+	assert.Equal(t, "v1.2.0", manifest.Dependencies["github.com/openconfig/goyang"])
+	assert.Equal(t, []FileHash{{File: "Makefile", SHA256: sha256Sum(c.artifacts[0].content)}}, manifest.Artifacts)
+
+	inputs := make([]string, 0, len(manifest.Inputs))
+	for _, input := range manifest.Inputs {
+		inputs = append(inputs, input.File)
+	}
+	assert.Equal(t, []string{"metadata.yaml", "templates/Dockerfile.tpl", "templates/Makefile.tpl", "templates/generated_test.go.tpl",
+		"templates/go.mod.tpl", "templates/main.go.tpl", "templates/model.go.tpl", "templates/validate.go.tpl",
+		"yang/onf-test1-augmented@2020-02-29.yang",
+		"yang/onf-test1-identities@2020-09-01.yang", "yang/onf-test1@2019-06-10.yang"}, inputs)
+}
+
+func TestVerifyManifest(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	manifest, mismatches, err := c.VerifyManifest("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	out := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(out, "api"), os.ModePerm))
+	content, err := os.ReadFile(filepath.Join("../../models/testdevice-2.0.x", manifestFile))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(out, manifestFile), content, 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(out, "Dockerfile"), []byte("FROM scratch\n"), 0644))
+	c.SetOutputDirectory(out)
+	_, mismatches, err = c.VerifyManifest("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+	assert.Len(t, mismatches, len(manifest.Artifacts))
+	assert.Equal(t, "Dockerfile", mismatches[0].File)
+	assert.Equal(t, sha256Sum([]byte("FROM scratch\n")), mismatches[0].Actual)
+	assert.Equal(t, "Makefile: missing", mismatches[1].String())
+}
@@ -6,28 +6,60 @@ package compiler
 
 import (
 	"fmt"
+	docs_gen "github.com/onosproject/config-models/pkg/docs-gen"
+	goyang "github.com/openconfig/goyang/pkg/yang"
 	"gopkg.in/yaml.v3"
 	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
 )
 
+// versionRegExp matches MAJOR.MINOR.PATCH versions, where x stands for any patch or any minor and patch.
+// MAJOR.x is short for MAJOR.x.x
+var versionRegExp = regexp.MustCompile(`^(0|[1-9][0-9]*)\.((0|[1-9][0-9]*)\.(0|[1-9][0-9]*|x)|x\.x|x)$`)
+
+// fakeRootNameRegExp matches the YANG identifiers the fake root can be named with
+var fakeRootNameRegExp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// importPathElementRegExp matches one element of a Go import path
+var importPathElementRegExp = regexp.MustCompile(`^[A-Za-z0-9_~-]+(\.[A-Za-z0-9_~-]+)*$`)
+
 // MetaData plugin meta-data
 type MetaData struct {
-	Name               string   `mapstructure:"name" yaml:"name"`
-	Version            string   `mapstructure:"version" yaml:"version"`
-	Modules            []Module `mapstructure:"modules" yaml:"modules"`
-	GetStateMode       uint32   `mapstructure:"getStateMode" yaml:"getStateMode"`
-	LintModel          bool     `mapstructure:"lintModel" yaml:"lintModel"`
-	RequireHyphenated  bool     `mapstructure:"requireHyphenated" yaml:"requireHyphenated"`
-	FormatYang         bool     `mapstructure:"formatYang" yaml:"formatYang"`
-	GenOpenAPI         bool     `mapstructure:"genOpenAPI" yaml:"genOpenAPI"`
-	OpenAPITargetAlias string   `mapstructure:"openAPITargetAlias" yaml:"openAPITargetAlias"`
-	GoPackage          string   `mapstructure:"goPackage" yaml:"goPackage"`
-	ArtifactName       string   `mapstructure:"artifactName" yaml:"artifactName"`
-	ContactName        string   `mapstructure:"contactName" yaml:"contactName"`
-	ContactUrl         string   `mapstructure:"contactUrl" yaml:"contactUrl"`
-	ContactEmail       string   `mapstructure:"contactEmail" yaml:"contactEmail"`
-	LicenseName        string   `mapstructure:"licenseName" yaml:"licenseName"`
-	LicenseUrl         string   `mapstructure:"licenseUrl" yaml:"licenseUrl"`
+	Name              string   `mapstructure:"name" yaml:"name"`
+	Version           string   `mapstructure:"version" yaml:"version"`
+	Modules           []Module `mapstructure:"modules" yaml:"modules"`
+	GetStateMode      uint32   `mapstructure:"getStateMode" yaml:"getStateMode"`
+	LintModel         bool     `mapstructure:"lintModel" yaml:"lintModel"`
+	RequireHyphenated bool     `mapstructure:"requireHyphenated" yaml:"requireHyphenated"`
+	FormatYang        bool     `mapstructure:"formatYang" yaml:"formatYang"`
+	GenOpenAPI        bool     `mapstructure:"genOpenAPI" yaml:"genOpenAPI"`
+	GenProto          bool     `mapstructure:"genProto" yaml:"genProto"`
+	// GenDocs is the format of the model reference docs, markdown or html; none are generated when empty
+	GenDocs            string     `mapstructure:"genDocs" yaml:"genDocs"`
+	OpenAPITargetAlias string     `mapstructure:"openAPITargetAlias" yaml:"openAPITargetAlias"`
+	GoPackage          string     `mapstructure:"goPackage" yaml:"goPackage"`
+	ArtifactName       string     `mapstructure:"artifactName" yaml:"artifactName"`
+	ContactName        string     `mapstructure:"contactName" yaml:"contactName"`
+	ContactUrl         string     `mapstructure:"contactUrl" yaml:"contactUrl"`
+	ContactEmail       string     `mapstructure:"contactEmail" yaml:"contactEmail"`
+	LicenseName        string     `mapstructure:"licenseName" yaml:"licenseName"`
+	LicenseUrl         string     `mapstructure:"licenseUrl" yaml:"licenseUrl"`
+	Templates          []Template `mapstructure:"templates" yaml:"templates"`
+	// Features lists the enabled features of some of the modules, all the features of the others being enabled
+	Features map[string][]string `mapstructure:"features" yaml:"features"`
+	// Deviations are the modules holding the deviations applied to the model
+	Deviations []Module `mapstructure:"deviations" yaml:"deviations"`
+	// Generator controls the generation of the Golang bindings
+	Generator GeneratorOptions `mapstructure:"generator" yaml:"generator"`
+	// Stages are the registered stages the model goes through after the built-in ones
+	Stages []StageConfig `mapstructure:"stages" yaml:"stages"`
+
+	// file and nodes locate the meta-data and each of its fields when loaded by LoadMetaData
+	file  string
+	nodes map[string]*yaml.Node
 }
 
 type Module struct {
@@ -37,37 +69,358 @@ type Module struct {
 	YangFile     string `mapstructure:"file" yaml:"file"`
 }
 
-// LoadMetaData loads the metadata.yaml file
+// GeneratorOptions are the ygot options the Golang bindings are generated with; all default to false,
+// which generates uncompressed structs rooted at Device
+type GeneratorOptions struct {
+	// CompressPaths removes the config and state containers and the containers of lists from the structs
+	CompressPaths bool `mapstructure:"compressPaths" yaml:"compressPaths"`
+	// PreferOperationalState maps the compressed leaves to their state rather than their config path
+	PreferOperationalState bool `mapstructure:"preferOperationalState" yaml:"preferOperationalState"`
+	// IgnoreShadowPaths ignores the paths shadowed by compression when unmarshalling, instead of failing
+	IgnoreShadowPaths bool `mapstructure:"ignoreShadowPaths" yaml:"ignoreShadowPaths"`
+	// FakeRootName is the name of the root of the data tree, Device if empty
+	FakeRootName string `mapstructure:"fakeRootName" yaml:"fakeRootName"`
+	// SimpleUnions represents unions with typedefs of their subtypes rather than wrapper structs
+	SimpleUnions bool `mapstructure:"simpleUnions" yaml:"simpleUnions"`
+	// ExcludeState leaves the config false nodes out of the structs
+	ExcludeState bool `mapstructure:"excludeState" yaml:"excludeState"`
+}
+
+// Template overrides one of the default plugin templates or adds an extra one
+type Template struct {
+	// Name of the default template to override, e.g. Dockerfile.tpl; defaults to the base name of File
+	Name string `mapstructure:"name" yaml:"name"`
+	// File is the template path, relative to the model directory
+	File string `mapstructure:"file" yaml:"file"`
+	// Output is the generated file path, relative to the model directory; mandatory for extra templates
+	Output string `mapstructure:"output" yaml:"output"`
+}
+
+// TemplateName returns the name the template is known by
+func (t Template) TemplateName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return filepath.Base(t.File)
+}
+
+// MetaDataError is a single issue found in the meta-data; Line and Column are 0 when unknown
+type MetaDataError struct {
+	File    string
+	Line    int
+	Column  int
+	Field   string
+	Message string
+}
+
+func (e *MetaDataError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+}
+
+// MetaDataErrors is every issue found in the meta-data, in file order
+type MetaDataErrors []*MetaDataError
+
+func (e MetaDataErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// LoadMetaData loads the metadata.yaml file, failing on unknown or mistyped fields with
+// MetaDataErrors. Fields missing from the file keep the value they have in metaData
 func LoadMetaData(path string, configFile string, metaData *MetaData) error {
-	mdBytes, err := os.ReadFile(fmt.Sprintf("%s/%s.yaml", path, configFile))
+	file := filepath.Join(path, configFile+".yaml")
+	mdBytes, err := os.ReadFile(file)
 	if err != nil {
 		return err
 	}
-	return yaml.Unmarshal(mdBytes, metaData)
+	var root yaml.Node
+	if err := yaml.Unmarshal(mdBytes, &root); err != nil {
+		return err
+	}
+	metaData.file = file
+	metaData.nodes = make(map[string]*yaml.Node)
+	if len(root.Content) == 0 {
+		return MetaDataErrors{{File: file, Message: fmt.Sprintf("%s is empty", file)}}
+	}
+	var errs MetaDataErrors
+	metaData.decode(root.Content[0], reflect.ValueOf(metaData).Elem(), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// ValidateMetaData checks that required attributes are set
-func ValidateMetaData(metaData *MetaData) error {
-	if metaData.Name == "" {
-		return fmt.Errorf("name is mandatory")
+// decode decodes node into out, recording the node of every field so that later errors can be
+// located. Unknown and mistyped fields are added to errs rather than stopping the decoding
+func (m *MetaData) decode(node *yaml.Node, out reflect.Value, field string, errs *MetaDataErrors) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	m.nodes[field] = node
+
+	switch {
+	case out.Kind() == reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			*errs = append(*errs, m.errorAt(node, field, "%s must be a mapping", fieldName(field)))
+			return
+		}
+		fields := yamlFields(out.Type())
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			index, ok := fields[key.Value]
+			if !ok {
+				*errs = append(*errs, m.errorAt(key, joinField(field, key.Value), "%s", unknownFieldMessage(key.Value, fields)))
+				continue
+			}
+			m.decode(value, out.Field(index), joinField(field, key.Value), errs)
+		}
+	case out.Kind() == reflect.Map && out.Type().Key().Kind() == reflect.String:
+		if node.Kind != yaml.MappingNode {
+			*errs = append(*errs, m.errorAt(node, field, "%s must be a mapping", fieldName(field)))
+			return
+		}
+		out.Set(reflect.MakeMap(out.Type()))
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			item := reflect.New(out.Type().Elem()).Elem()
+			m.decode(value, item, joinField(field, key.Value), errs)
+			out.SetMapIndex(reflect.ValueOf(key.Value), item)
+		}
+	case out.Kind() == reflect.Slice && out.Type().Elem().Kind() == reflect.Struct:
+		if node.Kind != yaml.SequenceNode {
+			*errs = append(*errs, m.errorAt(node, field, "%s must be a list", fieldName(field)))
+			return
+		}
+		out.Set(reflect.MakeSlice(out.Type(), len(node.Content), len(node.Content)))
+		for i, item := range node.Content {
+			m.decode(item, out.Index(i), fmt.Sprintf("%s[%d]", field, i), errs)
+		}
+	default:
+		if err := node.Decode(out.Addr().Interface()); err != nil {
+			*errs = append(*errs, m.errorAt(node, field, "%s must be a %s, found %q", field, out.Type(), node.Value))
+		}
 	}
-	if metaData.Version == "" {
-		return fmt.Errorf("version is mandatory")
+}
+
+// yamlFields maps the yaml name of each field of a struct type to its index
+func yamlFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		if name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]; name != "" && name != "-" {
+			fields[name] = i
+		}
 	}
-	if metaData.ArtifactName == "" {
-		return fmt.Errorf("artifactName is mandatory")
+	return fields
+}
+
+func unknownFieldMessage(key string, fields map[string]int) string {
+	for name := range fields {
+		if strings.EqualFold(name, key) {
+			return fmt.Sprintf("unknown field %q, did you mean %q?", key, name)
+		}
 	}
-	if metaData.GoPackage == "" {
-		return fmt.Errorf("goPackage is mandatory")
+	return fmt.Sprintf("unknown field %q", key)
+}
+
+func joinField(parent string, field string) string {
+	if parent == "" {
+		return field
 	}
-	if metaData.Modules == nil || len(metaData.Modules) == 0 {
-		return fmt.Errorf("no modules are listed")
+	return parent + "." + field
+}
+
+func fieldName(field string) string {
+	if field == "" {
+		return "meta-data"
 	}
-	if metaData.ContactName == "" {
-		return fmt.Errorf("ContactName is mandatory")
+	return field
+}
+
+// errorAt returns an error located at node, or unlocated if the meta-data was not loaded from a file
+func (m *MetaData) errorAt(node *yaml.Node, field string, format string, args ...interface{}) *MetaDataError {
+	err := &MetaDataError{File: m.file, Field: field, Message: fmt.Sprintf(format, args...)}
+	if node != nil {
+		err.Line, err.Column = node.Line, node.Column
 	}
-	if metaData.LicenseName == "" {
-		return fmt.Errorf("licenseName is mandatory")
+	return err
+}
+
+// errorAtField returns an error located at the value of field, or at its closest parent when missing
+func (m *MetaData) errorAtField(field string, format string, args ...interface{}) *MetaDataError {
+	for parent := field; ; {
+		if node, ok := m.nodes[parent]; ok {
+			return m.errorAt(node, field, format, args...)
+		}
+		if parent == "" {
+			return m.errorAt(nil, field, format, args...)
+		}
+		if i := strings.LastIndexAny(parent, ".["); i >= 0 {
+			parent = parent[:i]
+		} else {
+			parent = ""
+		}
+	}
+}
+
+// ValidateMetaData checks every field of the meta-data of the model at path, returning all the
+// issues found as MetaDataErrors. Module files are looked up in the yang directory of the model
+func ValidateMetaData(path string, metaData *MetaData) error {
+	var errs MetaDataErrors
+	mandatory := func(field string, value string) bool {
+		if value == "" {
+			errs = append(errs, metaData.errorAtField(field, "%s is mandatory", field))
+		}
+		return value != ""
+	}
+
+	mandatory("name", metaData.Name)
+	if mandatory("version", metaData.Version) && !versionRegExp.MatchString(metaData.Version) {
+		errs = append(errs, metaData.errorAtField("version",
+			"version %q is not a semantic version MAJOR.MINOR.PATCH, where x may replace PATCH or MINOR.PATCH", metaData.Version))
+	}
+	mandatory("artifactName", metaData.ArtifactName)
+	if mandatory("goPackage", metaData.GoPackage) && !isImportPath(metaData.GoPackage) {
+		errs = append(errs, metaData.errorAtField("goPackage", "goPackage %q is not a valid Go import path", metaData.GoPackage))
+	}
+	mandatory("contactName", metaData.ContactName)
+	mandatory("licenseName", metaData.LicenseName)
+	if len(metaData.Modules) == 0 {
+		errs = append(errs, metaData.errorAtField("modules", "no modules are listed"))
+	}
+	for i, module := range metaData.Modules {
+		errs = append(errs, metaData.validateModule(path, fmt.Sprintf("modules[%d]", i), module)...)
+	}
+	for i, deviation := range metaData.Deviations {
+		errs = append(errs, metaData.validateModule(path, fmt.Sprintf("deviations[%d]", i), deviation)...)
+	}
+	errs = append(errs, metaData.validateGenerator()...)
+	if metaData.GenDocs != "" && !isDocsFormat(metaData.GenDocs) {
+		errs = append(errs, metaData.errorAtField("genDocs", "genDocs %q is not one of %s", metaData.GenDocs, docsFormats()))
+	}
+	for i, stage := range metaData.Stages {
+		field := fmt.Sprintf("stages[%d].name", i)
+		if !mandatory(field, stage.Name) {
+			continue
+		}
+		if _, ok := registeredStage(stage.Name); !ok {
+			errs = append(errs, metaData.errorAtField(field, "%s %q is not a registered stage", field, stage.Name))
+		}
+	}
+	for i, t := range metaData.Templates {
+		field := fmt.Sprintf("templates[%d]", i)
+		if !mandatory(field+".file", t.File) {
+			continue
+		}
+		if t.Output == "" && !isDefaultTemplate(t.TemplateName()) {
+			errs = append(errs, metaData.errorAtField(field+".output",
+				"%s.output is mandatory for extra template %s", field, t.TemplateName()))
+		} else if t.Output != "" && !isLocalPath(t.Output) {
+			errs = append(errs, metaData.errorAtField(field+".output",
+				"%s.output %q is not a relative path within the model directory", field, t.Output))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
 	}
 	return nil
 }
+
+// isLocalPath returns true if p is relative and, once cleaned, does not escape the directory it is joined onto
+func isLocalPath(p string) bool {
+	if filepath.IsAbs(p) {
+		return false
+	}
+	p = filepath.Clean(p)
+	return p != ".." && !strings.HasPrefix(p, ".."+string(filepath.Separator))
+}
+
+// validateModule checks that the module file exists and declares the module with the given revision as its latest
+func (m *MetaData) validateModule(path string, field string, module Module) MetaDataErrors {
+	var errs MetaDataErrors
+	for _, f := range []struct{ name, value string }{{"name", module.Name}, {"revision", module.Revision}, {"file", module.YangFile}} {
+		if f.value == "" {
+			errs = append(errs, m.errorAtField(field+"."+f.name, "%s.%s is mandatory", field, f.name))
+		}
+	}
+	if module.YangFile == "" {
+		return errs
+	}
+
+	file := filepath.Join(path, yang, module.YangFile)
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return append(errs, m.errorAtField(field+".file", "%s.file %s does not exist under %s/", field, module.YangFile, yang))
+	}
+	statements, err := goyang.Parse(string(data), file)
+	if err != nil || len(statements) == 0 {
+		return append(errs, m.errorAtField(field+".file", "%s.file %s is not a valid YANG file: %v", field, module.YangFile, err))
+	}
+	declared := statements[0]
+	if module.Name != "" && declared.Argument != module.Name {
+		errs = append(errs, m.errorAtField(field+".name", "%s.name %q does not match %s %q declared in %s",
+			field, module.Name, declared.Keyword, declared.Argument, module.YangFile))
+	}
+	latest := ""
+	for _, s := range declared.SubStatements() {
+		if s.Keyword == "revision" && s.Argument > latest {
+			latest = s.Argument
+		}
+	}
+	if module.Revision != "" && module.Revision != latest {
+		errs = append(errs, m.errorAtField(field+".revision", "%s.revision %q does not match the latest revision %q of %s",
+			field, module.Revision, latest, module.YangFile))
+	}
+	return errs
+}
+
+// validateGenerator checks that the generator options can be combined and are supported by ygot
+func (m *MetaData) validateGenerator() MetaDataErrors {
+	var errs MetaDataErrors
+	options := m.Generator
+	if options.PreferOperationalState && (!options.CompressPaths || options.ExcludeState) {
+		errs = append(errs, m.errorAtField("generator.preferOperationalState",
+			"generator.preferOperationalState requires compressPaths and cannot be combined with excludeState"))
+	}
+	if options.IgnoreShadowPaths && !options.CompressPaths {
+		errs = append(errs, m.errorAtField("generator.ignoreShadowPaths", "generator.ignoreShadowPaths requires compressPaths"))
+	}
+	if options.FakeRootName != "" && !fakeRootNameRegExp.MatchString(options.FakeRootName) {
+		errs = append(errs, m.errorAtField("generator.fakeRootName",
+			"generator.fakeRootName %q is not a valid YANG identifier", options.FakeRootName))
+	}
+	return errs
+}
+
+func isDocsFormat(format string) bool {
+	for _, f := range docs_gen.Formats {
+		if string(f) == format {
+			return true
+		}
+	}
+	return false
+}
+
+func docsFormats() string {
+	formats := make([]string, 0, len(docs_gen.Formats))
+	for _, f := range docs_gen.Formats {
+		formats = append(formats, string(f))
+	}
+	return strings.Join(formats, ", ")
+}
+
+// isImportPath tells whether path is a valid Go import path, as used for the module of the generated plugin
+func isImportPath(path string) bool {
+	for _, element := range strings.Split(path, "/") {
+		if !importPathElementRegExp.MatchString(element) {
+			return false
+		}
+	}
+	return true
+}
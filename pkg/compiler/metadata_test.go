@@ -5,6 +5,7 @@
 package compiler
 
 import (
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -23,9 +24,108 @@ func TestLoadMetaData(t *testing.T) {
 	assert.True(t, md.RequireHyphenated)
 }
 
+func TestLoadMetaData_Strict(t *testing.T) {
+	md := &MetaData{LintModel: true}
+	err := LoadMetaData("../../test/metadata", "invalid", md)
+	errs, ok := err.(MetaDataErrors)
+	if !assert.True(t, ok, "unexpected error %v", err) {
+		return
+	}
+	assert.Equal(t, []string{
+		`../../test/metadata/invalid.yaml:7:1: unknown field "genOpenApi", did you mean "genOpenAPI"?`,
+		`../../test/metadata/invalid.yaml:8:12: lintModel must be a bool, found "maybe"`,
+		`../../test/metadata/invalid.yaml:11:5: unknown field "revsion"`,
+	}, errorStrings(errs))
+	assert.Equal(t, "1.0.0", md.Version)
+	assert.Equal(t, "openconfig-interfaces", md.Modules[0].Name)
+}
+
 func TestValidateMetaData(t *testing.T) {
 	missingName := &MetaData{Name: "", LintModel: true, RequireHyphenated: true}
-	err := ValidateMetaData(missingName)
+	err := ValidateMetaData("testdata/compat/v1", missingName)
+	assert.Error(t, err)
+	assert.Equal(t, "name is mandatory", err.(MetaDataErrors)[0].Error())
+	assert.Len(t, err.(MetaDataErrors), 7)
+
+	md := validMetaData()
+	assert.NoError(t, ValidateMetaData("testdata/compat/v1", md))
+
+	md.Version = "1.0"
+	md.GoPackage = "github.com/onosproject/../test"
+	md.Modules = []Module{
+		{Name: "compat", Revision: "2022-01-01", YangFile: "compat-test.yang"},
+		{Name: "missing", Revision: "2022-01-01", YangFile: "missing.yang"},
+		{Name: "compat-test"},
+	}
+	err = ValidateMetaData("testdata/compat/v1", md)
+	assert.Equal(t, []string{
+		`version "1.0" is not a semantic version MAJOR.MINOR.PATCH, where x may replace PATCH or MINOR.PATCH`,
+		`goPackage "github.com/onosproject/../test" is not a valid Go import path`,
+		`modules[0].name "compat" does not match module "compat-test" declared in compat-test.yang`,
+		`modules[0].revision "2022-01-01" does not match the latest revision "2023-01-01" of compat-test.yang`,
+		`modules[1].file missing.yang does not exist under yang/`,
+		`modules[2].revision is mandatory`,
+		`modules[2].file is mandatory`,
+	}, errorStrings(err.(MetaDataErrors)))
+}
+
+func TestValidateMetaData_Version(t *testing.T) {
+	md := validMetaData()
+	for _, version := range []string{"1.0.0", "0.1.10", "1.0.x", "2.x.x", "1.x"} {
+		md.Version = version
+		assert.NoError(t, ValidateMetaData("testdata/compat/v1", md), version)
+	}
+	for _, version := range []string{"1", "x", "1.x.0", "1.x.", "01.0.0", "v1.0.0", "1.0.0.0"} {
+		md.Version = version
+		assert.Error(t, ValidateMetaData("testdata/compat/v1", md), version)
+	}
+}
+
+func TestValidateMetaData_Templates(t *testing.T) {
+	md := validMetaData()
+	md.Templates = []Template{{File: "templates/Dockerfile.tpl"}}
+	assert.NoError(t, ValidateMetaData("testdata/compat/v1", md))
+
+	md.Templates = []Template{{File: "templates/cli.go.tpl"}}
+	err := ValidateMetaData("testdata/compat/v1", md)
+	assert.Error(t, err)
+	assert.Equal(t, "templates[0].output is mandatory for extra template cli.go.tpl", err.Error())
+
+	for _, output := range []string{"../../Makefile", "/tmp/Makefile", "..", "cmd/../../Makefile"} {
+		md.Templates = []Template{{File: "templates/cli.go.tpl", Output: output}}
+		err = ValidateMetaData("testdata/compat/v1", md)
+		assert.Error(t, err, output)
+		assert.Equal(t, fmt.Sprintf("templates[0].output %q is not a relative path within the model directory", output), err.Error())
+	}
+	md.Templates = []Template{{File: "templates/cli.go.tpl", Output: "cmd/../cli/main.go"}}
+	assert.NoError(t, ValidateMetaData("testdata/compat/v1", md))
+
+	md.Templates = []Template{{Name: "main.go.tpl"}}
+	assert.Error(t, ValidateMetaData("testdata/compat/v1", md))
+}
+
+func validMetaData() *MetaData {
+	return &MetaData{Name: "test", Version: "1.0.0", ArtifactName: "test", GoPackage: "github.com/onosproject/test",
+		Modules:     []Module{{Name: "compat-test", Revision: "2023-01-01", YangFile: "compat-test.yang"}},
+		ContactName: "ONF", LicenseName: "Apache-2.0"}
+}
+
+func errorStrings(errs MetaDataErrors) []string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func TestValidateMetaData_GenDocs(t *testing.T) {
+	md := validMetaData()
+	for _, format := range []string{"", "markdown", "html"} {
+		md.GenDocs = format
+		assert.NoError(t, ValidateMetaData("testdata/compat/v1", md), format)
+	}
+	md.GenDocs = "pdf"
+	err := ValidateMetaData("testdata/compat/v1", md)
 	assert.Error(t, err)
-	assert.Equal(t, "name is mandatory", err.Error())
+	assert.Equal(t, `genDocs "pdf" is not one of markdown, html`, err.Error())
 }
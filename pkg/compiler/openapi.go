@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	openapi_gen "github.com/onosproject/config-models/pkg/openapi-gen"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	openapiFile   = "openapi.yaml"
+	openapiHeader = `# SPDX-FileCopyrightText: 2022-present Intel Corporation
+# SPDX-FileCopyrightText: 2021-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+`
+)
+
+// generateOpenApi writes the OpenAPI specs of the model to openapi.yaml
+func (c *ModelCompiler) generateOpenApi(path string) error {
+	log.Infof("Generating plugin OpenApi specs '%s'", c.outputPath(path, openapiFile))
+
+	content, err := c.buildOpenApi(path)
+	if err != nil {
+		return err
+	}
+	return c.writeArtifact(path, openapiFile, content, 0644)
+}
+
+// buildOpenApi returns the YAML of the OpenAPI specs derived from the ygot schema of the model; BuildOpenapi validates the specs before returning them
+func (c *ModelCompiler) buildOpenApi(path string) ([]byte, error) {
+	schema, err := c.compiledSchema(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := openapi_gen.BuildOpenapi(schema, c.openapiSettings())
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenApi specs: %v", err)
+	}
+	content, err := jsonToYaml(spec)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(openapiHeader), content...), nil
+}
+
+// jsonToYaml marshals the JSON form of value as YAML, so that the openapi3 JSON tags are honored
+func jsonToYaml(value interface{}) ([]byte, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var object interface{}
+	if err := yaml.Unmarshal(content, &object); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(object)
+}
+
+func (c *ModelCompiler) openapiSettings() *openapi_gen.ApiGenSettings {
+	return &openapi_gen.ApiGenSettings{
+		ModelType:    c.dictionary.Name,
+		ModelVersion: c.dictionary.Version,
+		Title:        fmt.Sprintf("%s-%s", c.dictionary.Name, c.dictionary.Version),
+		TargetAlias:  c.dictionary.OpenAPITargetAlias,
+		Contact: &openapi3.Contact{
+			Name:  c.dictionary.ContactName,
+			URL:   c.dictionary.ContactUrl,
+			Email: c.dictionary.ContactEmail,
+		},
+		License: &openapi3.License{
+			Name: c.dictionary.LicenseName,
+			URL:  c.dictionary.LicenseUrl,
+		},
+	}
+}
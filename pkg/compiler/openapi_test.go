@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildSchema(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	path := "../../models/testdevice-2.0.x"
+	assert.NoError(t, c.loadModelMetaData(path))
+
+	schema, err := c.BuildSchema(path)
+	assert.NoError(t, err)
+	assert.Contains(t, schema.SchemaTree, "Device")
+	assert.Contains(t, schema.SchemaTree["Device"].Dir, "cont1a")
+	assert.Contains(t, schema.SchemaTree, "OnfTest1_Cont1A")
+
+	// The stages of a compilation share a single schema
+	compiled, err := c.compiledSchema(path)
+	assert.NoError(t, err)
+	again, err := c.compiledSchema(path)
+	assert.NoError(t, err)
+	assert.Same(t, compiled, again)
+	assert.NotSame(t, schema, compiled)
+}
+
+func TestBuildOpenApi(t *testing.T) {
+	for _, model := range []string{"testdevice-1.0.x", "testdevice-2.0.x", "devicesim-1.0.x", "e2node-1.x", "ric-1.x"} {
+		t.Run(model, func(t *testing.T) {
+			c := NewCompiler()
+			c.SetYangBaseDirectory("../../yang-base")
+			path := filepath.Join("../../models", model)
+			assert.NoError(t, c.loadModelMetaData(path))
+			c.dictionary = c.newDictionary()
+
+			content, err := c.buildOpenApi(path)
+			assert.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join(path, openapiFile))
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(content))
+		})
+	}
+}
+
+func TestBuildOpenApi_Reproducible(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	path := "../../models/devicesim-1.0.x"
+	assert.NoError(t, c.loadModelMetaData(path))
+	c.dictionary = c.newDictionary()
+
+	first, err := c.buildOpenApi(path)
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		// The ygot schema is built again, rather than shared as within a compilation
+		c.reset()
+		content, err := c.buildOpenApi(path)
+		assert.NoError(t, err)
+		assert.Equal(t, string(first), string(content))
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// pluginConfig uses the uncompressed paths of testdata/plugin, whatever the generator options
+const pluginConfig = `{"plugin-test:interfaces": {"interface": [{"name": "eth0", "config": {"name": "eth0", "mtu": %d}}]}}`
+
+// buildPlugin compiles the model at path and builds its plugin against this checkout of config-models,
+// returning the path of the plugin binary
+func buildPlugin(t *testing.T, path string) string {
+	if testing.Short() {
+		t.Skip("building a model plugin is slow")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not installed")
+	}
+
+	out := t.TempDir()
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(out)
+	c.SetNoFormat(true)
+	if err := c.Compile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// The sample models share the dependencies of the generated go.mod, so their go.sum saves a download
+	goSum, err := os.ReadFile("../../models/testdevice-1.0.x/go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(out, "go.sum"), goSum, 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The plugin requires the version of the compiler, which the replace of a workspace resolves to this checkout
+	checkout, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goWork := filepath.Join(out, "go.work")
+	content := fmt.Sprintf("go 1.19\n\nuse .\n\nreplace %s => %s\n", configModelsModulePath, checkout)
+	if err := os.WriteFile(goWork, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	binary := filepath.Join(out, "_bin", "plugin")
+	build := exec.Command(goBin, "build", "-o", binary, "./plugin")
+	build.Dir = out
+	build.Env = append(os.Environ(), "GOWORK="+goWork, "GOFLAGS=")
+	if output, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("unable to build the plugin: %v\n%s", err, output)
+	}
+	return binary
+}
+
+// runPlugin starts the plugin binary without TLS and returns a client of its service, stopping it with the test
+func runPlugin(t *testing.T, binary string) admin.ModelPluginServiceClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	plugin := exec.Command(binary, "--no-tls", "--bind", "127.0.0.1", "--port", strconv.Itoa(port))
+	if err := plugin.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = plugin.Process.Signal(os.Interrupt)
+		_ = plugin.Wait()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return admin.NewModelPluginServiceClient(conn)
+}
+
+func TestPlugin_CompressedPaths(t *testing.T) {
+	client := runPlugin(t, buildPlugin(t, "testdata/plugin"))
+	ctx := context.Background()
+
+	info, err := client.GetModelInfo(ctx, &admin.ModelInfoRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "plugin", info.ModelInfo.Name)
+		assert.Len(t, info.ModelInfo.ReadWritePath, 4)
+	}
+
+	values, err := client.GetPathValues(ctx, &admin.PathValuesRequest{PathPrefix: "/", Json: []byte(fmt.Sprintf(pluginConfig, 1500))})
+	if assert.NoError(t, err) {
+		paths := make([]string, 0, len(values.PathValues))
+		for _, value := range values.PathValues {
+			paths = append(paths, value.Path)
+		}
+		assert.ElementsMatch(t, []string{"/interfaces/interface[name=eth0]/config/name",
+			"/interfaces/interface[name=eth0]/config/mtu"}, paths)
+	}
+
+	_, err = client.ValidateConfig(ctx, &admin.ValidateConfigRequest{Json: []byte(fmt.Sprintf(pluginConfig, 1500))})
+	assert.NoError(t, err)
+
+	// The must statement is evaluated by navigating the compressed structs from the custom fake root
+	_, err = client.ValidateConfig(ctx, &admin.ValidateConfigRequest{Json: []byte(fmt.Sprintf(pluginConfig, 1000))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "MTU 1000 is reserved")
+}
+
+func TestPlugin_GenProto(t *testing.T) {
+	path := t.TempDir()
+	for _, file := range []string{metaDataFile, "yang/plugin-test.yang"} {
+		content, err := os.ReadFile(filepath.Join("testdata/plugin", file))
+		assert.NoError(t, err)
+		if file == metaDataFile {
+			content = append(content, "genProto: true\n"...)
+		}
+		assert.NoError(t, os.MkdirAll(filepath.Join(path, filepath.Dir(file)), os.ModePerm))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, file), content, 0644))
+	}
+	client := runPlugin(t, buildPlugin(t, path))
+	ctx := context.Background()
+
+	info, err := client.GetModelInfo(ctx, &admin.ModelInfoRequest{})
+	if assert.NoError(t, err) {
+		assert.Contains(t, info.ModelInfo.SupportedEncodings, gnmi.Encoding_PROTO)
+	}
+
+	notification := func(mtu uint64) []byte {
+		config, err := proto.Marshal(&gnmi.Notification{
+			Prefix: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth0"}}}},
+			Update: []*gnmi.Update{
+				{
+					Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "config"}, {Name: "name"}}},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "eth0"}},
+				},
+				{
+					Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "config"}, {Name: "mtu"}}},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: mtu}},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		return config
+	}
+
+	values, err := client.GetPathValues(ctx, &admin.PathValuesRequest{PathPrefix: "/", Json: notification(1500)})
+	if assert.NoError(t, err) {
+		assert.Len(t, values.PathValues, 2)
+	}
+	_, err = client.ValidateConfig(ctx, &admin.ValidateConfigRequest{Json: notification(1500)})
+	assert.NoError(t, err)
+	_, err = client.ValidateConfig(ctx, &admin.ValidateConfigRequest{Json: notification(1000)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "MTU 1000 is reserved")
+}
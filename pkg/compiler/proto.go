@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/openconfig/ygot/genutil"
+	"github.com/openconfig/ygot/protogen"
+	"github.com/openconfig/ygot/ygen"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const protoDirectory = "proto"
+
+// protoPackageName returns the name of the protobuf package of the model, which has to be an identifier
+func (c *ModelCompiler) protoPackageName() string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(c.metaData.Name)
+}
+
+// protoImportPath returns the import path of the protobuf definitions, which uses slashes whatever the OS
+func (c *ModelCompiler) protoImportPath() string {
+	return path.Join(c.metaData.GoPackage, protoDirectory)
+}
+
+// generateProto writes the protobuf definitions of the model under the proto directory, one file per package.
+// They are for export only: the plugin is not built with them, and decodes a proto encoded config as a generic
+// gnmi.Notification whose typed values are set in the Golang bindings
+func (c *ModelCompiler) generateProto(path string) error {
+	log.Infof("Generating protobuf definitions '%s'", c.outputPath(path, protoDirectory))
+
+	files, err := c.buildProto(path)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := c.writeArtifact(path, name, files[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildProto runs the ygot protobuf generator in-process on the same sources and with the same options as the
+// Golang bindings, returning the content of each file keyed by its path relative to the model directory
+func (c *ModelCompiler) buildProto(path string) (map[string][]byte, error) {
+	sources, err := c.yangSources(path)
+	if err != nil {
+		return nil, err
+	}
+	defer sources.close()
+
+	options := c.metaData.Generator
+	compressBehaviour, err := genutil.TranslateToCompressBehaviour(options.CompressPaths, options.ExcludeState, options.PreferOperationalState)
+	if err != nil {
+		return nil, err
+	}
+	packageName := c.protoPackageName()
+	cg := protogen.New(generatorName, ygen.IROptions{
+		TransformationOptions: ygen.TransformationOpts{
+			CompressBehaviour: compressBehaviour,
+			GenerateFakeRoot:  true,
+			FakeRootName:      options.FakeRootName,
+		},
+	}, protogen.ProtoOpts{
+		PackageName:         packageName,
+		BaseImportPath:      c.protoImportPath(),
+		AnnotateSchemaPaths: true,
+		AnnotateEnumNames:   true,
+		NestedMessages:      true,
+		GoPackageBase:       c.protoImportPath(),
+	})
+	code, errs := cg.Generate(sources.files(), sources.includePaths)
+	if errs != nil {
+		return nil, fmt.Errorf("unable to generate protobuf definitions: %v", errs)
+	}
+
+	files := make(map[string][]byte, len(code.Packages))
+	for _, p := range code.Packages {
+		var content bytes.Buffer
+		content.WriteString(p.Header)
+		for _, message := range p.Messages {
+			fmt.Fprintln(&content, message)
+		}
+		for _, enum := range p.Enums {
+			content.WriteString(enum)
+		}
+		file := filepath.Join(append([]string{protoDirectory}, p.FilePath...)...)
+		files[file] = insertProtoHeaderPrefix(content.Bytes(), sources.path, sources.yangBaseDirectory)
+	}
+	return files, nil
+}
+
+// insertProtoHeaderPrefix makes the YANG file and directory paths listed in the ygot header relative, as done
+// for the Golang bindings
+func insertProtoHeaderPrefix(content []byte, path string, yangBaseDirectory string) []byte {
+	header, definitions, _ := bytes.Cut(content, []byte("\nsyntax = "))
+	lines := strings.Split(string(header), "\n")
+	for i, line := range lines {
+		if value := strings.TrimLeft(strings.TrimPrefix(line, "//"), " "); strings.HasPrefix(line, "//") && strings.HasPrefix(value, "- ") {
+			prefix := line[:len(line)-len(value)+len("- ")]
+			lines[i] = prefix + relativeHeaderPath(strings.TrimPrefix(value, "- "), path, yangBaseDirectory)
+		}
+	}
+	return append([]byte(strings.Join(lines, "\n")+"\nsyntax = "), definitions...)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildProto(t *testing.T) {
+	modelPath := "../../models/devicesim-1.0.x"
+	c := loadSampleModel(t, modelPath)
+	files, err := c.buildProto(modelPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, files, "proto/devicesim/devicesim.proto")
+	assert.Contains(t, files, "proto/devicesim/enums/enums.proto")
+	assert.Contains(t, files, "proto/devicesim/openconfig_interfaces/openconfig_interfaces.proto")
+
+	root := string(files["proto/devicesim/devicesim.proto"])
+	assert.Contains(t, root, "//  - yang/openconfig-interfaces@2017-07-14.yang\n")
+	assert.Contains(t, root, "//   - yang-base\n")
+	assert.Contains(t, root, "package devicesim;")
+	assert.Contains(t, root, `option go_package = "github.com/onosproject/config-models/models/devicesim-1.0.x/proto/devicesim";`)
+	assert.Contains(t, root, "message Device {")
+	assert.Contains(t, root, `[(yext.schemapath) = "/interfaces"]`)
+
+	c.metaData.Generator = GeneratorOptions{CompressPaths: true, FakeRootName: "my-root"}
+	files, err = c.buildProto(modelPath)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(files["proto/devicesim/devicesim.proto"]), "message MyRoot {")
+	}
+}
+
+func TestGenerateProto(t *testing.T) {
+	modelPath := "../../models/testdevice-2.0.x"
+	c := loadSampleModel(t, modelPath)
+	c.SetOutputDirectory(t.TempDir())
+	c.SetDryRun(true)
+	c.metaData.GenProto = true
+	c.dictionary = c.newDictionary()
+	assert.NoError(t, c.generateProto(modelPath))
+	assert.NoError(t, c.generatePluginArtifacts(modelPath))
+
+	contents := make(map[string]string)
+	for _, artifact := range c.Artifacts() {
+		contents[artifact.File] = string(artifact.content)
+	}
+	assert.Contains(t, contents, "proto/testdevice/testdevice.proto")
+	assert.Contains(t, contents[filepath.Join("api", "model.go")], "gnmi.Encoding_JSON_IETF, gnmi.Encoding_PROTO}")
+	assert.Contains(t, contents[filepath.Join("plugin", "main.go")], "path.UnmarshalNotification(notification, schema)")
+	assert.Contains(t, contents[filepath.Join("plugin", "main.go")], "path.NotificationToJSON(notification, schema)")
+	assert.Contains(t, contents["Makefile"], "api/manifest.json proto")
+}
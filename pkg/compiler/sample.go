@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	modelpath "github.com/onosproject/config-models/pkg/path"
+)
+
+// SampleConfig returns a JSON config of the model at path which the plugin accepts: a minimal one holding the
+// mandatory nodes, the list keys and the defaults or, if full, one holding every config node
+func (c *ModelCompiler) SampleConfig(path string, full bool) ([]byte, error) {
+	if err := c.loadModelMetaData(path); err != nil {
+		return nil, err
+	}
+	schema, err := c.BuildSchema(path)
+	if err != nil {
+		return nil, err
+	}
+	return modelpath.SampleConfig(schema.SchemaTree, full)
+}
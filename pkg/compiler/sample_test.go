@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSampleConfig(t *testing.T) {
+	for _, model := range []string{"devicesim-1.0.x", "e2node-1.x", "ric-1.x", "testdevice-1.0.x", "testdevice-2.0.x"} {
+		modelPath := "../../models/" + model
+		schema := compileJSONSchema(t, modelPath)
+		for _, full := range []bool{false, true} {
+			c := NewCompiler()
+			c.SetYangBaseDirectory("../../yang-base")
+			content, err := c.SampleConfig(modelPath, full)
+			if !assert.NoError(t, err, model) {
+				continue
+			}
+			var config interface{}
+			assert.NoError(t, json.Unmarshal(content, &config), model)
+			assert.NoError(t, schema.Validate(config), model)
+		}
+	}
+
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	content, err := c.SampleConfig("../../models/ric-1.x", false)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"report_period": {"interval": 10}}`, string(content))
+}
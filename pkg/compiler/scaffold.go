@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	// initialVersion is the VERSION of a new model
+	initialVersion = "0.0.1-dev"
+	// initialModelVersion is the meta-data version of a new model whose directory name carries none
+	initialModelVersion = "1.0.x"
+	// starterTestFile is the test a new model starts with
+	starterTestFile = "api/model_test.go"
+	goModFile       = "go.mod"
+)
+
+// ScaffoldOptions are the settings of a new model; the empty ones are derived from the model directory
+type ScaffoldOptions struct {
+	// Name defaults to the base name of the directory, without any version suffix such as -1.0.x
+	Name string
+	// Version defaults to the version suffix of the directory base name, else 1.0.x
+	Version string
+	// ArtifactName defaults to the base name of the directory
+	ArtifactName string
+	// GoPackage defaults to the import path of the directory in the enclosing Go module, else the artifact name
+	GoPackage string
+	// YangFiles are copied under yang/; the modules none of the others import or include are the root modules
+	YangFiles []string
+}
+
+// yamlScalar quotes the values which YAML would not read back as the same string, such as ones holding ": "
+func yamlScalar(value string) (string, error) {
+	content, err := yaml.Marshal(value)
+	return strings.TrimSuffix(string(content), "\n"), err
+}
+
+var metaDataTemplate = template.Must(template.New(metaDataFile).Funcs(template.FuncMap{"yaml": yamlScalar}).Parse(
+	`# SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+name: {{ .Name }}
+version: {{ .Version }}
+artifactName: {{ .ArtifactName }}
+goPackage: {{ .GoPackage }}
+contactName: Open Networking Foundation
+contactUrl: https://opennetworking.org
+contactEmail: info@opennetworking.org
+licenseName: Apache-2.0
+licenseUrl: https://www.apache.org/licenses/LICENSE-2.0
+modules:
+{{- range .Modules }}
+  - name: {{ .Name }}
+    organization: {{ yaml .Organization }}
+    revision: {{ .Revision }}
+    file: {{ .YangFile }}
+{{- end }}
+`))
+
+var starterTestTemplate = template.Must(template.New(starterTestFile).Parse(
+	`// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestModelData(t *testing.T) {
+	modules := make(map[string]string)
+	for _, module := range ModelData() {
+		modules[module.Name] = module.Version
+	}
+{{- range .Modules }}
+	assert.Equal(t, "{{ .Revision }}", modules["{{ .Name }}"])
+{{- end }}
+}
+
+func TestSchema(t *testing.T) {
+	schema, err := Schema()
+	if !assert.NoError(t, err) {
+		return
+	}
+	device := &Device{}
+	assert.NoError(t, schema.Unmarshal([]byte("{}"), device))
+	assert.NoError(t, device.Validate())
+}
+`))
+
+// Scaffold lays out a new model in dir from the given YANG files: it copies them under yang/ and writes the
+// meta-data, listing the root modules with the organization and latest revision they declare, the VERSION file
+// and a starter test of the generated API. It fails if dir already holds a model
+func (c *ModelCompiler) Scaffold(dir string, options ScaffoldOptions) (*MetaData, error) {
+	if _, err := os.Stat(c.outputPath(dir, metaDataFile)); err == nil {
+		return nil, fmt.Errorf("%s already holds a model", c.outputPath(dir, ""))
+	}
+	if len(options.YangFiles) == 0 {
+		return nil, fmt.Errorf("no YANG file given")
+	}
+	m, err := scaffoldMetaData(dir, options)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]*yangModule, 0, len(options.YangFiles))
+	files := make(map[string]string)
+	for _, file := range options.YangFiles {
+		module, err := parseYangModule(file)
+		if err != nil {
+			return nil, err
+		}
+		base := filepath.Base(file)
+		if other, ok := files[base]; ok {
+			return nil, fmt.Errorf("%s and %s would both be copied to %s", other, file, filepath.Join(yang, base))
+		}
+		files[base] = file
+		modules = append(modules, module)
+	}
+	for _, module := range scaffoldRoots(modules) {
+		m.Modules = append(m.Modules, Module{
+			Name:         module.Name,
+			Revision:     module.Revision,
+			Organization: module.Organization,
+			YangFile:     filepath.Base(module.File),
+		})
+	}
+	if len(m.Modules) == 0 {
+		return nil, fmt.Errorf("no root module found: every module is imported or included by another one")
+	}
+
+	for _, base := range sortedKeys(files) {
+		content, err := os.ReadFile(files[base])
+		if err != nil {
+			return nil, err
+		}
+		if err := c.writeArtifact(dir, filepath.Join(yang, base), content, 0644); err != nil {
+			return nil, err
+		}
+	}
+	for file, tpl := range map[string]*template.Template{metaDataFile: metaDataTemplate, starterTestFile: starterTestTemplate} {
+		var content bytes.Buffer
+		if err := tpl.Execute(&content, m); err != nil {
+			return nil, err
+		}
+		if err := c.writeArtifact(dir, file, content.Bytes(), 0644); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.writeArtifact(dir, versionFile, []byte(initialVersion+"\n"), 0644); err != nil {
+		return nil, err
+	}
+	sort.Slice(c.artifacts, func(i, j int) bool {
+		return c.artifacts[i].File < c.artifacts[j].File
+	})
+	return m, nil
+}
+
+// scaffoldMetaData returns the meta-data of a new model in dir, without its modules
+func scaffoldMetaData(dir string, options ScaffoldOptions) (*MetaData, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	base := filepath.Base(absDir)
+	name, version := base, initialModelVersion
+	if i := strings.LastIndex(base, "-"); i > 0 {
+		if v := padVersion(base[i+1:]); versionRegExp.MatchString(v) {
+			name, version = base[:i], v
+		}
+	}
+
+	m := &MetaData{Name: options.Name, Version: options.Version, ArtifactName: options.ArtifactName, GoPackage: options.GoPackage}
+	if m.Name == "" {
+		m.Name = name
+	}
+	if m.Version == "" {
+		m.Version = version
+	}
+	if m.ArtifactName == "" {
+		m.ArtifactName = base
+	}
+	if m.GoPackage == "" {
+		m.GoPackage = m.ArtifactName
+		if goPackage, ok := enclosingImportPath(absDir); ok {
+			m.GoPackage = goPackage
+		}
+	}
+	if !versionRegExp.MatchString(m.Version) {
+		return nil, fmt.Errorf("version %q is not a MAJOR.MINOR.PATCH version", m.Version)
+	}
+	if !isImportPath(m.GoPackage) {
+		return nil, fmt.Errorf("goPackage %q is not a valid Go import path", m.GoPackage)
+	}
+	return m, nil
+}
+
+// padVersion completes a version such as 1.x or 2 up to MAJOR.MINOR.PATCH, with 0 for the minor and x for the
+// patch, so that both give 1.0.x or 2.0.x
+func padVersion(version string) string {
+	parts := strings.Split(version, ".")
+	switch len(parts) {
+	case 1:
+		parts = append(parts, "0", wildcardVersion)
+	case 2:
+		if parts[1] == wildcardVersion {
+			parts[1] = "0"
+		}
+		parts = append(parts, wildcardVersion)
+	}
+	return strings.Join(parts, ".")
+}
+
+// enclosingImportPath returns the import path of dir in the Go module holding it, if any
+func enclosingImportPath(dir string) (string, bool) {
+	for parent := dir; ; parent = filepath.Dir(parent) {
+		if modulePath, ok := readModulePath(filepath.Join(parent, goModFile)); ok {
+			rel, err := filepath.Rel(parent, dir)
+			if err != nil {
+				return "", false
+			}
+			if rel == "." {
+				return modulePath, true
+			}
+			return modulePath + "/" + filepath.ToSlash(rel), true
+		}
+		if filepath.Dir(parent) == parent {
+			return "", false
+		}
+	}
+}
+
+// readModulePath returns the module path declared by a go.mod file
+func readModulePath(file string) (string, bool) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), true
+		}
+	}
+	return "", false
+}
+
+// scaffoldRoots returns the modules, as opposed to submodules, that none of the others import or include,
+// sorted by name
+func scaffoldRoots(modules []*yangModule) []*yangModule {
+	used := make(map[string]bool)
+	for _, module := range modules {
+		for name := range module.Imports {
+			used[name] = true
+		}
+		for name := range module.Includes {
+			used[name] = true
+		}
+	}
+	roots := make([]*yangModule, 0)
+	for _, module := range modules {
+		if module.Keyword == "module" && !used[module.Name] {
+			roots = append(roots, module)
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return roots[i].Name < roots[j].Name
+	})
+	return roots
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScaffold(t *testing.T) {
+	workspace := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(workspace, "go.mod"), []byte("module example.com/models\n\ngo 1.19\n"), 0644))
+	yangFiles, err := filepath.Glob("../../models/testdevice-2.0.x/yang/*.yang")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(workspace, "acme-2.x")
+	c := NewCompiler()
+	m, err := c.Scaffold(modelPath, ScaffoldOptions{YangFiles: yangFiles})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "acme", m.Name)
+	assert.Equal(t, "2.0.x", m.Version)
+	assert.Equal(t, "acme-2.x", m.ArtifactName)
+	assert.Equal(t, "example.com/models/acme-2.x", m.GoPackage)
+	assert.Equal(t, []Module{{
+		Name:         "onf-test1-augmented",
+		Revision:     "2020-02-29",
+		Organization: "Open Networking Foundation.",
+		YangFile:     "onf-test1-augmented@2020-02-29.yang",
+	}}, m.Modules)
+
+	files := make([]string, 0)
+	for _, artifact := range c.Artifacts() {
+		files = append(files, artifact.File)
+	}
+	assert.Equal(t, []string{"VERSION", "api/model_test.go", "metadata.yaml", "yang/onf-test1-augmented@2020-02-29.yang",
+		"yang/onf-test1-identities@2020-09-01.yang", "yang/onf-test1@2019-06-10.yang"}, files)
+	version, err := readVersionFile(modelPath)
+	assert.NoError(t, err)
+	assert.Equal(t, initialVersion, version)
+
+	// The scaffolded model is valid and imports the other modules
+	loaded := loadSampleModel(t, modelPath)
+	assert.Equal(t, m.Modules, loaded.metaData.Modules)
+	assert.Len(t, loaded.modelInfo.ModelData, 3)
+
+	_, err = NewCompiler().Scaffold(modelPath, ScaffoldOptions{YangFiles: yangFiles})
+	assert.EqualError(t, err, modelPath+" already holds a model")
+}
+
+func TestScaffold_Options(t *testing.T) {
+	m, err := scaffoldMetaData(filepath.Join(t.TempDir(), "acme"), ScaffoldOptions{GoPackage: "github.com/acme/models/acme"})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", m.Name)
+	assert.Equal(t, initialModelVersion, m.Version)
+	assert.Equal(t, "github.com/acme/models/acme", m.GoPackage)
+
+	_, err = scaffoldMetaData(t.TempDir(), ScaffoldOptions{Version: "1.0"})
+	assert.EqualError(t, err, `version "1.0" is not a MAJOR.MINOR.PATCH version`)
+}
+
+func TestPadVersion(t *testing.T) {
+	for version, padded := range map[string]string{"2": "2.0.x", "2.x": "2.0.x", "2.1": "2.1.x", "2.1.x": "2.1.x",
+		"2.x.x": "2.x.x", "2.1.3": "2.1.3"} {
+		assert.Equal(t, padded, padVersion(version), version)
+	}
+}
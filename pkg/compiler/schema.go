@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"path/filepath"
+	"strings"
+)
+
+// LoadModelEntries reads the model meta-data and YANG files at path and returns the
+// processed entries of the root modules listed in the meta-data
+func (c *ModelCompiler) LoadModelEntries(path string) ([]*goyang.Entry, error) {
+	if err := c.loadModelMetaData(path); err != nil {
+		return nil, err
+	}
+	ms, err := c.readModules(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*goyang.Entry, 0, len(c.metaData.Modules))
+	for _, module := range c.metaData.Modules {
+		m, ok := ms.Modules[module.Name]
+		if !ok {
+			return nil, fmt.Errorf("module %s not found in %s", module.Name, module.YangFile)
+		}
+		entries = append(entries, goyang.ToEntry(m))
+	}
+	return entries, nil
+}
+
+// readModules parses and processes the root YANG modules of the model with goyang
+func (c *ModelCompiler) readModules(path string) (*goyang.Modules, error) {
+	searchPath, err := c.yangSearchPath(path)
+	if err != nil {
+		return nil, err
+	}
+	ms := goyang.NewModules()
+	ms.AddPath(searchPath...)
+	for _, module := range c.metaData.Modules {
+		if err := ms.Read(filepath.Join(path, yang, module.YangFile)); err != nil {
+			return nil, err
+		}
+	}
+	if errs := ms.Process(); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return nil, fmt.Errorf("unable to process YANG modules: %s", strings.Join(messages, "; "))
+	}
+	return ms, nil
+}
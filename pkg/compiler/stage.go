@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	goyang "github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Stage is a step of the compilation of a model, which generates some of its artifacts. Besides the built-in
+// stages, a model goes through the stages added to the compiler with AddStage and the ones registered with
+// RegisterStage that its meta-data names
+type Stage interface {
+	// Name identifies the stage in the meta-data, the logs and the cache
+	Name() string
+	// Inputs returns what the artifacts of the stage depend on, besides the YANG files of the model and the
+	// options of the stage. It is hashed as JSON, and the stage skipped when the hash did not change
+	Inputs(ctx *StageContext) (interface{}, error)
+	// Run generates the artifacts of the stage, writing them with the WriteArtifact method of ctx
+	Run(ctx *StageContext) error
+}
+
+// StageContext is the model a stage is run on
+type StageContext struct {
+	// Path is the directory of the model
+	Path string
+	// Dictionary is what the templates of the model are rendered with
+	Dictionary Dictionary
+	// Options are the options of the stage in the meta-data
+	Options map[string]string
+
+	compiler *ModelCompiler
+}
+
+// MetaData returns the meta-data of the model
+func (ctx *StageContext) MetaData() *MetaData {
+	return ctx.compiler.metaData
+}
+
+// Entries returns the entries of the root modules of the model, processed by goyang with the disabled features
+// left out and the deviations applied. They are parsed once per compilation, on the first call
+func (ctx *StageContext) Entries() ([]*goyang.Entry, error) {
+	c := ctx.compiler
+	if c.entries == nil {
+		entries, err := c.processedEntries(ctx.Path)
+		if err != nil {
+			return nil, err
+		}
+		c.entries = entries
+	}
+	return c.entries, nil
+}
+
+// Schema returns the ygot schema of the model, which the golang, openapi and jsonschema stages derive their
+// artifacts from. It is built once per compilation, on the first call
+func (ctx *StageContext) Schema() (*ytypes.Schema, error) {
+	return ctx.compiler.compiledSchema(ctx.Path)
+}
+
+// OutputPath returns where an artifact file of the model is written
+func (ctx *StageContext) OutputPath(file string) string {
+	return ctx.compiler.outputPath(ctx.Path, file)
+}
+
+// WriteArtifact records an artifact of the model, relative to the output directory, and writes it unless this is a dry run
+func (ctx *StageContext) WriteArtifact(file string, content []byte, perm os.FileMode) error {
+	return ctx.compiler.writeArtifact(ctx.Path, file, content, perm)
+}
+
+// StageConfig names a registered stage the model goes through, along with its options
+type StageConfig struct {
+	Name    string            `mapstructure:"name" yaml:"name"`
+	Options map[string]string `mapstructure:"options" yaml:"options"`
+}
+
+var (
+	registeredStagesMu sync.RWMutex
+	registeredStages   = make(map[string]Stage)
+)
+
+// RegisterStage makes a stage available to the models naming it in their meta-data. It is meant to be called
+// from init functions, and panics if the name is empty or already taken
+func RegisterStage(stage Stage) {
+	registeredStagesMu.Lock()
+	defer registeredStagesMu.Unlock()
+	name := stage.Name()
+	if name == "" {
+		panic("compiler: stage name is empty")
+	}
+	if _, ok := registeredStages[name]; ok || isBuiltinStage(name) {
+		panic(fmt.Sprintf("compiler: stage %s is already registered", name))
+	}
+	registeredStages[name] = stage
+}
+
+func registeredStage(name string) (Stage, bool) {
+	registeredStagesMu.RLock()
+	defer registeredStagesMu.RUnlock()
+	stage, ok := registeredStages[name]
+	return stage, ok
+}
+
+// AddStage adds a stage every model compiled by the compiler goes through, after the built-in stages
+func (c *ModelCompiler) AddStage(stage Stage) {
+	c.extraStages = append(c.extraStages, stage)
+}
+
+// stages returns the stages the model goes through: the built-in ones it enables, the ones added to the
+// compiler and then the registered ones named in its meta-data, in meta-data order
+func (c *ModelCompiler) stages() ([]Stage, error) {
+	stages := make([]Stage, 0, len(builtinStages)+len(c.extraStages)+len(c.metaData.Stages))
+	names := make(map[string]bool)
+	for _, stage := range builtinStages {
+		if stage.enabled == nil || stage.enabled(c.metaData) {
+			stages = append(stages, stage)
+		}
+	}
+	for _, stage := range append(append([]Stage{}, c.extraStages...), c.namedStages()...) {
+		if names[stage.Name()] || isBuiltinStage(stage.Name()) {
+			return nil, fmt.Errorf("stage %s is added more than once", stage.Name())
+		}
+		names[stage.Name()] = true
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// namedStages returns the registered stages named in the meta-data which were not added to the compiler
+func (c *ModelCompiler) namedStages() []Stage {
+	added := make(map[string]bool)
+	for _, stage := range c.extraStages {
+		added[stage.Name()] = true
+	}
+	stages := make([]Stage, 0, len(c.metaData.Stages))
+	for _, config := range c.metaData.Stages {
+		if stage, ok := registeredStage(config.Name); ok && !added[config.Name] {
+			stages = append(stages, stage)
+		}
+	}
+	return stages
+}
+
+// newStageContext returns the context the stage is run with, with its options from the meta-data
+func (c *ModelCompiler) newStageContext(path string, stage Stage) *StageContext {
+	ctx := &StageContext{Path: path, Dictionary: c.dictionary, compiler: c}
+	for _, config := range c.metaData.Stages {
+		if config.Name == stage.Name() {
+			ctx.Options = config.Options
+		}
+	}
+	return ctx
+}
+
+// builtinStage is one of the stages of every compilation, which the meta-data may disable
+type builtinStage struct {
+	name string
+	// failure describes what went wrong when the stage fails
+	failure string
+	enabled func(m *MetaData) bool
+	inputs  func(c *ModelCompiler, path string) (interface{}, error)
+	run     func(c *ModelCompiler, path string) error
+}
+
+func (s *builtinStage) Name() string {
+	return s.name
+}
+
+func (s *builtinStage) Inputs(ctx *StageContext) (interface{}, error) {
+	return s.inputs(ctx.compiler, ctx.Path)
+}
+
+func (s *builtinStage) Run(ctx *StageContext) error {
+	return s.run(ctx.compiler, ctx.Path)
+}
+
+// builtinStages are run in order, before any other stage
+var builtinStages = []*builtinStage{
+	{
+		name:    "lint",
+		failure: "YANG files contain issues",
+		enabled: func(m *MetaData) bool { return m.LintModel },
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return c.metaData.RequireHyphenated, nil
+		},
+		run: (*ModelCompiler).lintModel,
+	},
+	{
+		name:    "format",
+		failure: "YANG file formatting failed",
+		enabled: func(m *MetaData) bool { return m.FormatYang },
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.noFormat, hasPyang()}, nil
+		},
+		run: (*ModelCompiler).formatYang,
+	},
+	{
+		name:    "golang",
+		failure: "Unable to generate Golang bindings",
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return c.metaData.Generator, nil
+		},
+		run: (*ModelCompiler).generateGolangBindings,
+	},
+	{
+		name:    "proto",
+		failure: "Unable to generate protobuf definitions",
+		enabled: func(m *MetaData) bool { return m.GenProto },
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.metaData.Generator, c.metaData.Name}, nil
+		},
+		run: (*ModelCompiler).generateProto,
+	},
+	{
+		name:    "tree",
+		failure: "Unable to generate YANG model tree",
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.metaData.Name, hasPyang()}, nil
+		},
+		run: (*ModelCompiler).generateModelTree,
+	},
+	{
+		name:    "templates",
+		failure: "Unable to generate model plugin artifacts",
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			templates := make(map[string]string)
+			for _, input := range c.manifestInputs(path) {
+				if strings.HasPrefix(input, templatesPrefix+string(filepath.Separator)) || isModelTemplate(c.metaData, input) {
+					content, err := c.readInput(path, input)
+					if err != nil {
+						return nil, err
+					}
+					templates[input] = sha256Sum(content)
+				}
+			}
+			return []interface{}{c.dictionary, templates}, nil
+		},
+		run: (*ModelCompiler).generatePluginArtifacts,
+	},
+	{
+		name:    "openapi",
+		failure: "Unable to generate OpenApi specs",
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.metaData.Generator, c.openapiSettings()}, nil
+		},
+		run: (*ModelCompiler).generateOpenApi,
+	},
+	{
+		name:    "jsonschema",
+		failure: "Unable to generate JSON Schema",
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.metaData.Generator, c.metaData.Name, c.metaData.Version, c.metaData.GoPackage}, nil
+		},
+		run: (*ModelCompiler).generateJSONSchema,
+	},
+	{
+		name:    "docs",
+		failure: "Unable to generate model reference docs",
+		enabled: func(m *MetaData) bool { return m.GenDocs != "" },
+		inputs: func(c *ModelCompiler, path string) (interface{}, error) {
+			return []interface{}{c.metaData.Name, c.metaData.Version, c.metaData.GenDocs}, nil
+		},
+		run: (*ModelCompiler).generateDocs,
+	},
+}
+
+func isBuiltinStage(name string) bool {
+	for _, stage := range builtinStages {
+		if stage.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPyang tells whether pyang is installed, without which the YANG files are neither formatted nor drawn as a tree
+func hasPyang() bool {
+	_, err := exec.LookPath(pyang)
+	return err == nil
+}
+
+func isModelTemplate(m *MetaData, file string) bool {
+	for _, t := range m.Templates {
+		if t.File == file {
+			return true
+		}
+	}
+	return false
+}
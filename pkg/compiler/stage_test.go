@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// cliStage writes a command per top level node of the model, named after the command option
+type cliStage struct {
+	name string
+	runs int
+}
+
+func (s *cliStage) Name() string {
+	return s.name
+}
+
+func (s *cliStage) Inputs(ctx *StageContext) (interface{}, error) {
+	return ctx.Dictionary.Name, nil
+}
+
+func (s *cliStage) Run(ctx *StageContext) error {
+	s.runs++
+	entries, err := ctx.Entries()
+	if err != nil {
+		return err
+	}
+	if schema, err := ctx.Schema(); err != nil {
+		return err
+	} else if len(schema.SchemaTree) == 0 {
+		return fmt.Errorf("no root in the schema of %s", ctx.Dictionary.Name)
+	}
+	commands := make([]string, 0)
+	for _, entry := range entries {
+		for name := range entry.Dir {
+			commands = append(commands, fmt.Sprintf("%s %s %s", ctx.Options["command"], ctx.Dictionary.Name, name))
+		}
+	}
+	sort.Strings(commands)
+	return ctx.WriteArtifact(filepath.Join("cli", s.name+".txt"), []byte(strings.Join(commands, "\n")+"\n"), 0644)
+}
+
+var testCLIStage = &cliStage{name: "test-cli"}
+
+func init() {
+	RegisterStage(testCLIStage)
+}
+
+func TestCompile_Stages(t *testing.T) {
+	modelPath := "testdata/stages"
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetOutputDirectory(t.TempDir())
+	added := &cliStage{name: "added-cli"}
+	c.AddStage(added)
+	runs := testCLIStage.runs
+	assert.NoError(t, c.Compile(modelPath))
+	assert.Equal(t, runs+1, testCLIStage.runs)
+	assert.Equal(t, 1, added.runs)
+
+	files := make([]string, 0)
+	contents := make(map[string]string)
+	for _, artifact := range c.Artifacts() {
+		files = append(files, artifact.File)
+		contents[artifact.File] = string(artifact.content)
+	}
+	assert.Equal(t, filepath.Join("cli", "added-cli.txt"), files[len(files)-3])
+	assert.Equal(t, filepath.Join("cli", "test-cli.txt"), files[len(files)-2])
+	assert.Equal(t, manifestFile, files[len(files)-1])
+	assert.Equal(t, " stages interfaces\n stages system\n", contents[filepath.Join("cli", "added-cli.txt")])
+	assert.Equal(t, "stagectl stages interfaces\nstagectl stages system\n", contents[filepath.Join("cli", "test-cli.txt")])
+	assert.Contains(t, contents[manifestFile], `"file": "cli/test-cli.txt"`)
+
+	// Unchanged stages are skipped like the built-in ones
+	assert.NoError(t, c.Compile(modelPath))
+	assert.Equal(t, runs+1, testCLIStage.runs)
+	assert.Equal(t, 1, added.runs)
+}
+
+func TestStages_Duplicate(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	c.SetDryRun(true)
+	c.AddStage(&cliStage{name: "golang"})
+	assert.EqualError(t, c.Compile("testdata/stages"), "stage golang is added more than once")
+	assert.Panics(t, func() { RegisterStage(&cliStage{name: "test-cli"}) })
+}
+
+func TestValidateMetaData_Stages(t *testing.T) {
+	md := validMetaData()
+	md.Stages = []StageConfig{{Name: "test-cli"}, {Name: "terraform"}, {}}
+	err := ValidateMetaData("testdata/compat/v1", md)
+	assert.Error(t, err)
+	assert.Equal(t, []string{`stages[1].name "terraform" is not a registered stage`, "stages[2].name is mandatory"}, errorStrings(err.(MetaDataErrors)))
+}
@@ -5,16 +5,20 @@
 package compiler
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/onosproject/config-models/templates"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
 )
 
-func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
+// applyTemplate renders the named template into the artifact file of the model at path
+func (c *ModelCompiler) applyTemplate(path, name, file string) error {
 	var funcs template.FuncMap = map[string]interface{}{
 		"quote": func(value interface{}) string {
 			return fmt.Sprintf("\"%s\"", value)
@@ -33,31 +37,54 @@ func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
 		},
 	}
 
+	text, err := c.readTemplate(path, name)
+	if err != nil {
+		return err
+	}
 	tpl, err := template.New(name).
 		Funcs(funcs).
-		ParseFiles(tplPath)
+		Parse(text)
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Create(outPath)
-	if err != nil {
+	var content bytes.Buffer
+	if err := tpl.Execute(&content, c.dictionary); err != nil {
 		return err
 	}
-	defer file.Close()
-
-	return tpl.Execute(file, c.dictionary)
+	return c.writeArtifact(path, file, content.Bytes(), 0644)
 }
 
-func (c *ModelCompiler) getTemplatePath(name string) string {
-	return filepath.Join("templates", name)
+// readTemplate returns the model's own version of the named template if it has one, else the default
+func (c *ModelCompiler) readTemplate(path, name string) (string, error) {
+	for _, t := range c.metaData.Templates {
+		if t.TemplateName() == name {
+			text, err := os.ReadFile(filepath.Join(path, t.File))
+			return string(text), err
+		}
+	}
+	text, err := fs.ReadFile(templates.Templates, name)
+	if err != nil {
+		return "", fmt.Errorf("template %s not found: %v", name, err)
+	}
+	return string(text), nil
 }
 
-func (c *ModelCompiler) createDir(dir string) {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		log.Debugf("Creating '%s'", dir)
-		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-			log.Errorf("Creating '%s' failed: %s", dir, err)
+// generateExtraTemplates applies the templates the model adds on top of the default ones
+func (c *ModelCompiler) generateExtraTemplates(path string) error {
+	for _, t := range c.metaData.Templates {
+		if isDefaultTemplate(t.TemplateName()) {
+			continue
+		}
+		log.Infof("Generating '%s' from template '%s'", c.outputPath(path, t.Output), t.File)
+		if err := c.applyTemplate(path, t.TemplateName(), t.Output); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+func isDefaultTemplate(name string) bool {
+	_, err := fs.Stat(templates.Templates, name)
+	return err == nil
 }
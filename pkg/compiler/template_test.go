@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTemplateTestCompiler(templates ...Template) *ModelCompiler {
+	c := NewCompiler()
+	c.metaData = &MetaData{Name: "test", Version: "1.0.0", Templates: templates}
+	c.dictionary = Dictionary{Name: "test", Version: "1.0.0", GoPackage: "github.com/onosproject/test"}
+	return c
+}
+
+func TestApplyTemplate_Default(t *testing.T) {
+	c := newTemplateTestCompiler()
+	out := t.TempDir()
+	c.SetOutputDirectory(out)
+	assert.NoError(t, c.applyTemplate("testdata", gomodTemplate, "go.mod"))
+
+	content, err := os.ReadFile(filepath.Join(out, "go.mod"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "module github.com/onosproject/test")
+}
+
+func TestApplyTemplate_Override(t *testing.T) {
+	c := newTemplateTestCompiler(Template{File: "templates/Dockerfile.tpl"})
+	out := t.TempDir()
+	c.SetOutputDirectory(out)
+	assert.NoError(t, c.applyTemplate("testdata", dockerfileTemplate, "Dockerfile"))
+
+	content, err := os.ReadFile(filepath.Join(out, "Dockerfile"))
+	assert.NoError(t, err)
+	assert.Equal(t, "FROM internal/base-image\nENTRYPOINT [\"test\"]\n", string(content))
+}
+
+func TestGenerateExtraTemplates(t *testing.T) {
+	path := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "templates"), os.ModePerm))
+	tpl, err := os.ReadFile("testdata/templates/cli.go.tpl")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "templates", "cli.go.tpl"), tpl, 0644))
+
+	c := newTemplateTestCompiler(
+		Template{File: "templates/cli.go.tpl", Output: "cli/main.go"},
+		Template{Name: dockerfileTemplate, File: "templates/Dockerfile.tpl"},
+	)
+	assert.NoError(t, c.generateExtraTemplates(path))
+
+	content, err := os.ReadFile(filepath.Join(path, "cli", "main.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, "package main\n\nconst model = \"test\"\n", string(content))
+	_, err = os.Stat(filepath.Join(path, "Dockerfile"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestApplyTemplate_Missing(t *testing.T) {
+	c := newTemplateTestCompiler()
+	assert.Error(t, c.applyTemplate("testdata", "not-existing.tpl", filepath.Join(t.TempDir(), "out")))
+}
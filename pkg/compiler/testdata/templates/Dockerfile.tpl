@@ -0,0 +1,2 @@
+FROM internal/base-image
+ENTRYPOINT ["{{ .Name }}"]
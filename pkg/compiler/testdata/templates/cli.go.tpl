@@ -0,0 +1,3 @@
+package main
+
+const model = {{ .Name | quote }}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// go.mod is tidied by the model's mod-update target after generation, so it never matches the template output
+var unverifiedArtifacts = map[string]bool{
+	"go.mod": true,
+}
+
+// ArtifactDiff is a generated artifact that differs from the committed one
+type ArtifactDiff struct {
+	File string
+	Diff string
+}
+
+func (d *ArtifactDiff) String() string {
+	if d.Diff == "" {
+		return fmt.Sprintf("%s: not committed", d.File)
+	}
+	return d.Diff
+}
+
+// Verify compiles the model at path without writing anything and returns the generated
+// artifacts which differ from the ones committed in the output directory, sorted by file.
+// Every stage is run, since the cache would take the committed artifacts for generated ones.
+// The checks and artifacts left out for want of a tool are not verified; Skipped lists them
+func (c *ModelCompiler) Verify(path string) ([]*ArtifactDiff, error) {
+	dryRun, force := c.dryRun, c.force
+	c.dryRun, c.force = true, true
+	defer func() { c.dryRun, c.force = dryRun, force }()
+	if err := c.Compile(path); err != nil {
+		return nil, err
+	}
+
+	diffs := make([]*ArtifactDiff, 0)
+	for _, artifact := range c.artifacts {
+		if artifact.Status == ArtifactUnchanged || unverifiedArtifacts[artifact.File] {
+			continue
+		}
+		diff := &ArtifactDiff{File: artifact.File}
+		if artifact.Status == ArtifactChanged {
+			committed, err := os.ReadFile(c.outputPath(path, artifact.File))
+			if err != nil {
+				return nil, err
+			}
+			diff.Diff = unifiedDiff(artifact.File, committed, artifact.content)
+		}
+		diffs = append(diffs, diff)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].File < diffs[j].File
+	})
+	return diffs, nil
+}
+
+// unifiedDiff returns the unified diff between the current and the generated content of file
+func unifiedDiff(file string, current []byte, generated []byte) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(generated)),
+		FromFile: filepath.Join("a", file),
+		ToFile:   filepath.Join("b", file),
+		Context:  3,
+	})
+	if err != nil {
+		return err.Error()
+	}
+	return diff
+}
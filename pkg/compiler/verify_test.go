@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInsertHeaderPrefix(t *testing.T) {
+	content := []byte("/*\nusing the following YANG input files:\n\t- /config-model/yang/test@2020-01-01.yang\n" +
+		"Imported modules were sourced from:\n\t- /var/model-compiler/yang-base/...\n\t- /config-model/yang/sub/...\n" +
+		"\t- /elsewhere/...\n*/\npackage api\n\t- /config-model/yang/untouched\n")
+	expected := "// Code generated by YGOT. DO NOTEDIT.\n/*\nusing the following YANG input files:\n\t- yang/test@2020-01-01.yang\n" +
+		"Imported modules were sourced from:\n\t- yang-base/...\n\t- yang/sub/...\n" +
+		"\t- /elsewhere/...\n*/\npackage api\n\t- /config-model/yang/untouched\n"
+	assert.Equal(t, expected, string(insertHeaderPrefix(content, "/config-model", DefaultYangBaseDirectory)))
+}
+
+func TestVerify(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	diffs, err := c.Verify("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+	if _, err := exec.LookPath(pyang); err != nil {
+		assert.Equal(t, []string{"YANG formatting: pyang is not installed", "testdevice.tree: pyang is not installed"}, c.Skipped())
+	} else {
+		assert.Empty(t, c.Skipped())
+	}
+
+	out := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(out, "Dockerfile"), []byte("FROM scratch\n"), 0644))
+	c.SetOutputDirectory(out)
+	diffs, err = c.Verify("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+	files := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		files = append(files, diff.File)
+	}
+	assert.Equal(t, []string{"Dockerfile", "Makefile", "api/generated.go", "api/generated_test.go", "api/manifest.json", "api/model.go", "api/validate.go", openapiFile,
+		"plugin/main.go", "testdevice" + jsonSchemaSuffix}, files)
+	assert.True(t, strings.HasPrefix(diffs[0].Diff, "--- a/Dockerfile\n+++ b/Dockerfile\n"))
+	assert.Contains(t, diffs[0].Diff, "-FROM scratch\n")
+	assert.Equal(t, "Makefile: not committed", diffs[1].String())
+
+	entries, err := os.ReadDir(out)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
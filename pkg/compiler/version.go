@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VersionBump is the level of a semantic version increment
+type VersionBump int
+
+const (
+	BumpNone VersionBump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+func (b VersionBump) String() string {
+	switch b {
+	case BumpNone:
+		return "none"
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	default:
+		return "unknown"
+	}
+}
+
+const wildcardVersion = "x"
+
+var semverRegExp = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// VersionCheck is the result of comparing a model against its baseline
+type VersionCheck struct {
+	Changes  []*SchemaChange
+	Required VersionBump
+	Problems []string
+}
+
+// RequiredBump returns the smallest version bump covering all the schema changes:
+// patch for editorial changes, minor for compatible changes and major for breaking ones
+func RequiredBump(changes []*SchemaChange) VersionBump {
+	required := BumpNone
+	for _, change := range changes {
+		var bump VersionBump
+		switch change.Impact {
+		case ImpactBreaking:
+			bump = BumpMajor
+		case ImpactCompatible:
+			bump = BumpMinor
+		default:
+			bump = BumpPatch
+		}
+		if bump > required {
+			required = bump
+		}
+	}
+	return required
+}
+
+// CheckVersion compares the YANG of the model at path against the baseline model and
+// verifies that both the VERSION file and the meta-data version were bumped enough
+func (c *ModelCompiler) CheckVersion(path string, baselinePath string) (*VersionCheck, error) {
+	baseline := NewCompiler()
+	baseline.SetYangBaseDirectory(c.yangBaseDirectory)
+	oldEntries, err := baseline.LoadModelEntries(baselinePath)
+	if err != nil {
+		return nil, err
+	}
+	newEntries, err := c.LoadModelEntries(path)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &VersionCheck{Changes: CompareEntries(oldEntries, newEntries)}
+	check.Required = RequiredBump(check.Changes)
+	if check.Required == BumpNone {
+		return check, nil
+	}
+
+	oldVersion, err := readVersionFile(baselinePath)
+	if err != nil {
+		return nil, err
+	}
+	newVersion, err := readVersionFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if problem := checkSemverBump(oldVersion, newVersion, check.Required); problem != "" {
+		check.Problems = append(check.Problems, fmt.Sprintf("%s: %s", versionFile, problem))
+	}
+	if problem := checkModelVersionBump(baseline.metaData.Version, c.metaData.Version, check.Required); problem != "" {
+		check.Problems = append(check.Problems, fmt.Sprintf("metadata.yaml version: %s", problem))
+	}
+	return check, nil
+}
+
+func readVersionFile(path string) (string, error) {
+	version, err := os.ReadFile(filepath.Join(path, versionFile))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(version)), nil
+}
+
+// checkSemverBump verifies the bump between two semantic versions
+func checkSemverBump(oldVersion string, newVersion string, required VersionBump) string {
+	oldParts, err := parseSemver(oldVersion)
+	if err != nil {
+		return err.Error()
+	}
+	newParts, err := parseSemver(newVersion)
+	if err != nil {
+		return err.Error()
+	}
+	actual := BumpNone
+	for i, bump := range []VersionBump{BumpMajor, BumpMinor, BumpPatch} {
+		if newParts[i] < oldParts[i] {
+			return fmt.Sprintf("%s is lower than %s", newVersion, oldVersion)
+		} else if newParts[i] > oldParts[i] {
+			actual = bump
+			break
+		}
+	}
+	if actual < required {
+		return fmt.Sprintf("%s to %s is a %s bump but the YANG changes require a %s bump",
+			oldVersion, newVersion, actual, required)
+	}
+	return ""
+}
+
+// checkModelVersionBump verifies the bump between two model versions such as 1.0.x, where
+// x is a wildcard covering any change at that level
+func checkModelVersionBump(oldVersion string, newVersion string, required VersionBump) string {
+	oldParts, err := parseModelVersion(oldVersion)
+	if err != nil {
+		return err.Error()
+	}
+	newParts, err := parseModelVersion(newVersion)
+	if err != nil {
+		return err.Error()
+	}
+	requiredLevel := int(BumpMajor - required)
+	if oldParts[requiredLevel] == wildcardVersion {
+		return ""
+	}
+
+	for i := 0; i <= requiredLevel; i++ {
+		if oldParts[i] == newParts[i] {
+			continue
+		}
+		oldNumber, oldErr := strconv.Atoi(oldParts[i])
+		newNumber, newErr := strconv.Atoi(newParts[i])
+		if oldErr == nil && newErr == nil && newNumber < oldNumber {
+			return fmt.Sprintf("%s is lower than %s", newVersion, oldVersion)
+		}
+		return ""
+	}
+	return fmt.Sprintf("%s is unchanged at the %s level required by the YANG changes", newVersion, required)
+}
+
+func parseSemver(version string) ([3]int, error) {
+	var parts [3]int
+	matches := semverRegExp.FindStringSubmatch(version)
+	if matches == nil {
+		return parts, fmt.Errorf("%q is not a semantic version", version)
+	}
+	for i := range parts {
+		parts[i], _ = strconv.Atoi(matches[i+1])
+	}
+	return parts, nil
+}
+
+// parseModelVersion splits a version into major, minor and patch. Missing components
+// default to the wildcard if the last one given is a wildcard, otherwise to 0
+func parseModelVersion(version string) ([3]string, error) {
+	var parts [3]string
+	components := strings.Split(version, ".")
+	if version == "" || len(components) > len(parts) {
+		return parts, fmt.Errorf("%q is not a valid model version", version)
+	}
+	for i := range parts {
+		switch {
+		case i < len(components):
+			parts[i] = components[i]
+		case components[len(components)-1] == wildcardVersion:
+			parts[i] = wildcardVersion
+		default:
+			parts[i] = "0"
+		}
+		if _, err := strconv.Atoi(parts[i]); err != nil && parts[i] != wildcardVersion {
+			return parts, fmt.Errorf("%q is not a valid model version", version)
+		}
+	}
+	return parts, nil
+}
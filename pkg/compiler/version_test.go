@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRequiredBump(t *testing.T) {
+	assert.Equal(t, BumpNone, RequiredBump(nil))
+	assert.Equal(t, BumpPatch, RequiredBump([]*SchemaChange{{Impact: ImpactEditorial}}))
+	assert.Equal(t, BumpMinor, RequiredBump([]*SchemaChange{{Impact: ImpactEditorial}, {Impact: ImpactCompatible}}))
+	assert.Equal(t, BumpMajor, RequiredBump([]*SchemaChange{{Impact: ImpactBreaking}, {Impact: ImpactCompatible}}))
+}
+
+func TestCheckSemverBump(t *testing.T) {
+	assert.Empty(t, checkSemverBump("1.2.3", "2.0.0", BumpMajor))
+	assert.Empty(t, checkSemverBump("1.2.3", "1.3.0-dev", BumpMinor))
+	assert.Empty(t, checkSemverBump("1.2.3", "1.2.4", BumpPatch))
+	assert.Equal(t, "1.2.3 to 1.3.0 is a minor bump but the YANG changes require a major bump",
+		checkSemverBump("1.2.3", "1.3.0", BumpMajor))
+	assert.Equal(t, "1.2.3-dev to 1.2.3 is a none bump but the YANG changes require a patch bump",
+		checkSemverBump("1.2.3-dev", "1.2.3", BumpPatch))
+	assert.Equal(t, "1.2.2 is lower than 1.2.3", checkSemverBump("1.2.3", "1.2.2", BumpPatch))
+	assert.Equal(t, `"1.2" is not a semantic version`, checkSemverBump("1.2", "1.2.3", BumpPatch))
+
+	// 0.y.z versions need the same bumps as the others
+	assert.Empty(t, checkSemverBump("0.5.31-dev", "1.0.0-dev", BumpMajor))
+	assert.Equal(t, "0.5.31-dev to 0.6.0-dev is a minor bump but the YANG changes require a major bump",
+		checkSemverBump("0.5.31-dev", "0.6.0-dev", BumpMajor))
+	assert.Equal(t, "0.5.31-dev to 0.5.32-dev is a patch bump but the YANG changes require a minor bump",
+		checkSemverBump("0.5.31-dev", "0.5.32-dev", BumpMinor))
+}
+
+func TestCheckModelVersionBump(t *testing.T) {
+	assert.Empty(t, checkModelVersionBump("1.0.x", "1.0.x", BumpPatch))
+	assert.Empty(t, checkModelVersionBump("1.0.x", "1.1.x", BumpMinor))
+	assert.Empty(t, checkModelVersionBump("1.0.x", "2.0.x", BumpMajor))
+	assert.Empty(t, checkModelVersionBump("1.x", "1.x", BumpMinor))
+	assert.Empty(t, checkModelVersionBump("1.0.0", "1.0.1", BumpPatch))
+	assert.Equal(t, "1.0.x is unchanged at the minor level required by the YANG changes",
+		checkModelVersionBump("1.0.x", "1.0.x", BumpMinor))
+	assert.Equal(t, "1.1.x is unchanged at the major level required by the YANG changes",
+		checkModelVersionBump("1.0.x", "1.1.x", BumpMajor))
+	assert.Equal(t, "1.0.0 is lower than 1.1.0", checkModelVersionBump("1.1.0", "1.0.0", BumpMinor))
+	assert.Equal(t, `"1.a" is not a valid model version`, checkModelVersionBump("1.a", "1.0.0", BumpMinor))
+}
+
+func TestCheckVersion(t *testing.T) {
+	c := NewCompiler()
+	c.SetYangBaseDirectory("../../yang-base")
+	check, err := c.CheckVersion("testdata/compat/v2", "testdata/compat/v1")
+	assert.NoError(t, err)
+	assert.Equal(t, BumpMajor, check.Required)
+	assert.NotEmpty(t, check.Changes)
+	assert.Equal(t, []string{
+		"VERSION: 1.0.0 to 1.1.0-dev is a minor bump but the YANG changes require a major bump",
+		"metadata.yaml version: 1.0.0 is unchanged at the major level required by the YANG changes",
+	}, check.Problems)
+
+	check, err = c.CheckVersion("testdata/compat/v1", "testdata/compat/v1")
+	assert.NoError(t, err)
+	assert.Equal(t, BumpNone, check.Required)
+	assert.Empty(t, check.Problems)
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/openconfig/ygot/genutil"
+	"github.com/openconfig/ygot/gogen"
+	"github.com/openconfig/ygot/ygen"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"io"
+)
+
+const (
+	generatorName = "model-compiler"
+	apiPackage    = "api"
+	// defaultFakeRootName is the name ygot gives to the root of the data tree by default
+	defaultFakeRootName = "device"
+)
+
+// BuildSchema generates the ygot schema of the model at path, the same schema that the
+// generated Golang bindings return from api.Schema(); only the SchemaTree is populated
+func (c *ModelCompiler) BuildSchema(path string) (*ytypes.Schema, error) {
+	code, _, err := c.generateYgotCode(path)
+	if err != nil {
+		return nil, err
+	}
+	return buildSchema(code)
+}
+
+// compiledSchema returns the ygot schema of the model being compiled, which is built once per compilation and
+// shared by its stages
+func (c *ModelCompiler) compiledSchema(path string) (*ytypes.Schema, error) {
+	if c.schema == nil {
+		code, _, err := c.compiledYgotCode(path)
+		if err != nil {
+			return nil, err
+		}
+		if c.schema, err = buildSchema(code); err != nil {
+			return nil, err
+		}
+	}
+	return c.schema, nil
+}
+
+// compiledYgotCode returns the ygot Go code of the model being compiled, which is generated once per compilation
+// and shared by its stages, along with the sources it was generated from
+func (c *ModelCompiler) compiledYgotCode(path string) (*gogen.GeneratedCode, *yangSources, error) {
+	if c.ygotCode == nil {
+		code, sources, err := c.generateYgotCode(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.ygotCode, c.ygotSources = code, sources
+	}
+	return c.ygotCode, c.ygotSources, nil
+}
+
+// buildSchema unzips the schema tree of the generated code, as the generated Golang bindings do
+func buildSchema(code *gogen.GeneratedCode) (*ytypes.Schema, error) {
+	var zipped bytes.Buffer
+	writer := gzip.NewWriter(&zipped)
+	if _, err := writer.Write(code.RawJSONSchema); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	tree, err := ygot.GzipToSchema(zipped.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &ytypes.Schema{SchemaTree: tree}, nil
+}
+
+// generateYgotCode runs the ygot Go code generator in-process on the root and deviation YANG files of
+// the model, returning the code along with the sources it was generated from
+func (c *ModelCompiler) generateYgotCode(path string) (*gogen.GeneratedCode, *yangSources, error) {
+	sources, err := c.yangSources(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer sources.close()
+
+	options := c.metaData.Generator
+	compressBehaviour, err := genutil.TranslateToCompressBehaviour(options.CompressPaths, options.ExcludeState, options.PreferOperationalState)
+	if err != nil {
+		return nil, nil, err
+	}
+	cg := gogen.New(generatorName, ygen.IROptions{
+		TransformationOptions: ygen.TransformationOpts{
+			CompressBehaviour:          compressBehaviour,
+			GenerateFakeRoot:           true,
+			FakeRootName:               options.FakeRootName,
+			EnumerationsUseUnderscores: true,
+		},
+	}, gogen.GoOpts{
+		PackageName:             apiPackage,
+		GenerateJSONSchema:      true,
+		IncludeDescriptions:     true,
+		GenerateSimpleUnions:    options.SimpleUnions,
+		IgnoreShadowSchemaPaths: options.IgnoreShadowPaths,
+		YgotImportPath:          genutil.GoDefaultYgotImportPath,
+		YtypesImportPath:        genutil.GoDefaultYtypesImportPath,
+		GoyangImportPath:        genutil.GoDefaultGoyangImportPath,
+		AnnotationPrefix:        gogen.DefaultAnnotationPrefix,
+		ValidateFunctionName:    "Validate",
+	})
+	// Only the directories holding the modules the roots depend on are searched
+	code, errs := cg.Generate(sources.files(), sources.includePaths)
+	if errs != nil {
+		return nil, nil, fmt.Errorf("unable to generate ygot code: %v", errs)
+	}
+	return code, sources, nil
+}
+
+// fakeRootName returns the name of the Go struct generated for the root of the data tree
+func (c *ModelCompiler) fakeRootName() string {
+	name := c.metaData.Generator.FakeRootName
+	if name == "" {
+		name = defaultFakeRootName
+	}
+	return genutil.EntryCamelCaseName(ygen.MakeFakeRoot(name))
+}
+
+// writeGoCode writes the generated code as a single file, in the same layout as the ygot generator
+func writeGoCode(w io.Writer, code *gogen.GeneratedCode) {
+	fmt.Fprint(w, code.CommonHeader)
+	fmt.Fprint(w, code.OneOffHeader)
+	for _, snippet := range code.Structs {
+		fmt.Fprintln(w, snippet.String())
+	}
+	for _, snippet := range code.Enums {
+		fmt.Fprintln(w, snippet)
+	}
+	fmt.Fprintln(w, code.EnumMap)
+	if len(code.JSONSchemaCode) > 0 {
+		fmt.Fprintln(w, code.JSONSchemaCode)
+	}
+	if len(code.EnumTypeMap) > 0 {
+		fmt.Fprintln(w, code.EnumTypeMap)
+	}
+}
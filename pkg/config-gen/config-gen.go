@@ -0,0 +1,582 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config_gen generates random JSON configs of a model, valid ones and ones breaking a given kind of
+// constraint, to exercise the plugin validation and path extraction through property tests and Go fuzzing
+package config_gen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxElements bounds the entries of the lists and leaf-lists
+	maxElements = 3
+	// maxAttempts bounds the random configs, or values, tried before giving up
+	maxAttempts = 32
+)
+
+// ErrNotApplicable is returned when a model has no node a kind of violation can be applied to
+var ErrNotApplicable = errors.New("violation not applicable to the model")
+
+// Violation is a kind of constraint an invalid config breaks
+type Violation int
+
+const (
+	// RangeViolation sets a leaf out of the range or length of its type
+	RangeViolation Violation = iota
+	// MissingKey removes a key of a list entry
+	MissingKey
+	// DanglingLeafRef sets a leafref to a value none of its targets has
+	DanglingLeafRef
+	// FailingMust changes the leaves of a node so that one of its must statements, or of its leaves, fails
+	FailingMust
+)
+
+// Violations are all the kinds of violations
+var Violations = []Violation{RangeViolation, MissingKey, DanglingLeafRef, FailingMust}
+
+func (v Violation) String() string {
+	switch v {
+	case RangeViolation:
+		return "range violation"
+	case MissingKey:
+		return "missing key"
+	case DanglingLeafRef:
+		return "dangling leafref"
+	case FailingMust:
+		return "failing must"
+	default:
+		return fmt.Sprintf("violation %d", int(v))
+	}
+}
+
+// SchemaFunc returns a new schema of a model, such as the Schema function of its generated Golang bindings. The
+// evaluation of the must statements alters the schema tree, so every validation gets a new one
+type SchemaFunc func() (*ytypes.Schema, error)
+
+// Generator generates random configs of the model of a schema; the same seed gives the same configs
+type Generator struct {
+	newSchema SchemaFunc
+	schema    *ytypes.Schema
+	root      *yang.Entry
+	rand      *rand.Rand
+}
+
+// NewGenerator returns a generator of configs of the model whose schema newSchema returns
+func NewGenerator(newSchema SchemaFunc, seed int64) (*Generator, error) {
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil || !schema.IsValid() {
+		return nil, fmt.Errorf("the schema has no root, schema tree or unmarshal function")
+	}
+	if _, ok := schema.Root.(ygot.ValidatedGoStruct); !ok {
+		return nil, fmt.Errorf("the root %T of the schema cannot be validated", schema.Root)
+	}
+	root := path.RootEntry(schema.SchemaTree)
+	if root == nil {
+		return nil, fmt.Errorf("no root entry found in the schema")
+	}
+	return &Generator{newSchema: newSchema, schema: schema, root: root, rand: rand.New(rand.NewSource(seed))}, nil
+}
+
+// Validate validates a JSON config the way the plugin does: it unmarshals it, validates it against the schema
+// and evaluates the must statements
+func (g *Generator) Validate(config []byte) error {
+	device, err := g.unmarshal(config)
+	if err != nil {
+		return err
+	}
+	if err := device.Validate(); err != nil {
+		return err
+	}
+	return g.validateMust(device)
+}
+
+// Valid returns a random config which passes Validate. Since the must statements can only be evaluated on the
+// generated Golang bindings, the nodes with must statements are pruned from random configs until they pass,
+// falling back to the minimal sample config
+func (g *Generator) Valid() ([]byte, error) {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		config, err := g.prune(g.container(g.root))
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			return config, nil
+		}
+	}
+	config, err := path.SampleConfig(g.schema.SchemaTree, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.Validate(config); err != nil {
+		return nil, fmt.Errorf("unable to generate a valid config: %w", err)
+	}
+	return config, nil
+}
+
+// Invalid returns a config breaking a constraint of the given kind, derived from a valid config. It does not
+// check that Validate rejects it, which is up to the tests. It fails with ErrNotApplicable if none of the
+// configs drawn has a node the violation applies to
+func (g *Generator) Invalid(violation Violation) ([]byte, error) {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		valid, err := g.Valid()
+		if err != nil {
+			return nil, err
+		}
+		var config map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(valid))
+		decoder.UseNumber()
+		if err := decoder.Decode(&config); err != nil {
+			return nil, err
+		}
+		index := newIndex(g.root, config)
+		var ok bool
+		switch violation {
+		case RangeViolation:
+			ok = g.breakRange(index)
+		case MissingKey:
+			ok = g.removeKey(index)
+		case DanglingLeafRef:
+			ok = g.danglingLeafRef(index)
+		case FailingMust:
+			ok, err = g.failMust(config, index)
+		default:
+			return nil, fmt.Errorf("unknown violation %d", int(violation))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return json.Marshal(config)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotApplicable, violation)
+}
+
+// prune returns a config passing Validate, if need be after removing the containers and list entries with must
+// statements, or holding leaves with must statements, innermost first. It returns nil if the config still fails
+func (g *Generator) prune(config map[string]interface{}) ([]byte, error) {
+	content, err := json.Marshal(config)
+	if err != nil || g.Validate(content) == nil {
+		return content, err
+	}
+	objects := newIndex(g.root, config).objects
+	for i := len(objects) - 1; i >= 0; i-- {
+		object := objects[i]
+		if object.remove == nil || !hasMust(object.entry) && !holdsMust(object.entry) {
+			continue
+		}
+		object.remove()
+		g.resolveLeafRefs(newIndex(g.root, config))
+		if content, err = json.Marshal(config); err != nil || g.Validate(content) == nil {
+			return content, err
+		}
+	}
+	return nil, nil
+}
+
+// newRoot returns an empty root struct of the schema
+func (g *Generator) newRoot() ygot.ValidatedGoStruct {
+	return reflect.New(reflect.TypeOf(g.schema.Root).Elem()).Interface().(ygot.ValidatedGoStruct)
+}
+
+func (g *Generator) unmarshal(config []byte) (ygot.ValidatedGoStruct, error) {
+	device := g.newRoot()
+	if err := g.schema.Unmarshal(config, device); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// validateMust evaluates the must statements of a device on a new schema, as the plugin does
+func (g *Generator) validateMust(device ygot.ValidatedGoStruct) error {
+	schema, err := g.newSchema()
+	if err != nil {
+		return err
+	}
+	nn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
+	ynn, ok := nn.(*navigator.YangNodeNavigator)
+	if !ok {
+		return fmt.Errorf("cannot cast NodeNavigator to YangNodeNavigator")
+	}
+	return ynn.WalkAndValidateMust()
+}
+
+// chance returns true once in n times
+func (g *Generator) chance(n int) bool {
+	return g.rand.Intn(n) == 0
+}
+
+// container returns a random JSON object of a container, a list entry or the root. The optional nodes are
+// included at random, and leafrefs are then pointed at the values of their targets
+func (g *Generator) container(entry *yang.Entry) map[string]interface{} {
+	object := g.object(entry)
+	if entry == g.root {
+		g.resolveLeafRefs(newIndex(g.root, object))
+	}
+	return object
+}
+
+func (g *Generator) object(entry *yang.Entry) map[string]interface{} {
+	object := make(map[string]interface{})
+	keys := make(map[string]bool)
+	if entry.IsList() {
+		for _, key := range strings.Fields(entry.Key) {
+			keys[key] = true
+		}
+	}
+	g.addChildren(object, entry, keys)
+	return object
+}
+
+// addChildren adds random children of entry to object, choices adding the nodes of one of their cases
+func (g *Generator) addChildren(object map[string]interface{}, entry *yang.Entry, keys map[string]bool) {
+	for _, name := range path.SortedDirNames(entry) {
+		child := entry.Dir[name]
+		if child.Config == yang.TSFalse {
+			continue
+		}
+		if child.IsChoice() {
+			g.addChoice(object, child)
+			continue
+		}
+		if !keys[name] && !path.IsMandatory(child) && !child.IsContainer() && g.chance(2) {
+			continue
+		}
+		if path.IsPresence(child) && g.chance(2) {
+			continue
+		}
+		if value, ok := g.node(child); ok {
+			object[name] = value
+		}
+	}
+}
+
+func (g *Generator) addChoice(object map[string]interface{}, choice *yang.Entry) {
+	names := path.SortedDirNames(choice)
+	if len(names) == 0 || (choice.Mandatory != yang.TSTrue && g.chance(2)) {
+		return
+	}
+	c := choice.Dir[names[g.rand.Intn(len(names))]]
+	if !c.IsCase() {
+		// A shorthand case, made of a single node
+		c = &yang.Entry{Name: c.Name, Kind: yang.CaseEntry, Parent: choice, Dir: map[string]*yang.Entry{c.Name: c}}
+	}
+	g.addChildren(object, c, nil)
+}
+
+// node returns a random JSON value of a container, list, leaf or leaf-list, and false to leave it out
+func (g *Generator) node(entry *yang.Entry) (interface{}, bool) {
+	switch {
+	case entry.IsList():
+		items := make([]interface{}, 0)
+		seen := make(map[string]bool)
+		for i := g.elements(entry); i > 0; i-- {
+			item := g.object(entry)
+			keys, err := json.Marshal(listKeys(entry, item))
+			if err != nil || seen[string(keys)] {
+				continue
+			}
+			seen[string(keys)] = true
+			items = append(items, item)
+		}
+		return items, len(items) > 0
+	case entry.IsLeafList():
+		values := make([]interface{}, 0)
+		seen := make(map[string]bool)
+		for i := g.elements(entry); i > 0; i-- {
+			value, ok := g.value(entry, entry.Type)
+			if !ok || seen[fmt.Sprint(value)] {
+				continue
+			}
+			seen[fmt.Sprint(value)] = true
+			values = append(values, value)
+		}
+		return values, len(values) > 0
+	case entry.IsLeaf():
+		if defaults := path.DefaultValues(entry); len(defaults) > 0 && g.chance(4) {
+			return path.JSONValue(entry.Type, defaults[0]), true
+		}
+		return g.value(entry, entry.Type)
+	default:
+		object := g.object(entry)
+		return object, len(object) > 0 || path.IsPresence(entry)
+	}
+}
+
+// elements returns a random number of entries of a list or leaf-list, within its min-elements and max-elements
+func (g *Generator) elements(entry *yang.Entry) int {
+	lowest, highest := 1, maxElements
+	if entry.ListAttr != nil {
+		if int(entry.ListAttr.MinElements) > lowest {
+			lowest = int(entry.ListAttr.MinElements)
+		}
+		if entry.ListAttr.MaxElements > 0 && int(entry.ListAttr.MaxElements) < highest {
+			highest = int(entry.ListAttr.MaxElements)
+		}
+	}
+	if highest < lowest {
+		highest = lowest
+	}
+	return lowest + g.rand.Intn(highest-lowest+1)
+}
+
+func listKeys(entry *yang.Entry, item map[string]interface{}) []interface{} {
+	keys := make([]interface{}, 0)
+	for _, key := range strings.Fields(entry.Key) {
+		keys = append(keys, item[key])
+	}
+	return keys
+}
+
+// value returns a random JSON value of a type. The value of a leafref is one of its target type, which
+// resolveLeafRefs replaces with the value of a target in the config
+func (g *Generator) value(entry *yang.Entry, yangType *yang.YangType) (interface{}, bool) {
+	if yangType == nil {
+		return nil, false
+	}
+	switch yangType.Kind {
+	case yang.Ystring:
+		return g.stringValue(yangType)
+	case yang.Ybool:
+		return g.chance(2), true
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		n := g.number(yangType, yangType.Range)
+		return json.Number(n.String()), true
+	case yang.Yint64, yang.Yuint64:
+		// RFC 7951 encodes 64 bit integers as strings
+		return g.number(yangType, yangType.Range).String(), true
+	case yang.Ydecimal64:
+		return formatDecimal(g.number(yangType, yangType.Range), int(yangType.FractionDigits)), true
+	case yang.Yenum:
+		if yangType.Enum == nil || len(yangType.Enum.Names()) == 0 {
+			return nil, false
+		}
+		names := yangType.Enum.Names()
+		sort.Strings(names)
+		return names[g.rand.Intn(len(names))], true
+	case yang.Yidentityref:
+		if yangType.IdentityBase == nil || len(yangType.IdentityBase.Values) == 0 {
+			return nil, false
+		}
+		identities := make([]string, 0, len(yangType.IdentityBase.Values))
+		for _, identity := range yangType.IdentityBase.Values {
+			identities = append(identities, identity.Name)
+		}
+		sort.Strings(identities)
+		return identities[g.rand.Intn(len(identities))], true
+	case yang.Ybinary:
+		content := make([]byte, g.length(yangType.Length))
+		g.rand.Read(content)
+		return base64.StdEncoding.EncodeToString(content), true
+	case yang.Ybits:
+		if yangType.Bit == nil {
+			return "", true
+		}
+		bits := make([]string, 0)
+		for _, name := range yangType.Bit.Names() {
+			if g.chance(2) {
+				bits = append(bits, name)
+			}
+		}
+		return strings.Join(bits, " "), true
+	case yang.Yempty:
+		return []interface{}{nil}, true
+	case yang.Yunion:
+		for _, i := range g.rand.Perm(len(yangType.Type)) {
+			if value, ok := g.value(entry, yangType.Type[i]); ok {
+				return value, true
+			}
+		}
+		return nil, false
+	case yang.Yleafref:
+		target := path.ResolveLeafRef(entry, yangType.Path)
+		if target == nil || target == entry {
+			return nil, false
+		}
+		return g.value(target, target.Type)
+	default:
+		return nil, false
+	}
+}
+
+// builtinRanges are the ranges of the integer types, for the types whose range is not set
+var builtinRanges = map[yang.TypeKind]yang.YangRange{
+	yang.Yint8:   yang.Int8Range,
+	yang.Yint16:  yang.Int16Range,
+	yang.Yint32:  yang.Int32Range,
+	yang.Yint64:  yang.Int64Range,
+	yang.Yuint8:  yang.Uint8Range,
+	yang.Yuint16: yang.Uint16Range,
+	yang.Yuint32: yang.Uint32Range,
+	yang.Yuint64: yang.Uint64Range,
+}
+
+// number returns a random number within the ranges, scaled by the fraction digits of the type; the bounds of
+// the ranges come up more often than the other values
+func (g *Generator) number(yangType *yang.YangType, ranges yang.YangRange) *big.Int {
+	digits := int(yangType.FractionDigits)
+	if len(ranges) == 0 {
+		ranges = builtinRanges[yangType.Kind]
+	}
+	if len(ranges) == 0 {
+		return big.NewInt(0)
+	}
+	r := ranges[g.rand.Intn(len(ranges))]
+	lowest, highest := scaled(r.Min, digits), scaled(r.Max, digits)
+	switch g.rand.Intn(4) {
+	case 0:
+		return lowest
+	case 1:
+		return highest
+	}
+	span := new(big.Int).Sub(highest, lowest)
+	span.Add(span, big.NewInt(1))
+	return span.Add(lowest, new(big.Int).Rand(g.rand, span))
+}
+
+// scaled returns a number as an integer with the given number of fraction digits
+func scaled(n yang.Number, digits int) *big.Int {
+	value := new(big.Int).SetUint64(n.Value)
+	if shift := digits - int(n.FractionDigits); shift > 0 {
+		value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil))
+	} else if shift < 0 {
+		value.Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-shift)), nil))
+	}
+	if n.Negative {
+		value.Neg(value)
+	}
+	return value
+}
+
+// formatDecimal formats an integer scaled by the fraction digits as a decimal64 value
+func formatDecimal(value *big.Int, digits int) string {
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	abs := new(big.Int).Abs(value).String()
+	if digits == 0 {
+		return sign + abs
+	}
+	if len(abs) <= digits {
+		abs = strings.Repeat("0", digits-len(abs)+1) + abs
+	}
+	return sign + abs[:len(abs)-digits] + "." + abs[len(abs)-digits:]
+}
+
+// length returns a random length within the length ranges, not too long to stay readable
+func (g *Generator) length(ranges yang.YangRange) int {
+	if len(ranges) == 0 {
+		return 1 + g.rand.Intn(12)
+	}
+	r := ranges[g.rand.Intn(len(ranges))]
+	lowest, highest := r.Min.Value, r.Max.Value
+	if highest > lowest+16 {
+		highest = lowest + 16
+	}
+	return int(lowest) + g.rand.Intn(int(highest-lowest)+1)
+}
+
+// stringValue returns a random string satisfying the lengths and patterns of the type
+func (g *Generator) stringValue(yangType *yang.YangType) (string, bool) {
+	patterns, isPOSIX := util.SanitizedPattern(yangType)
+	flags := syntax.Perl
+	if isPOSIX {
+		flags = syntax.POSIX
+	}
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	parsed := make([]*syntax.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		r, err := regexp.Compile(pattern)
+		if isPOSIX {
+			r, err = regexp.CompilePOSIX(pattern)
+		}
+		if err != nil {
+			return "", false
+		}
+		re, err := syntax.Parse(pattern, flags)
+		if err != nil {
+			return "", false
+		}
+		regexps = append(regexps, r)
+		parsed = append(parsed, re.Simplify())
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var sb strings.Builder
+		if len(parsed) == 0 {
+			for i := g.length(yangType.Length); i > 0; i-- {
+				sb.WriteByte(alphabet[g.rand.Intn(len(alphabet))])
+			}
+		} else if !path.GenerateString(&sb, parsed[g.rand.Intn(len(parsed))], 0, g.rand.Intn) {
+			continue
+		}
+		value := sb.String()
+		if !path.LengthAllowed(yangType.Length, utf8.RuneCountInString(value)) {
+			continue
+		}
+		matches := true
+		for _, r := range regexps {
+			matches = matches && r.MatchString(value)
+		}
+		if matches {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// alphabet holds the characters of the strings without patterns
+const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_."
+
+// resolveLeafRefs sets every leafref of the config to the value of one of its targets, and removes the ones
+// without any target
+func (g *Generator) resolveLeafRefs(index *index) {
+	for _, leaf := range index.leaves {
+		target := leafRefTarget(leaf.entry)
+		if target == nil {
+			continue
+		}
+		values := index.values(target)
+		if len(values) == 0 {
+			leaf.remove()
+			continue
+		}
+		leaf.set(values[g.rand.Intn(len(values))])
+	}
+}
+
+func leafRefTarget(entry *yang.Entry) *yang.Entry {
+	if entry.Type == nil || entry.Type.Kind != yang.Yleafref {
+		return nil
+	}
+	target := path.ResolveLeafRef(entry, entry.Type.Path)
+	if target == nil || target == entry {
+		return nil
+	}
+	return target
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_gen
+
+import (
+	"github.com/onosproject/config-models/pkg/internal/testdevice"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func testdeviceGenerator(t *testing.T, seed int64) *Generator {
+	g, err := NewGenerator(testdevice.Schema, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestGenerator_Valid(t *testing.T) {
+	for seed := int64(0); seed < 16; seed++ {
+		g := testdeviceGenerator(t, seed)
+		config, err := g.Valid()
+		if assert.NoError(t, err) {
+			assert.NoError(t, g.Validate(config), string(config))
+		}
+	}
+
+	// The same seed gives the same configs
+	first, err := testdeviceGenerator(t, 1).Valid()
+	assert.NoError(t, err)
+	second, err := testdeviceGenerator(t, 1).Valid()
+	assert.NoError(t, err)
+	assert.Equal(t, string(first), string(second))
+}
+
+func TestGenerator_Invalid(t *testing.T) {
+	for _, violation := range Violations {
+		for seed := int64(0); seed < 8; seed++ {
+			g := testdeviceGenerator(t, seed)
+			config, err := g.Invalid(violation)
+			if assert.NoError(t, err, violation.String()) {
+				assert.Error(t, g.Validate(config), "%s: %s", violation, config)
+			}
+		}
+	}
+
+	_, err := testdeviceGenerator(t, 0).Invalid(Violation(-1))
+	assert.EqualError(t, err, "unknown violation -1")
+}
+
+func TestGenerator_NotApplicable(t *testing.T) {
+	schema, err := testdevice.Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A model made of a single unrestricted leaf has nothing to break
+	root := &yang.Entry{Name: "device", Kind: yang.DirectoryEntry, Annotation: map[string]interface{}{"isFakeRoot": true}}
+	root.Dir = map[string]*yang.Entry{"leaf-at-top-level": {Name: "leaf-at-top-level", Kind: yang.LeafEntry, Parent: root,
+		Type: &yang.YangType{Kind: yang.Ystring}}}
+	schema.SchemaTree = map[string]*yang.Entry{"Device": root}
+	g, err := NewGenerator(func() (*ytypes.Schema, error) {
+		return schema, nil
+	}, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = g.Invalid(MissingKey)
+	assert.ErrorIs(t, err, ErrNotApplicable)
+}
+
+func Test_formatDecimal(t *testing.T) {
+	assert.Equal(t, "1.50", formatDecimal(big.NewInt(150), 2))
+	assert.Equal(t, "-0.001", formatDecimal(big.NewInt(-1), 3))
+	assert.Equal(t, "0.000", formatDecimal(big.NewInt(0), 3))
+	assert.Equal(t, "42", formatDecimal(big.NewInt(42), 0))
+}
+
+func Test_outOfRange(t *testing.T) {
+	value, ok := outOfRange(&yang.YangType{Kind: yang.Yuint8, Range: yang.YangRange{{Min: yang.FromInt(0), Max: yang.FromInt(10)}}})
+	assert.True(t, ok)
+	assert.EqualValues(t, "11", value)
+
+	value, ok = outOfRange(&yang.YangType{Kind: yang.Yint64, Range: yang.YangRange{{Min: yang.FromInt(-5), Max: yang.FromInt(5)}}})
+	assert.True(t, ok)
+	assert.Equal(t, "-6", value)
+
+	_, ok = outOfRange(&yang.YangType{Kind: yang.Yuint8, Range: yang.Uint8Range})
+	assert.False(t, ok)
+
+	value, ok = outOfRange(&yang.YangType{Kind: yang.Ystring, Length: yang.YangRange{{Min: yang.FromInt(2), Max: yang.FromInt(4)}}})
+	assert.True(t, ok)
+	assert.Equal(t, "x", value)
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_gen
+
+import (
+	"errors"
+	"github.com/onosproject/config-models/pkg/path"
+	"testing"
+)
+
+const (
+	// fuzzSeed seeds the generator of the seed corpus, so that it does not change from one run to the next
+	fuzzSeed = 1
+	// fuzzValidConfigs is the number of valid configs in the seed corpus, along with an invalid config of
+	// every kind of violation the model allows
+	fuzzValidConfigs = 8
+)
+
+// AddSeeds adds count valid configs, and an invalid config of every kind of violation the model allows, to the
+// seed corpus of a fuzz test
+func (g *Generator) AddSeeds(f *testing.F, count int) {
+	f.Helper()
+	for i := 0; i < count; i++ {
+		config, err := g.Valid()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(config)
+	}
+	for _, violation := range Violations {
+		config, err := g.Invalid(violation)
+		if errors.Is(err, ErrNotApplicable) {
+			continue
+		} else if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(config)
+	}
+}
+
+func fuzzGenerator(f *testing.F, newSchema SchemaFunc) *Generator {
+	f.Helper()
+	g, err := NewGenerator(newSchema, fuzzSeed)
+	if err != nil {
+		f.Fatal(err)
+	}
+	g.AddSeeds(f, fuzzValidConfigs)
+	return g
+}
+
+// FuzzUnmarshal fuzzes the generated Unmarshal of a model with JSON configs, seeded with generated ones. The
+// configs it accepts are validated against the schema. It is called from a fuzz test of the model:
+//
+//	func FuzzUnmarshal(f *testing.F) {
+//		config_gen.FuzzUnmarshal(f, Schema)
+//	}
+func FuzzUnmarshal(f *testing.F, newSchema SchemaFunc) {
+	g := fuzzGenerator(f, newSchema)
+	f.Fuzz(func(t *testing.T, config []byte) {
+		if device, err := g.unmarshal(config); err == nil {
+			_ = device.Validate()
+		}
+	})
+}
+
+// FuzzWalkAndValidateMust fuzzes the evaluation of the must statements of a model, by navigator.WalkAndValidateMust,
+// on the JSON configs the generated Unmarshal accepts, seeded with generated ones
+func FuzzWalkAndValidateMust(f *testing.F, newSchema SchemaFunc) {
+	g := fuzzGenerator(f, newSchema)
+	f.Fuzz(func(t *testing.T, config []byte) {
+		if device, err := g.unmarshal(config); err == nil {
+			_ = g.validateMust(device)
+		}
+	})
+}
+
+// FuzzGetPathValues fuzzes path.GetPathValues with the paths of a model and JSON configs, seeded with generated ones
+func FuzzGetPathValues(f *testing.F, newSchema SchemaFunc) {
+	g := fuzzGenerator(f, newSchema)
+	path.ExtractPaths(g.schema.SchemaTree)
+	f.Fuzz(func(t *testing.T, config []byte) {
+		_, _ = path.GetPathValues("", config)
+	})
+}
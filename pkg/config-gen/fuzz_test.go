@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_gen
+
+import (
+	"github.com/onosproject/config-models/pkg/internal/testdevice"
+	"testing"
+)
+
+func FuzzTestdeviceUnmarshal(f *testing.F) {
+	FuzzUnmarshal(f, testdevice.Schema)
+}
+
+func FuzzTestdeviceWalkAndValidateMust(f *testing.F) {
+	FuzzWalkAndValidateMust(f, testdevice.Schema)
+}
+
+func FuzzTestdeviceGetPathValues(f *testing.F) {
+	FuzzGetPathValues(f, testdevice.Schema)
+}
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/openconfig/goyang/pkg/yang"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// index lists the nodes of a JSON config along with their schema entries
+type index struct {
+	leaves  []*leafInstance
+	objects []*objectInstance
+}
+
+// leafInstance is a leaf, or a value of a leaf-list, of a config
+type leafInstance struct {
+	entry  *yang.Entry
+	parent map[string]interface{}
+	// owner is the container or list entry holding the leaf
+	owner *objectInstance
+	name  string
+	// position is the position of the value in a leaf-list, else -1
+	position int
+	isKey    bool
+}
+
+// objectInstance is a container or a list entry of a config
+type objectInstance struct {
+	entry  *yang.Entry
+	object map[string]interface{}
+	// remove removes the container or list entry from the config; it is nil for the root
+	remove func()
+}
+
+func newIndex(root *yang.Entry, config map[string]interface{}) *index {
+	i := &index{}
+	i.add(root, config, nil)
+	return i
+}
+
+func (i *index) add(entry *yang.Entry, object map[string]interface{}, remove func()) {
+	owner := &objectInstance{entry: entry, object: object, remove: remove}
+	i.objects = append(i.objects, owner)
+	keys := make(map[string]bool)
+	if entry.IsList() {
+		for _, key := range strings.Fields(entry.Key) {
+			keys[key] = true
+		}
+	}
+	children := path.DataChildren(entry)
+	for _, name := range sortedKeys(object) {
+		child, ok := children[name]
+		if !ok {
+			continue
+		}
+		switch value := object[name].(type) {
+		case map[string]interface{}:
+			name := name
+			i.add(child, value, func() {
+				delete(object, name)
+			})
+		case []interface{}:
+			for position, item := range value {
+				if child.IsLeafList() {
+					i.leaves = append(i.leaves, &leafInstance{entry: child, parent: object, owner: owner, name: name,
+						position: position})
+				} else if itemObject, ok := item.(map[string]interface{}); ok {
+					i.add(child, itemObject, removeItem(object, name, itemObject))
+				}
+			}
+		default:
+			i.leaves = append(i.leaves, &leafInstance{entry: child, parent: object, owner: owner, name: name,
+				position: -1, isKey: keys[name]})
+		}
+	}
+}
+
+// removeItem returns a function removing an entry from a list of object, and the list once empty
+func removeItem(object map[string]interface{}, name string, item map[string]interface{}) func() {
+	return func() {
+		items, _ := object[name].([]interface{})
+		kept := make([]interface{}, 0, len(items))
+		for _, other := range items {
+			if otherObject, ok := other.(map[string]interface{}); !ok || reflect.ValueOf(otherObject).Pointer() != reflect.ValueOf(item).Pointer() {
+				kept = append(kept, other)
+			}
+		}
+		if len(kept) == 0 {
+			delete(object, name)
+		} else {
+			object[name] = kept
+		}
+	}
+}
+
+// values returns the values an entry has in the config
+func (i *index) values(entry *yang.Entry) []interface{} {
+	values := make([]interface{}, 0)
+	for _, leaf := range i.leaves {
+		if leaf.entry == entry {
+			values = append(values, leaf.get())
+		}
+	}
+	return values
+}
+
+func (l *leafInstance) get() interface{} {
+	if l.position < 0 {
+		return l.parent[l.name]
+	}
+	return l.parent[l.name].([]interface{})[l.position]
+}
+
+func (l *leafInstance) set(value interface{}) {
+	if l.position < 0 {
+		l.parent[l.name] = value
+		return
+	}
+	l.parent[l.name].([]interface{})[l.position] = value
+}
+
+// remove removes a leaf, or a whole leaf-list
+func (l *leafInstance) remove() {
+	delete(l.parent, l.name)
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// breakRange sets a numeric leaf of the config out of the range of its type, or a string or binary leaf out of
+// its lengths
+func (g *Generator) breakRange(index *index) bool {
+	candidates := make([]*leafInstance, 0)
+	for _, leaf := range index.leaves {
+		if _, ok := outOfRange(leaf.entry.Type); ok {
+			candidates = append(candidates, leaf)
+		}
+	}
+	if len(candidates) == 0 {
+		return false
+	}
+	leaf := candidates[g.rand.Intn(len(candidates))]
+	value, _ := outOfRange(leaf.entry.Type)
+	leaf.set(value)
+	return true
+}
+
+// outOfRange returns a JSON value of a type just outside its range or length, if the type restricts them
+func outOfRange(yangType *yang.YangType) (interface{}, bool) {
+	if yangType == nil {
+		return nil, false
+	}
+	switch yangType.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yint64, yang.Yuint64,
+		yang.Ydecimal64:
+		digits := int(yangType.FractionDigits)
+		if len(yangType.Range) == 0 {
+			return nil, false
+		}
+		candidates := []*big.Int{
+			new(big.Int).Sub(scaled(yangType.Range[0].Min, digits), big.NewInt(1)),
+			new(big.Int).Add(scaled(yangType.Range[len(yangType.Range)-1].Max, digits), big.NewInt(1)),
+		}
+		for _, candidate := range candidates {
+			if inRanges(candidate, yangType.Range, digits) || !inBuiltinRange(candidate, yangType.Kind) {
+				continue
+			}
+			switch yangType.Kind {
+			case yang.Yint64, yang.Yuint64:
+				return candidate.String(), true
+			case yang.Ydecimal64:
+				return formatDecimal(candidate, digits), true
+			default:
+				return json.Number(candidate.String()), true
+			}
+		}
+	case yang.Ystring:
+		if len(yangType.Length) == 0 {
+			return nil, false
+		}
+		if lowest := yangType.Length[0].Min.Value; lowest > 0 {
+			return strings.Repeat("x", int(lowest)-1), true
+		}
+		if highest := yangType.Length[len(yangType.Length)-1].Max; highest.Value < 1024 {
+			return strings.Repeat("x", int(highest.Value)+1), true
+		}
+	}
+	return nil, false
+}
+
+func inRanges(n *big.Int, ranges yang.YangRange, digits int) bool {
+	for _, r := range ranges {
+		if scaled(r.Min, digits).Cmp(n) <= 0 && n.Cmp(scaled(r.Max, digits)) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// inBuiltinRange tells whether a value can be encoded by an integer type; any decimal64 value can
+func inBuiltinRange(n *big.Int, kind yang.TypeKind) bool {
+	ranges, ok := builtinRanges[kind]
+	return !ok || inRanges(n, ranges, 0)
+}
+
+// removeKey removes a key of an entry of a list of the config
+func (g *Generator) removeKey(index *index) bool {
+	candidates := make([]*leafInstance, 0)
+	for _, leaf := range index.leaves {
+		if leaf.isKey {
+			candidates = append(candidates, leaf)
+		}
+	}
+	if len(candidates) == 0 {
+		return false
+	}
+	candidates[g.rand.Intn(len(candidates))].remove()
+	return true
+}
+
+// danglingLeafRef sets a leafref of the config to a value of its target type which no target has
+func (g *Generator) danglingLeafRef(index *index) bool {
+	candidates := make([]*leafInstance, 0)
+	for _, leaf := range index.leaves {
+		if leafRefTarget(leaf.entry) != nil {
+			candidates = append(candidates, leaf)
+		}
+	}
+	if len(candidates) == 0 {
+		return false
+	}
+	leaf := candidates[g.rand.Intn(len(candidates))]
+	target := leafRefTarget(leaf.entry)
+	existing := make(map[string]bool)
+	for _, value := range index.values(target) {
+		existing[fmt.Sprint(value)] = true
+	}
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if value, ok := g.value(target, target.Type); ok && !existing[fmt.Sprint(value)] {
+			leaf.set(value)
+			return true
+		}
+	}
+	return false
+}
+
+// failMust sets random values to the leaves of a node of the config which has must statements, or whose leaves
+// have, until the config passes the schema validation but fails the must statements. The nodes are tried in a
+// random order, since the values of some of them cannot fail their must statements
+func (g *Generator) failMust(config map[string]interface{}, index *index) (bool, error) {
+	candidates := make([]*objectInstance, 0)
+	for _, object := range index.objects {
+		if hasMust(object.entry) || holdsMust(object.entry) {
+			candidates = append(candidates, object)
+		}
+	}
+	for _, i := range g.rand.Perm(len(candidates)) {
+		failed, err := g.failObjectMust(config, candidates[i], index)
+		if failed || err != nil {
+			return failed, err
+		}
+	}
+	return false, nil
+}
+
+// failObjectMust sets random values to the leaves of object until the config fails the must statements
+func (g *Generator) failObjectMust(config map[string]interface{}, object *objectInstance, index *index) (bool, error) {
+	leaves := make([]*leafInstance, 0)
+	for _, leaf := range index.leaves {
+		if leaf.owner == object && !leaf.isKey && leafRefTarget(leaf.entry) == nil {
+			leaves = append(leaves, leaf)
+		}
+	}
+	if len(leaves) == 0 {
+		return false, nil
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		for _, leaf := range leaves {
+			if value, ok := g.value(leaf.entry, leaf.entry.Type); ok && g.chance(2) {
+				leaf.set(value)
+			}
+		}
+		content, err := json.Marshal(config)
+		if err != nil {
+			return false, err
+		}
+		device, err := g.unmarshal(content)
+		if err != nil || device.Validate() != nil {
+			continue
+		}
+		if g.validateMust(device) != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func hasMust(entry *yang.Entry) bool {
+	return len(entry.Extra["must"]) > 0
+}
+
+// holdsMust tells whether a leaf or leaf-list of a container or list has must statements
+func holdsMust(entry *yang.Entry) bool {
+	for _, child := range path.DataChildren(entry) {
+		if (child.IsLeaf() || child.IsLeafList()) && hasMust(child) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,622 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docs_gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/openconfig/goyang/pkg/yang"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Format is the format the reference pages are rendered in
+type Format string
+
+const (
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+)
+
+// Formats are the supported formats
+var Formats = []Format{Markdown, HTML}
+
+// Extension returns the file extension of the pages rendered in format
+func (f Format) Extension() string {
+	if f == HTML {
+		return ".html"
+	}
+	return ".md"
+}
+
+// sentenceEndRegExp matches the end of a sentence within a description
+var sentenceEndRegExp = regexp.MustCompile(`\. [A-Z]`)
+
+const (
+	indexPage      = "index"
+	identitiesPage = "identities"
+	leafSelection  = "leaf-selection"
+)
+
+type DocsGenSettings struct {
+	ModelType    string
+	ModelVersion string
+	Title        string
+	Format       Format
+}
+
+// ApplyDefaults fills in the title from the model type and version and renders Markdown by default
+func (settings *DocsGenSettings) ApplyDefaults() {
+	if settings.Title == "" {
+		settings.Title = fmt.Sprintf("%s-%s", settings.ModelType, settings.ModelVersion)
+	}
+	if settings.Format == "" {
+		settings.Format = Markdown
+	}
+}
+
+// BuildDocs renders the reference pages of the data tree of the given module entries: an index page for the
+// root of the tree, one page per container and list and a page of the identities the identityref leaves refer
+// to. The pages link to each other, and are returned keyed by file name
+func BuildDocs(modules []*yang.Entry, settings *DocsGenSettings) (map[string][]byte, error) {
+	settings.ApplyDefaults()
+	render, err := newRenderer(settings.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	// The top level nodes of every module are hung under a single root, against which absolute leafref
+	// paths are resolved
+	root := &yang.Entry{Name: settings.Title, Kind: yang.DirectoryEntry, Dir: make(map[string]*yang.Entry)}
+	b := &builder{root: root, ext: settings.Format.Extension(), identities: make(map[string]*yang.Identity)}
+	index := &page{
+		File:        indexPage + b.ext,
+		Title:       settings.Title,
+		Description: fmt.Sprintf("Reference of the data tree of the %s %s config model.", settings.ModelType, settings.ModelVersion),
+	}
+	moduleNames := make([]fragment, 0, len(modules))
+	for _, module := range modules {
+		moduleNames = append(moduleNames, fragment{Text: module.Name, Code: true})
+		for name, child := range module.Dir {
+			if child.RPC != nil || child.Kind == yang.NotificationEntry {
+				continue
+			}
+			if _, ok := root.Dir[name]; ok {
+				return nil, fmt.Errorf("%s is defined by more than one module", name)
+			}
+			child.Parent = root
+			root.Dir[name] = child
+		}
+	}
+	index.Properties = append(index.Properties, property{Name: "Modules", Values: moduleNames})
+
+	pages := []*page{index}
+	index.Children = b.children(root)
+	for _, leaf := range leaves(root) {
+		index.Sections = append(index.Sections, b.leaf(leaf))
+	}
+	b.walk(root, &pages)
+	if len(b.identities) > 0 {
+		pages = append(pages, b.identitiesPage())
+	}
+
+	docs := make(map[string][]byte, len(pages))
+	for _, p := range pages {
+		var content bytes.Buffer
+		if err := render(&content, p); err != nil {
+			return nil, fmt.Errorf("unable to render %s: %v", p.File, err)
+		}
+		docs[p.File] = content.Bytes()
+	}
+	return docs, nil
+}
+
+// page is the content of one page, independently of the format it is rendered in
+type page struct {
+	File        string
+	Title       string
+	Breadcrumbs []fragment
+	Description string
+	Properties  []property
+	Children    []child
+	Sections    []section
+}
+
+// fragment is a piece of text, rendered as code and linked when asked to
+type fragment struct {
+	Text string
+	Code bool
+	Link string
+}
+
+type property struct {
+	Name   string
+	Values []fragment
+}
+
+// child is a row of the table of the children of a page
+type child struct {
+	Name    fragment
+	Kind    string
+	Type    []fragment
+	Config  string
+	Summary string
+}
+
+// section details a leaf, or an identity on the identities page
+type section struct {
+	Anchor      string
+	Title       string
+	Description string
+	Properties  []property
+}
+
+type builder struct {
+	root *yang.Entry
+	ext  string
+	// identities are the identities referred to by identityref leaves, keyed by prefixed name
+	identities map[string]*yang.Identity
+}
+
+// walk adds the pages of the containers and lists under entry
+func (b *builder) walk(entry *yang.Entry, pages *[]*page) {
+	for _, child := range dataChildren(entry) {
+		if child.IsDir() {
+			*pages = append(*pages, b.page(child))
+			b.walk(child, pages)
+		}
+	}
+}
+
+// page returns the page of a container or list
+func (b *builder) page(entry *yang.Entry) *page {
+	p := &page{
+		File:        b.file(entry),
+		Title:       schemaPath(entry),
+		Breadcrumbs: b.breadcrumbs(entry),
+		Description: entry.Description,
+		Children:    b.children(entry),
+	}
+	kind := "container"
+	if entry.IsList() {
+		kind = "list"
+	}
+	p.Properties = append(p.Properties, textProperty("Kind", kind), textProperty("Config", config(entry)))
+	if entry.IsList() {
+		keys := make([]fragment, 0)
+		for _, key := range strings.Fields(entry.Key) {
+			keys = append(keys, fragment{Text: key, Code: true, Link: "#" + key})
+		}
+		p.Properties = append(p.Properties, property{Name: "Keys", Values: keys})
+	}
+	if presence := extraValues(entry, "presence"); len(presence) > 0 {
+		p.Properties = append(p.Properties, textProperty("Presence", presence[0]))
+	}
+	p.Properties = append(p.Properties, listProperties(entry)...)
+	p.Properties = append(p.Properties, conditionProperties(entry)...)
+	p.Properties = append(p.Properties, extensionProperties(entry)...)
+
+	for _, child := range leaves(entry) {
+		p.Sections = append(p.Sections, b.leaf(child))
+	}
+	return p
+}
+
+// children returns the table of the children of entry, the children of its choices included
+func (b *builder) children(entry *yang.Entry) []child {
+	children := make([]child, 0)
+	for _, c := range dataChildren(entry) {
+		row := child{Name: fragment{Text: c.Name, Code: true}, Config: config(c), Summary: summary(c.Description)}
+		switch {
+		case c.IsList():
+			row.Kind = "list"
+			row.Name.Link = b.file(c)
+		case c.IsDir():
+			row.Kind = "container"
+			row.Name.Link = b.file(c)
+		case c.IsLeafList():
+			row.Kind = "leaf-list"
+			row.Name.Link = b.anchor(c)
+			row.Type = b.typeName(c, c.Type)
+		default:
+			row.Kind = "leaf"
+			row.Name.Link = b.anchor(c)
+			row.Type = b.typeName(c, c.Type)
+		}
+		if choice, ok := choiceCase(c); ok {
+			row.Kind += " (" + choice + ")"
+		}
+		children = append(children, row)
+	}
+	return children
+}
+
+// leaf returns the section detailing a leaf or leaf-list
+func (b *builder) leaf(entry *yang.Entry) section {
+	s := section{Anchor: entry.Name, Title: entry.Name, Description: entry.Description}
+	kind := "leaf"
+	if entry.IsLeafList() {
+		kind = "leaf-list"
+	}
+	s.Properties = append(s.Properties,
+		textProperty("Kind", kind),
+		property{Name: "Type", Values: b.typeName(entry, entry.Type)},
+		textProperty("Config", config(entry)))
+	if entry.Mandatory == yang.TSTrue || isKey(entry) {
+		s.Properties = append(s.Properties, textProperty("Mandatory", "true"))
+	}
+	if defaults := path.DefaultValues(entry); len(defaults) > 0 {
+		s.Properties = append(s.Properties, codeProperty("Default", defaults...))
+	}
+	if units := units(entry); units != "" {
+		s.Properties = append(s.Properties, textProperty("Units", units))
+	}
+	if constraints := typeConstraints(entry.Type); len(constraints) > 0 {
+		s.Properties = append(s.Properties, property{Name: "Constraints", Values: constraints})
+	}
+	if choice, ok := choiceCase(entry); ok {
+		s.Properties = append(s.Properties, textProperty("Choice", choice))
+	}
+	s.Properties = append(s.Properties, listProperties(entry)...)
+	s.Properties = append(s.Properties, conditionProperties(entry)...)
+	s.Properties = append(s.Properties, extensionProperties(entry)...)
+	return s
+}
+
+// typeName returns the name of the type of a leaf, linked to the leaf a leafref refers to and to the base
+// identity of an identityref
+func (b *builder) typeName(entry *yang.Entry, yangType *yang.YangType) []fragment {
+	if yangType == nil {
+		return nil
+	}
+	switch yangType.Kind {
+	case yang.Yleafref:
+		ref := fragment{Text: yangType.Path, Code: true}
+		if target := path.ResolveLeafRef(entry, yangType.Path); target != nil && target != entry {
+			ref.Link = b.anchor(target)
+		}
+		return []fragment{{Text: "leafref to"}, ref}
+	case yang.Yidentityref:
+		if yangType.IdentityBase == nil {
+			return []fragment{{Text: "identityref"}}
+		}
+		name := yangType.IdentityBase.PrefixedName()
+		b.identities[name] = yangType.IdentityBase
+		return []fragment{{Text: "identityref of"}, {Text: name, Code: true, Link: identitiesPage + b.ext + "#" + identityAnchor(name)}}
+	case yang.Yunion:
+		fragments := []fragment{{Text: "union of"}}
+		for i, member := range yangType.Type {
+			if i > 0 {
+				fragments = append(fragments, fragment{Text: "or"})
+			}
+			fragments = append(fragments, b.typeName(entry, member)...)
+		}
+		return fragments
+	}
+	kind := yangType.Kind.String()
+	if yangType.Name != "" && yangType.Name != kind {
+		return []fragment{{Text: yangType.Name, Code: true}, {Text: "(" + kind + ")"}}
+	}
+	return []fragment{{Text: kind, Code: true}}
+}
+
+// typeConstraints returns the restrictions of a type, those of the members of a union included
+func typeConstraints(yangType *yang.YangType) []fragment {
+	if yangType == nil {
+		return nil
+	}
+	constraints := make([]fragment, 0)
+	if len(yangType.Range) > 0 && !isBuiltinRange(yangType) {
+		constraints = append(constraints, fragment{Text: "range"}, fragment{Text: yangType.Range.String(), Code: true})
+	}
+	if len(yangType.Length) > 0 {
+		constraints = append(constraints, fragment{Text: "length"}, fragment{Text: yangType.Length.String(), Code: true})
+	}
+	patterns := yangType.POSIXPattern
+	if len(patterns) == 0 {
+		patterns = yangType.Pattern
+	}
+	for _, pattern := range patterns {
+		constraints = append(constraints, fragment{Text: "pattern"}, fragment{Text: pattern, Code: true})
+	}
+	if yangType.Kind == yang.Ydecimal64 && yangType.FractionDigits > 0 {
+		constraints = append(constraints, fragment{Text: fmt.Sprintf("fraction-digits %d", yangType.FractionDigits)})
+	}
+	for _, values := range []struct {
+		name  string
+		names *yang.EnumType
+	}{{"enum", yangType.Enum}, {"bits", yangType.Bit}} {
+		if values.names == nil || len(values.names.Names()) == 0 {
+			continue
+		}
+		constraints = append(constraints, fragment{Text: values.name})
+		for _, name := range values.names.Names() {
+			constraints = append(constraints, fragment{Text: name, Code: true})
+		}
+	}
+	if yangType.Kind == yang.Yunion {
+		for _, member := range yangType.Type {
+			constraints = append(constraints, typeConstraints(member)...)
+		}
+	}
+	return constraints
+}
+
+// isBuiltinRange tells whether the range of an integer type is the full range of its built-in type
+func isBuiltinRange(yangType *yang.YangType) bool {
+	builtin, ok := yang.BaseTypedefs[yangType.Kind.String()]
+	return ok && builtin.YangType != nil && builtin.YangType.Range.Equal(yangType.Range)
+}
+
+// identitiesPage returns the page of the identities referred to, along with the identities derived from them
+func (b *builder) identitiesPage() *page {
+	p := &page{
+		File:        identitiesPage + b.ext,
+		Title:       "Identities",
+		Breadcrumbs: []fragment{{Text: b.root.Name, Link: indexPage + b.ext}, {Text: "identities"}},
+		Description: "Identities the identityref leaves of the model refer to, with the identities derived from them.",
+	}
+	names := make([]string, 0, len(b.identities))
+	for name := range b.identities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		base := b.identities[name]
+		s := section{Anchor: identityAnchor(name), Title: name, Description: valueName(base.Description)}
+		values := make([]fragment, 0, len(base.Values))
+		for _, value := range base.Values {
+			values = append(values, fragment{Text: value.PrefixedName(), Code: true})
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i].Text < values[j].Text })
+		if len(values) > 0 {
+			s.Properties = append(s.Properties, property{Name: "Values", Values: values})
+		}
+		p.Sections = append(p.Sections, s)
+	}
+	return p
+}
+
+// breadcrumbs links to the pages of the ancestors of entry
+func (b *builder) breadcrumbs(entry *yang.Entry) []fragment {
+	crumbs := []fragment{{Text: entry.Name}}
+	for parent := pageEntry(entry.Parent); parent != nil; parent = pageEntry(parent.Parent) {
+		crumbs = append([]fragment{{Text: parent.Name, Link: b.file(parent)}}, crumbs...)
+	}
+	return crumbs
+}
+
+// file returns the page of a container or list, named after its path, or the index page for the root
+func (b *builder) file(entry *yang.Entry) string {
+	if entry == b.root {
+		return indexPage + b.ext
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(schemaPath(entry), "/"), "/", ".") + b.ext
+}
+
+// anchor links to the section of a leaf on the page of its container or list
+func (b *builder) anchor(entry *yang.Entry) string {
+	return b.file(pageEntry(entry.Parent)) + "#" + entry.Name
+}
+
+// pageEntry returns the container, list or root the page of entry belongs to, skipping choices and cases
+func pageEntry(entry *yang.Entry) *yang.Entry {
+	for entry != nil && (entry.IsChoice() || entry.IsCase()) {
+		entry = entry.Parent
+	}
+	return entry
+}
+
+// schemaPath returns the data tree path of entry, without choices and cases
+func schemaPath(entry *yang.Entry) string {
+	elements := make([]string, 0)
+	for e := entry; e != nil && e.Parent != nil; e = e.Parent {
+		if !e.IsChoice() && !e.IsCase() {
+			elements = append([]string{e.Name}, elements...)
+		}
+	}
+	return "/" + strings.Join(elements, "/")
+}
+
+// dataChildren returns the sorted children of entry in the data tree, looking into choices and cases
+func dataChildren(entry *yang.Entry) []*yang.Entry {
+	children := make([]*yang.Entry, 0, len(entry.Dir))
+	for _, child := range entry.Dir {
+		if child.IsChoice() || child.IsCase() {
+			children = append(children, dataChildren(child)...)
+		} else {
+			children = append(children, child)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Name < children[j].Name })
+	return children
+}
+
+// leaves returns the sorted leaves and leaf-lists of entry, keys first
+func leaves(entry *yang.Entry) []*yang.Entry {
+	keys := make([]*yang.Entry, 0)
+	others := make([]*yang.Entry, 0)
+	for _, child := range dataChildren(entry) {
+		switch {
+		case child.IsDir():
+		case isKey(child):
+			keys = append(keys, child)
+		default:
+			others = append(others, child)
+		}
+	}
+	return append(keys, others...)
+}
+
+func isKey(entry *yang.Entry) bool {
+	parent := pageEntry(entry.Parent)
+	if parent == nil || !parent.IsList() {
+		return false
+	}
+	for _, key := range strings.Fields(parent.Key) {
+		if key == entry.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// choiceCase names the case and choice entry belongs to, if any
+func choiceCase(entry *yang.Entry) (string, bool) {
+	var caseName, choiceName string
+	for parent := entry.Parent; parent != nil && (parent.IsChoice() || parent.IsCase()); parent = parent.Parent {
+		if parent.IsCase() && caseName == "" {
+			caseName = parent.Name
+		} else if parent.IsChoice() && choiceName == "" {
+			choiceName = parent.Name
+		}
+	}
+	if choiceName == "" {
+		return "", false
+	}
+	if caseName == "" {
+		return "choice " + choiceName, true
+	}
+	return fmt.Sprintf("case %s of choice %s", caseName, choiceName), true
+}
+
+func config(entry *yang.Entry) string {
+	if entry.ReadOnly() {
+		return "state"
+	}
+	return "config"
+}
+
+// listProperties returns the number of elements and the order of lists and leaf-lists
+func listProperties(entry *yang.Entry) []property {
+	if entry.ListAttr == nil {
+		return nil
+	}
+	properties := make([]property, 0)
+	if entry.ListAttr.MinElements > 0 {
+		properties = append(properties, textProperty("Min elements", fmt.Sprint(entry.ListAttr.MinElements)))
+	}
+	if entry.ListAttr.MaxElements != 0 && entry.ListAttr.MaxElements != ^uint64(0) {
+		properties = append(properties, textProperty("Max elements", fmt.Sprint(entry.ListAttr.MaxElements)))
+	}
+	if entry.ListAttr.OrderedBy != nil {
+		properties = append(properties, textProperty("Ordered by", entry.ListAttr.OrderedBy.Name))
+	}
+	return properties
+}
+
+// conditionProperties returns the when and must expressions of entry, along with the error messages of the latter
+func conditionProperties(entry *yang.Entry) []property {
+	properties := make([]property, 0)
+	if when, ok := entry.GetWhenXPath(); ok {
+		properties = append(properties, codeProperty("When", when))
+	}
+	for _, value := range entry.Extra["must"] {
+		must, ok := value.(*yang.Must)
+		if !ok {
+			continue
+		}
+		p := property{Name: "Must", Values: []fragment{{Text: must.Name, Code: true}}}
+		if message := valueName(must.ErrorMessage); message != "" {
+			p.Values = append(p.Values, fragment{Text: "otherwise: " + message})
+		}
+		if appTag := valueName(must.ErrorAppTag); appTag != "" {
+			p.Values = append(p.Values, fragment{Text: "error-app-tag"}, fragment{Text: appTag, Code: true})
+		}
+		properties = append(properties, p)
+	}
+	if reference := extraValues(entry, "reference"); len(reference) > 0 {
+		properties = append(properties, textProperty("Reference", reference...))
+	}
+	return properties
+}
+
+// extensionProperties returns the extension statements of entry, naming the path leaf-selection picks values from
+func extensionProperties(entry *yang.Entry) []property {
+	properties := make([]property, 0)
+	for _, ext := range entry.Exts {
+		keyword := ext.Keyword[strings.Index(ext.Keyword, ":")+1:]
+		switch {
+		case keyword == leafSelection:
+			properties = append(properties, codeProperty("Values selected from", ext.Argument))
+		case keyword == leafSelection+"-default":
+			properties = append(properties, codeProperty("Selection default", ext.Argument))
+		case ext.HasArgument:
+			properties = append(properties, property{Name: "Extension", Values: []fragment{{Text: ext.Keyword, Code: true}, {Text: ext.Argument}}})
+		default:
+			properties = append(properties, codeProperty("Extension", ext.Keyword))
+		}
+	}
+	return properties
+}
+
+// units returns the units of a leaf or leaf-list, which goyang leaves on the statement, or else those of its type
+func units(entry *yang.Entry) string {
+	var value *yang.Value
+	switch node := entry.Node.(type) {
+	case *yang.Leaf:
+		value = node.Units
+	case *yang.LeafList:
+		value = node.Units
+	}
+	switch {
+	case entry.Units != "":
+		return entry.Units
+	case value != nil:
+		return value.Name
+	case entry.Type != nil:
+		return entry.Type.Units
+	}
+	return ""
+}
+
+// extraValues returns the values of a statement goyang does not process, such as presence or reference
+func extraValues(entry *yang.Entry, keyword string) []string {
+	values := make([]string, 0)
+	for _, value := range entry.Extra[keyword] {
+		if v, ok := value.(*yang.Value); ok && v != nil {
+			values = append(values, v.Name)
+		}
+	}
+	return values
+}
+
+func valueName(value *yang.Value) string {
+	if value == nil {
+		return ""
+	}
+	return value.Name
+}
+
+func identityAnchor(name string) string {
+	return strings.ReplaceAll(name, ":", "-")
+}
+
+// summary returns the first sentence of a description, which ends with a period followed by a capital letter
+func summary(description string) string {
+	text := strings.Join(strings.Fields(description), " ")
+	if loc := sentenceEndRegExp.FindStringIndex(text); loc != nil {
+		return text[:loc[0]+1]
+	}
+	return text
+}
+
+func textProperty(name string, values ...string) property {
+	p := property{Name: name}
+	for _, value := range values {
+		p.Values = append(p.Values, fragment{Text: value})
+	}
+	return p
+}
+
+func codeProperty(name string, values ...string) property {
+	p := property{Name: name}
+	for _, value := range values {
+		p.Values = append(p.Values, fragment{Text: value, Code: true})
+	}
+	return p
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docs_gen
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testModule = `module test {
+  namespace "urn:test";
+  prefix t;
+
+  extension leaf-selection {
+    argument path;
+  }
+
+  identity interface-type {
+    description "Base of the interface types";
+  }
+  identity ethernet {
+    base interface-type;
+  }
+  identity loopback {
+    base interface-type;
+  }
+
+  container interfaces {
+    description "Interfaces of the device. Each one is configured separately.";
+    list interface {
+      key "name";
+      max-elements 8;
+      leaf name {
+        type string {
+          length "1..16";
+          pattern "eth[0-9]+";
+        }
+      }
+      leaf mtu {
+        type uint16 {
+          range "64..9000";
+        }
+        units "bytes";
+        default "1500";
+        description "Maximum transmission unit";
+      }
+      leaf kind {
+        type identityref {
+          base interface-type;
+        }
+        mandatory true;
+      }
+      leaf status {
+        config false;
+        type enumeration {
+          enum up;
+          enum down;
+        }
+      }
+      leaf speed {
+        when "../kind = 't:ethernet'";
+        type uint32;
+        must ". >= 10" {
+          error-message "speed must be at least 10";
+        }
+        t:leaf-selection "/interfaces/interface/mtu";
+      }
+    }
+  }
+
+  container system {
+    presence "Enables the system";
+    leaf primary {
+      type leafref {
+        path "/interfaces/interface/name";
+      }
+    }
+    choice addressing {
+      case dhcp {
+        leaf dhcp-server {
+          type string;
+        }
+      }
+      case static {
+        leaf address {
+          type string;
+        }
+      }
+    }
+  }
+}
+`
+
+func testEntries(t *testing.T) []*yang.Entry {
+	ms := yang.NewModules()
+	if !assert.NoError(t, ms.Parse(testModule, "test.yang")) {
+		t.FailNow()
+	}
+	if errs := ms.Process(); !assert.Empty(t, errs) {
+		t.FailNow()
+	}
+	return []*yang.Entry{yang.ToEntry(ms.Modules["test"])}
+}
+
+func TestBuildDocs_Markdown(t *testing.T) {
+	docs, err := BuildDocs(testEntries(t), &DocsGenSettings{ModelType: "test", ModelVersion: "1.0.0"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	var files []string
+	for file := range docs {
+		files = append(files, file)
+	}
+	assert.ElementsMatch(t, []string{"index.md", "interfaces.md", "interfaces.interface.md", "system.md", "identities.md"}, files)
+
+	index := string(docs["index.md"])
+	assert.Contains(t, index, "# test-1.0.0\n")
+	assert.Contains(t, index, "| Modules | `test` |")
+	assert.Contains(t, index, "| [`interfaces`](interfaces.md) | container |  | config | Interfaces of the device. |")
+
+	list := string(docs["interfaces.interface.md"])
+	assert.Contains(t, list, "# /interfaces/interface\n\n[test-1.0.0](index.md) / [interfaces](interfaces.md) / interface\n")
+	assert.Contains(t, list, "| Keys | [`name`](#name) |")
+	assert.Contains(t, list, "| Max elements | 8 |")
+	assert.Contains(t, list, "| [`status`](interfaces.interface.md#status) | leaf | `enumeration` | state |  |")
+	assert.Contains(t, list, "<a id=\"mtu\"></a>\n\n### mtu\n\nMaximum transmission unit\n")
+	assert.Contains(t, list, "| Default | `1500` |\n| Units | bytes |\n| Constraints | range `64..9000` |")
+	assert.Contains(t, list, "| Constraints | length `1..16` pattern `eth[0-9]+` |")
+	assert.Contains(t, list, "| Type | identityref of [`t:interface-type`](identities.md#t-interface-type) |")
+	assert.Contains(t, list, "| Constraints | enum `down` `up` |")
+	assert.Contains(t, list, "| When | `../kind = 't:ethernet'` |")
+	assert.Contains(t, list, "| Must | `. >= 10` otherwise: speed must be at least 10 |")
+	assert.Contains(t, list, "| Values selected from | `/interfaces/interface/mtu` |")
+
+	system := string(docs["system.md"])
+	assert.Contains(t, system, "| Presence | Enables the system |")
+	assert.Contains(t, system, "| Type | leafref to [`/interfaces/interface/name`](interfaces.interface.md#name) |")
+	assert.Contains(t, system, "| [`address`](system.md#address) | leaf (case static of choice addressing) | `string` | config |  |")
+
+	identities := string(docs["identities.md"])
+	assert.Contains(t, identities, "<a id=\"t-interface-type\"></a>\n\n### t:interface-type\n\nBase of the interface types\n")
+	assert.Contains(t, identities, "| Values | `t:ethernet` `t:loopback` |")
+}
+
+func TestBuildDocs_HTML(t *testing.T) {
+	docs, err := BuildDocs(testEntries(t), &DocsGenSettings{ModelType: "test", ModelVersion: "1.0.0", Format: HTML})
+	if !assert.NoError(t, err) {
+		return
+	}
+	list := string(docs["interfaces.interface.html"])
+	assert.Contains(t, list, `<nav><a href="index.html">test-1.0.0</a> / <a href="interfaces.html">interfaces</a> / interface</nav>`)
+	assert.Contains(t, list, `<h3 id="mtu">mtu</h3>`)
+	assert.Contains(t, list, `<tr><th>Must</th><td><code>. &gt;= 10</code> otherwise: speed must be at least 10</td></tr>`)
+	assert.Contains(t, list, `<a href="identities.html#t-interface-type"><code>t:interface-type</code></a>`)
+}
+
+func TestBuildDocs_UnsupportedFormat(t *testing.T) {
+	_, err := BuildDocs(testEntries(t), &DocsGenSettings{ModelType: "test", ModelVersion: "1.0.0", Format: "pdf"})
+	assert.EqualError(t, err, `unsupported format "pdf"`)
+}
+
+func Test_summary(t *testing.T) {
+	assert.Equal(t, "First sentence.", summary("First\n   sentence. Second one."))
+	assert.Equal(t, "Other fuel type e.g. hydrogen", summary("Other fuel type e.g. hydrogen"))
+}
+
+func Test_paragraphs(t *testing.T) {
+	assert.Equal(t, []string{"a b", "c"}, paragraphs("\n  a\n  b\n\n  c\n"))
+}
+
+func Test_markdownFragment(t *testing.T) {
+	assert.Equal(t, "a\\_b \\| \\[c\\]", markdownFragment(fragment{Text: "a_b | [c]"}))
+	assert.Equal(t, "[`a\\|b`](x.md#y)", markdownFragment(fragment{Text: "a|b", Code: true, Link: "x.md#y"}))
+	assert.Equal(t, "`` a`b ``", markdownFragment(fragment{Text: "a`b", Code: true}))
+}
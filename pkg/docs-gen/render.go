@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docs_gen
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// renderer writes a page in one of the formats
+type renderer func(w io.Writer, p *page) error
+
+func newRenderer(format Format) (renderer, error) {
+	switch format {
+	case Markdown:
+		t, err := template.New("page").Funcs(template.FuncMap{
+			"text":       markdownText,
+			"fragment":   markdownFragment,
+			"fragments":  markdownFragments,
+			"paragraphs": markdownParagraphs,
+		}).Parse(markdownTemplate)
+		if err != nil {
+			return nil, err
+		}
+		return func(w io.Writer, p *page) error {
+			var content bytes.Buffer
+			if err := t.Execute(&content, p); err != nil {
+				return err
+			}
+			content.Truncate(len(bytes.TrimRight(content.Bytes(), "\n")))
+			_, err := w.Write(append(blankLinesRegExp.ReplaceAll(content.Bytes(), []byte("\n\n")), '\n'))
+			return err
+		}, nil
+	case HTML:
+		t, err := htmltemplate.New("page").Funcs(htmltemplate.FuncMap{
+			"paragraphs": paragraphs,
+		}).Parse(htmlTemplate)
+		if err != nil {
+			return nil, err
+		}
+		return func(w io.Writer, p *page) error {
+			return t.Execute(w, p)
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// blankLinesRegExp matches the runs of blank lines the Markdown template leaves behind
+var blankLinesRegExp = regexp.MustCompile(`\n{3,}`)
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `&lt;`, `>`, `&gt;`, `|`, `\|`)
+
+// markdownText escapes text, on a single line so that it can be used in tables
+func markdownText(text string) string {
+	return markdownEscaper.Replace(strings.Join(strings.Fields(text), " "))
+}
+
+func markdownFragment(f fragment) string {
+	text := markdownText(f.Text)
+	if f.Code {
+		code := strings.ReplaceAll(strings.Join(strings.Fields(f.Text), " "), "|", `\|`)
+		if strings.Contains(code, "`") {
+			text = "`` " + code + " ``"
+		} else {
+			text = "`" + code + "`"
+		}
+	}
+	if f.Link != "" {
+		return fmt.Sprintf("[%s](%s)", text, f.Link)
+	}
+	return text
+}
+
+func markdownFragments(fragments []fragment) string {
+	texts := make([]string, 0, len(fragments))
+	for _, f := range fragments {
+		texts = append(texts, markdownFragment(f))
+	}
+	return strings.Join(texts, " ")
+}
+
+func markdownParagraphs(text string) string {
+	escaped := make([]string, 0)
+	for _, paragraph := range paragraphs(text) {
+		escaped = append(escaped, markdownText(paragraph))
+	}
+	return strings.Join(escaped, "\n\n")
+}
+
+// paragraphs splits a YANG description into its paragraphs, separated by blank lines
+func paragraphs(text string) []string {
+	result := make([]string, 0)
+	var current []string
+	for _, line := range append(strings.Split(text, "\n"), "") {
+		if line = strings.TrimSpace(line); line != "" {
+			current = append(current, line)
+		} else if len(current) > 0 {
+			result = append(result, strings.Join(current, " "))
+			current = nil
+		}
+	}
+	return result
+}
+
+const markdownTemplate = `# {{ text .Title }}
+
+{{ range $i, $f := .Breadcrumbs }}{{ if $i }} / {{ end }}{{ fragment $f }}{{ end }}
+
+{{ paragraphs .Description }}
+
+{{ if .Properties -}}
+| Property | Value |
+| --- | --- |
+{{ range .Properties }}| {{ .Name }} | {{ fragments .Values }} |
+{{ end }}{{ end }}
+{{ if .Children -}}
+## Children
+
+| Name | Kind | Type | Config | Description |
+| --- | --- | --- | --- | --- |
+{{ range .Children }}| {{ fragment .Name }} | {{ .Kind }} | {{ fragments .Type }} | {{ .Config }} | {{ text .Summary }} |
+{{ end }}{{ end }}
+{{ range .Sections }}
+<a id="{{ .Anchor }}"></a>
+
+### {{ text .Title }}
+
+{{ paragraphs .Description }}
+
+{{ if .Properties -}}
+| Property | Value |
+| --- | --- |
+{{ range .Properties }}| {{ .Name }} | {{ fragments .Values }} |
+{{ end }}{{ end }}{{ end }}`
+
+const htmlTemplate = `{{ define "fragment" }}{{ if .Link }}<a href="{{ .Link }}">{{ end }}{{ if .Code }}<code>{{ .Text }}</code>{{ else }}{{ .Text }}{{ end }}{{ if .Link }}</a>{{ end }}{{ end -}}
+{{ define "fragments" }}{{ range $i, $f := . }}{{ if $i }} {{ end }}{{ template "fragment" $f }}{{ end }}{{ end -}}
+{{ define "properties" }}{{ if . }}
+<table class="properties">
+{{- range . }}
+<tr><th>{{ .Name }}</th><td>{{ template "fragments" .Values }}</td></tr>
+{{- end }}
+</table>
+{{- end }}{{ end -}}
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+code { background: #f4f4f4; }
+nav { margin-bottom: 1em; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{- if .Breadcrumbs }}
+<nav>{{ range $i, $f := .Breadcrumbs }}{{ if $i }} / {{ end }}{{ template "fragment" $f }}{{ end }}</nav>
+{{- end }}
+{{- range paragraphs .Description }}
+<p>{{ . }}</p>
+{{- end }}
+{{- template "properties" .Properties }}
+{{- if .Children }}
+<h2>Children</h2>
+<table class="children">
+<tr><th>Name</th><th>Kind</th><th>Type</th><th>Config</th><th>Description</th></tr>
+{{- range .Children }}
+<tr><td>{{ template "fragment" .Name }}</td><td>{{ .Kind }}</td><td>{{ template "fragments" .Type }}</td><td>{{ .Config }}</td><td>{{ .Summary }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- range .Sections }}
+<h3 id="{{ .Anchor }}">{{ .Title }}</h3>
+{{- range paragraphs .Description }}
+<p>{{ . }}</p>
+{{- end }}
+{{- template "properties" .Properties }}
+{{- end }}
+</body>
+</html>
+`
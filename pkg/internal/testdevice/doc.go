@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testdevice holds a copy of the generated Golang bindings of models/testdevice-1.0.x, whose lists,
+// leafrefs and must statements the tests of config_gen and validate exercise. go generate refreshes the copy,
+// which its test checks is up to date
+package testdevice
+
+//go:generate sh -c "sed 's/^package api$/package testdevice/' ../../../models/testdevice-1.0.x/api/generated.go > generated.go"
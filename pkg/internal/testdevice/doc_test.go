@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testdevice
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+// TestCopy fails when the copy differs from the Golang bindings of models/testdevice-1.0.x
+func TestCopy(t *testing.T) {
+	model, err := os.ReadFile("../../../models/testdevice-1.0.x/api/generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied, err := os.ReadFile("generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := bytes.Replace(model, []byte("\npackage api\n"), []byte("\npackage testdevice\n"), 1)
+	assert.True(t, bytes.Equal(expected, copied),
+		"generated.go is not a copy of models/testdevice-1.0.x/api/generated.go, run go generate ./pkg/internal/...")
+}
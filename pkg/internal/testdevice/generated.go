@@ -0,0 +1,2309 @@
+// Code generated by YGOT. DO NOTEDIT.
+/*
+Package api is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was false
+in this case).
+
+This package was generated by model-compiler
+using the following YANG input files:
+	- yang/onf-test1@2018-02-20.yang
+	- yang/onf-test1-extra@2021-04-01.yang
+	- yang/switch/onf-switch-model@2023-03-07.yang
+	- yang/switch/onf-switch@2023-03-07.yang
+	- yang/onf-test1-choice@2023-03-07.yang
+Imported modules were sourced from:
+	- yang-base
+	- yang
+	- yang/switch
+*/
+package testdevice
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+var (
+	SchemaTree map[string]*yang.Entry
+	ΛEnumTypes map[string][]reflect.Type
+)
+
+func init() {
+	var err error
+	initΛEnumTypes()
+	if SchemaTree, err = UnzipSchema(); err != nil {
+		panic("schema error: " +  err.Error())
+	}
+}
+
+// Schema returns the details of the generated schema.
+func Schema() (*ytypes.Schema, error) {
+	uzp, err := UnzipSchema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot unzip schema, %v", err)
+	}
+
+	return &ytypes.Schema{
+		Root: &Device{},
+		SchemaTree: uzp,
+		Unmarshal: Unmarshal,
+	}, nil
+}
+
+// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
+// keyed by the name of the struct that the yang.Entry describes the schema for.
+func UnzipSchema() (map[string]*yang.Entry, error) {
+	var schemaTree map[string]*yang.Entry
+	var err error
+	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
+		return nil, fmt.Errorf("could not unzip the schema; %v", err)
+	}
+	return schemaTree, nil
+}
+
+// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
+// destStruct, which must be non-nil and the correct GoStruct type. It returns
+// an error if the destStruct is not found in the schema or the data cannot be
+// unmarshaled. The supplied options (opts) are used to control the behaviour
+// of the unmarshal function - for example, determining whether errors are
+// thrown for unknown fields in the input JSON.
+func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("could not find schema for type %s", tn )
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
+}
+
+// Device represents the /device YANG schema element.
+type Device struct {
+	Cont1A	*OnfTest1_Cont1A	`path:"cont1a" module:"onf-test1"`
+	Cont1BState	*OnfTest1_Cont1BState	`path:"cont1b-state" module:"onf-test1"`
+	LeafAtTopLevel	*string	`path:"leaf-at-top-level" module:"onf-test1"`
+	List1A	map[string]*OnfTest1_List1A	`path:"list1a" module:"onf-test1"`
+	Switch	map[string]*OnfSwitch_Switch	`path:"switch" module:"onf-switch"`
+	SwitchModel	map[string]*OnfSwitchModel_SwitchModel	`path:"switch-model" module:"onf-switch-model"`
+	Vehicle	map[string]*OnfTest1Choice_Vehicle	`path:"vehicle" module:"onf-test1-choice"`
+}
+
+// IsYANGGoStruct ensures that Device implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Device) IsYANGGoStruct() {}
+
+// NewList1A creates a new entry in the List1A list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewList1A(ListId string) (*OnfTest1_List1A, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List1A == nil {
+		t.List1A = make(map[string]*OnfTest1_List1A)
+	}
+
+	key := ListId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List1A[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List1A", key)
+	}
+
+	t.List1A[key] = &OnfTest1_List1A{
+		ListId: &ListId,
+	}
+
+	return t.List1A[key], nil
+}
+
+// NewSwitch creates a new entry in the Switch list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewSwitch(SwitchId string) (*OnfSwitch_Switch, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Switch == nil {
+		t.Switch = make(map[string]*OnfSwitch_Switch)
+	}
+
+	key := SwitchId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Switch[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Switch", key)
+	}
+
+	t.Switch[key] = &OnfSwitch_Switch{
+		SwitchId: &SwitchId,
+	}
+
+	return t.Switch[key], nil
+}
+
+// NewSwitchModel creates a new entry in the SwitchModel list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewSwitchModel(SwitchModelId string) (*OnfSwitchModel_SwitchModel, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.SwitchModel == nil {
+		t.SwitchModel = make(map[string]*OnfSwitchModel_SwitchModel)
+	}
+
+	key := SwitchModelId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.SwitchModel[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list SwitchModel", key)
+	}
+
+	t.SwitchModel[key] = &OnfSwitchModel_SwitchModel{
+		SwitchModelId: &SwitchModelId,
+	}
+
+	return t.SwitchModel[key], nil
+}
+
+// NewVehicle creates a new entry in the Vehicle list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewVehicle(Id string) (*OnfTest1Choice_Vehicle, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Vehicle == nil {
+		t.Vehicle = make(map[string]*OnfTest1Choice_Vehicle)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Vehicle[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Vehicle", key)
+	}
+
+	t.Vehicle[key] = &OnfTest1Choice_Vehicle{
+		Id: &Id,
+	}
+
+	return t.Vehicle[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Device.
+func (*Device) ΛBelongingModule() string {
+	return ""
+}
+
+
+// OnfSwitchModel_SwitchModel represents the /onf-switch-model/switch-model YANG schema element.
+type OnfSwitchModel_SwitchModel struct {
+	Attribute	map[string]*OnfSwitchModel_SwitchModel_Attribute	`path:"attribute" module:"onf-switch-model"`
+	Description	*string	`path:"description" module:"onf-switch-model"`
+	DisplayName	*string	`path:"display-name" module:"onf-switch-model"`
+	Port	map[uint8]*OnfSwitchModel_SwitchModel_Port	`path:"port" module:"onf-switch-model"`
+	SwitchModelId	*string	`path:"switch-model-id" module:"onf-switch-model"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitchModel_SwitchModel implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitchModel_SwitchModel) IsYANGGoStruct() {}
+
+// NewAttribute creates a new entry in the Attribute list of the
+// OnfSwitchModel_SwitchModel struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfSwitchModel_SwitchModel) NewAttribute(AttributeKey string) (*OnfSwitchModel_SwitchModel_Attribute, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Attribute == nil {
+		t.Attribute = make(map[string]*OnfSwitchModel_SwitchModel_Attribute)
+	}
+
+	key := AttributeKey
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Attribute[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Attribute", key)
+	}
+
+	t.Attribute[key] = &OnfSwitchModel_SwitchModel_Attribute{
+		AttributeKey: &AttributeKey,
+	}
+
+	return t.Attribute[key], nil
+}
+
+// NewPort creates a new entry in the Port list of the
+// OnfSwitchModel_SwitchModel struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfSwitchModel_SwitchModel) NewPort(CageNumber uint8) (*OnfSwitchModel_SwitchModel_Port, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Port == nil {
+		t.Port = make(map[uint8]*OnfSwitchModel_SwitchModel_Port)
+	}
+
+	key := CageNumber
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Port[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Port", key)
+	}
+
+	t.Port[key] = &OnfSwitchModel_SwitchModel_Port{
+		CageNumber: &CageNumber,
+	}
+
+	return t.Port[key], nil
+}
+
+// ΛListKeyMap returns the keys of the OnfSwitchModel_SwitchModel struct, which is a YANG list entry.
+func (t *OnfSwitchModel_SwitchModel) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SwitchModelId == nil {
+		return nil, fmt.Errorf("nil value for key SwitchModelId")
+	}
+
+	return map[string]interface{}{
+		"switch-model-id": *t.SwitchModelId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitchModel_SwitchModel"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitchModel_SwitchModel) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitchModel_SwitchModel.
+func (*OnfSwitchModel_SwitchModel) ΛBelongingModule() string {
+	return "onf-switch-model"
+}
+
+
+// OnfSwitchModel_SwitchModel_Attribute represents the /onf-switch-model/switch-model/attribute YANG schema element.
+type OnfSwitchModel_SwitchModel_Attribute struct {
+	AttributeKey	*string	`path:"attribute-key" module:"onf-switch-model"`
+	Value	*string	`path:"value" module:"onf-switch-model"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitchModel_SwitchModel_Attribute implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitchModel_SwitchModel_Attribute) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfSwitchModel_SwitchModel_Attribute struct, which is a YANG list entry.
+func (t *OnfSwitchModel_SwitchModel_Attribute) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.AttributeKey == nil {
+		return nil, fmt.Errorf("nil value for key AttributeKey")
+	}
+
+	return map[string]interface{}{
+		"attribute-key": *t.AttributeKey,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel_Attribute) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitchModel_SwitchModel_Attribute"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel_Attribute) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitchModel_SwitchModel_Attribute) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitchModel_SwitchModel_Attribute.
+func (*OnfSwitchModel_SwitchModel_Attribute) ΛBelongingModule() string {
+	return "onf-switch-model"
+}
+
+
+// OnfSwitchModel_SwitchModel_Port represents the /onf-switch-model/switch-model/port YANG schema element.
+type OnfSwitchModel_SwitchModel_Port struct {
+	CageNumber	*uint8	`path:"cage-number" module:"onf-switch-model"`
+	Description	*string	`path:"description" module:"onf-switch-model"`
+	DisplayName	*string	`path:"display-name" module:"onf-switch-model"`
+	MaxChannel	*uint8	`path:"max-channel" module:"onf-switch-model"`
+	Speeds	[]E_OnfSwitchTypes_Speed	`path:"speeds" module:"onf-switch-model"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitchModel_SwitchModel_Port implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitchModel_SwitchModel_Port) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfSwitchModel_SwitchModel_Port struct, which is a YANG list entry.
+func (t *OnfSwitchModel_SwitchModel_Port) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.CageNumber == nil {
+		return nil, fmt.Errorf("nil value for key CageNumber")
+	}
+
+	return map[string]interface{}{
+		"cage-number": *t.CageNumber,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel_Port) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitchModel_SwitchModel_Port"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitchModel_SwitchModel_Port) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitchModel_SwitchModel_Port) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitchModel_SwitchModel_Port.
+func (*OnfSwitchModel_SwitchModel_Port) ΛBelongingModule() string {
+	return "onf-switch-model"
+}
+
+
+// OnfSwitch_Switch represents the /onf-switch/switch YANG schema element.
+type OnfSwitch_Switch struct {
+	Attribute	map[string]*OnfSwitch_Switch_Attribute	`path:"attribute" module:"onf-switch"`
+	Description	*string	`path:"description" module:"onf-switch"`
+	DisplayName	*string	`path:"display-name" module:"onf-switch"`
+	HostLocalAgent	*string	`path:"host-local-agent" module:"onf-switch"`
+	ModelId	*string	`path:"model-id" module:"onf-switch"`
+	Port	map[OnfSwitch_Switch_Port_Key]*OnfSwitch_Switch_Port	`path:"port" module:"onf-switch"`
+	State	*OnfSwitch_Switch_State	`path:"state" module:"onf-switch"`
+	SwitchId	*string	`path:"switch-id" module:"onf-switch"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitch_Switch implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitch_Switch) IsYANGGoStruct() {}
+
+// OnfSwitch_Switch_Port_Key represents the key for list Port of element /onf-switch/switch.
+type OnfSwitch_Switch_Port_Key struct {
+	CageNumber	uint8	`path:"cage-number"`
+	ChannelNumber	uint8	`path:"channel-number"`
+}
+
+// IsYANGGoKeyStruct ensures that OnfSwitch_Switch_Port_Key partially implements the
+// yang.GoKeyStruct interface. This allows functions that need to
+// handle this key struct to identify it as being generated by gogen.
+func (OnfSwitch_Switch_Port_Key) IsYANGGoKeyStruct() {}
+
+// ΛListKeyMap returns the values of the OnfSwitch_Switch_Port_Key key struct.
+func (t OnfSwitch_Switch_Port_Key) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"cage-number": t.CageNumber,
+		"channel-number": t.ChannelNumber,
+	}, nil
+}
+
+// NewAttribute creates a new entry in the Attribute list of the
+// OnfSwitch_Switch struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfSwitch_Switch) NewAttribute(AttributeKey string) (*OnfSwitch_Switch_Attribute, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Attribute == nil {
+		t.Attribute = make(map[string]*OnfSwitch_Switch_Attribute)
+	}
+
+	key := AttributeKey
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Attribute[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Attribute", key)
+	}
+
+	t.Attribute[key] = &OnfSwitch_Switch_Attribute{
+		AttributeKey: &AttributeKey,
+	}
+
+	return t.Attribute[key], nil
+}
+
+// NewPort creates a new entry in the Port list of the
+// OnfSwitch_Switch struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfSwitch_Switch) NewPort(CageNumber uint8, ChannelNumber uint8) (*OnfSwitch_Switch_Port, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Port == nil {
+		t.Port = make(map[OnfSwitch_Switch_Port_Key]*OnfSwitch_Switch_Port)
+	}
+
+	key := OnfSwitch_Switch_Port_Key{
+		CageNumber: CageNumber,
+		ChannelNumber: ChannelNumber,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Port[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Port", key)
+	}
+
+	t.Port[key] = &OnfSwitch_Switch_Port{
+		CageNumber: &CageNumber,
+		ChannelNumber: &ChannelNumber,
+	}
+
+	return t.Port[key], nil
+}
+
+// ΛListKeyMap returns the keys of the OnfSwitch_Switch struct, which is a YANG list entry.
+func (t *OnfSwitch_Switch) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SwitchId == nil {
+		return nil, fmt.Errorf("nil value for key SwitchId")
+	}
+
+	return map[string]interface{}{
+		"switch-id": *t.SwitchId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitch_Switch"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitch_Switch) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitch_Switch.
+func (*OnfSwitch_Switch) ΛBelongingModule() string {
+	return "onf-switch"
+}
+
+
+// OnfSwitch_Switch_Attribute represents the /onf-switch/switch/attribute YANG schema element.
+type OnfSwitch_Switch_Attribute struct {
+	AttributeKey	*string	`path:"attribute-key" module:"onf-switch"`
+	Value	*string	`path:"value" module:"onf-switch"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitch_Switch_Attribute implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitch_Switch_Attribute) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfSwitch_Switch_Attribute struct, which is a YANG list entry.
+func (t *OnfSwitch_Switch_Attribute) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.AttributeKey == nil {
+		return nil, fmt.Errorf("nil value for key AttributeKey")
+	}
+
+	return map[string]interface{}{
+		"attribute-key": *t.AttributeKey,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_Attribute) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitch_Switch_Attribute"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_Attribute) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitch_Switch_Attribute) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitch_Switch_Attribute.
+func (*OnfSwitch_Switch_Attribute) ΛBelongingModule() string {
+	return "onf-switch"
+}
+
+
+// OnfSwitch_Switch_Port represents the /onf-switch/switch/port YANG schema element.
+type OnfSwitch_Switch_Port struct {
+	CageNumber	*uint8	`path:"cage-number" module:"onf-switch"`
+	ChannelNumber	*uint8	`path:"channel-number" module:"onf-switch"`
+	Description	*string	`path:"description" module:"onf-switch"`
+	DisplayName	*string	`path:"display-name" module:"onf-switch"`
+	Speed	E_OnfSwitchTypes_Speed	`path:"speed" module:"onf-switch"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitch_Switch_Port implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitch_Switch_Port) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfSwitch_Switch_Port struct, which is a YANG list entry.
+func (t *OnfSwitch_Switch_Port) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.CageNumber == nil {
+		return nil, fmt.Errorf("nil value for key CageNumber")
+	}
+
+	if t.ChannelNumber == nil {
+		return nil, fmt.Errorf("nil value for key ChannelNumber")
+	}
+
+	return map[string]interface{}{
+		"cage-number": *t.CageNumber,
+		"channel-number": *t.ChannelNumber,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_Port) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitch_Switch_Port"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_Port) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitch_Switch_Port) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitch_Switch_Port.
+func (*OnfSwitch_Switch_Port) ΛBelongingModule() string {
+	return "onf-switch"
+}
+
+
+// OnfSwitch_Switch_State represents the /onf-switch/switch/state YANG schema element.
+type OnfSwitch_Switch_State struct {
+	Connected	*string	`path:"connected" module:"onf-switch"`
+	LastConnected	*string	`path:"last-connected" module:"onf-switch"`
+}
+
+// IsYANGGoStruct ensures that OnfSwitch_Switch_State implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfSwitch_Switch_State) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_State) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfSwitch_Switch_State"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfSwitch_Switch_State) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfSwitch_Switch_State) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfSwitch_Switch_State.
+func (*OnfSwitch_Switch_State) ΛBelongingModule() string {
+	return "onf-switch"
+}
+
+
+// OnfTest1Choice_Vehicle represents the /onf-test1-choice/vehicle YANG schema element.
+type OnfTest1Choice_Vehicle struct {
+	Battery	*OnfTest1Choice_Vehicle_Battery	`path:"battery" module:"onf-test1-choice"`
+	CubicCapacity	*uint16	`path:"cubic-capacity" module:"onf-test1-choice"`
+	ElectricMotor	map[string]*OnfTest1Choice_Vehicle_ElectricMotor	`path:"electric-motor" module:"onf-test1-choice"`
+	EnginePosition	E_OnfTest1Choice_Vehicle_EnginePosition	`path:"engine-position" module:"onf-test1-choice"`
+	Id	*string	`path:"id" module:"onf-test1-choice"`
+	MaxBioDieselPercent	*uint8	`path:"max-bio-diesel-percent" module:"onf-test1-choice"`
+	MaxPercentEthanol	*uint8	`path:"max-percent-ethanol" module:"onf-test1-choice"`
+	OctaneMin	*uint8	`path:"octane-min" module:"onf-test1-choice"`
+	OtherFuelName	*string	`path:"other-fuel-name" module:"onf-test1-choice"`
+	UnderCarriage	*OnfTest1Choice_Vehicle_UnderCarriage	`path:"under-carriage" module:"onf-test1-choice"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1Choice_Vehicle implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1Choice_Vehicle) IsYANGGoStruct() {}
+
+// NewElectricMotor creates a new entry in the ElectricMotor list of the
+// OnfTest1Choice_Vehicle struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1Choice_Vehicle) NewElectricMotor(MotorName string) (*OnfTest1Choice_Vehicle_ElectricMotor, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.ElectricMotor == nil {
+		t.ElectricMotor = make(map[string]*OnfTest1Choice_Vehicle_ElectricMotor)
+	}
+
+	key := MotorName
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.ElectricMotor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list ElectricMotor", key)
+	}
+
+	t.ElectricMotor[key] = &OnfTest1Choice_Vehicle_ElectricMotor{
+		MotorName: &MotorName,
+	}
+
+	return t.ElectricMotor[key], nil
+}
+
+// ΛListKeyMap returns the keys of the OnfTest1Choice_Vehicle struct, which is a YANG list entry.
+func (t *OnfTest1Choice_Vehicle) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1Choice_Vehicle"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1Choice_Vehicle) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1Choice_Vehicle.
+func (*OnfTest1Choice_Vehicle) ΛBelongingModule() string {
+	return "onf-test1-choice"
+}
+
+
+// OnfTest1Choice_Vehicle_Battery represents the /onf-test1-choice/vehicle/battery YANG schema element.
+type OnfTest1Choice_Vehicle_Battery struct {
+	Capacity	*uint16	`path:"capacity" module:"onf-test1-choice"`
+	Material	E_OnfTest1Choice_Vehicle_Battery_Material	`path:"material" module:"onf-test1-choice"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1Choice_Vehicle_Battery implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1Choice_Vehicle_Battery) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_Battery) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1Choice_Vehicle_Battery"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_Battery) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1Choice_Vehicle_Battery) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1Choice_Vehicle_Battery.
+func (*OnfTest1Choice_Vehicle_Battery) ΛBelongingModule() string {
+	return "onf-test1-choice"
+}
+
+
+// OnfTest1Choice_Vehicle_ElectricMotor represents the /onf-test1-choice/vehicle/electric-motor YANG schema element.
+type OnfTest1Choice_Vehicle_ElectricMotor struct {
+	MotorName	*string	`path:"motor-name" module:"onf-test1-choice"`
+	MotorPower	*uint16	`path:"motor-power" module:"onf-test1-choice"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1Choice_Vehicle_ElectricMotor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1Choice_Vehicle_ElectricMotor) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1Choice_Vehicle_ElectricMotor struct, which is a YANG list entry.
+func (t *OnfTest1Choice_Vehicle_ElectricMotor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.MotorName == nil {
+		return nil, fmt.Errorf("nil value for key MotorName")
+	}
+
+	return map[string]interface{}{
+		"motor-name": *t.MotorName,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_ElectricMotor) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1Choice_Vehicle_ElectricMotor"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_ElectricMotor) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1Choice_Vehicle_ElectricMotor) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1Choice_Vehicle_ElectricMotor.
+func (*OnfTest1Choice_Vehicle_ElectricMotor) ΛBelongingModule() string {
+	return "onf-test1-choice"
+}
+
+
+// OnfTest1Choice_Vehicle_UnderCarriage represents the /onf-test1-choice/vehicle/under-carriage YANG schema element.
+type OnfTest1Choice_Vehicle_UnderCarriage struct {
+	Articulated	*bool	`path:"articulated" module:"onf-test1-choice"`
+	NumberTracks	*uint8	`path:"number-tracks" module:"onf-test1-choice"`
+	NumberWheels	*uint8	`path:"number-wheels" module:"onf-test1-choice"`
+	TrackType	E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType	`path:"track-type" module:"onf-test1-choice"`
+	WheelsDriven	*uint8	`path:"wheels-driven" module:"onf-test1-choice"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1Choice_Vehicle_UnderCarriage implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1Choice_Vehicle_UnderCarriage) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_UnderCarriage) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1Choice_Vehicle_UnderCarriage"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1Choice_Vehicle_UnderCarriage) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1Choice_Vehicle_UnderCarriage) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1Choice_Vehicle_UnderCarriage.
+func (*OnfTest1Choice_Vehicle_UnderCarriage) ΛBelongingModule() string {
+	return "onf-test1-choice"
+}
+
+
+// OnfTest1_Cont1A represents the /onf-test1/cont1a YANG schema element.
+type OnfTest1_Cont1A struct {
+	Cont2A	*OnfTest1_Cont1A_Cont2A	`path:"cont2a" module:"onf-test1"`
+	Leaf1A	*string	`path:"leaf1a" module:"onf-test1"`
+	List2A	map[string]*OnfTest1_Cont1A_List2A	`path:"list2a" module:"onf-test1"`
+	List4	map[string]*OnfTest1_Cont1A_List4	`path:"list4" module:"onf-test1-extra"`
+	List5	map[OnfTest1_Cont1A_List5_Key]*OnfTest1_Cont1A_List5	`path:"list5" module:"onf-test1-extra"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A) IsYANGGoStruct() {}
+
+// OnfTest1_Cont1A_List5_Key represents the key for list List5 of element /onf-test1/cont1a.
+type OnfTest1_Cont1A_List5_Key struct {
+	Key1	string	`path:"key1"`
+	Key2	uint8	`path:"key2"`
+}
+
+// IsYANGGoKeyStruct ensures that OnfTest1_Cont1A_List5_Key partially implements the
+// yang.GoKeyStruct interface. This allows functions that need to
+// handle this key struct to identify it as being generated by gogen.
+func (OnfTest1_Cont1A_List5_Key) IsYANGGoKeyStruct() {}
+
+// ΛListKeyMap returns the values of the OnfTest1_Cont1A_List5_Key key struct.
+func (t OnfTest1_Cont1A_List5_Key) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"key1": t.Key1,
+		"key2": t.Key2,
+	}, nil
+}
+
+// NewList2A creates a new entry in the List2A list of the
+// OnfTest1_Cont1A struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1_Cont1A) NewList2A(Name string) (*OnfTest1_Cont1A_List2A, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List2A == nil {
+		t.List2A = make(map[string]*OnfTest1_Cont1A_List2A)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List2A[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List2A", key)
+	}
+
+	t.List2A[key] = &OnfTest1_Cont1A_List2A{
+		Name: &Name,
+	}
+
+	return t.List2A[key], nil
+}
+
+// NewList4 creates a new entry in the List4 list of the
+// OnfTest1_Cont1A struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1_Cont1A) NewList4(Id string) (*OnfTest1_Cont1A_List4, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List4 == nil {
+		t.List4 = make(map[string]*OnfTest1_Cont1A_List4)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List4[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List4", key)
+	}
+
+	t.List4[key] = &OnfTest1_Cont1A_List4{
+		Id: &Id,
+	}
+
+	return t.List4[key], nil
+}
+
+// NewList5 creates a new entry in the List5 list of the
+// OnfTest1_Cont1A struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1_Cont1A) NewList5(Key1 string, Key2 uint8) (*OnfTest1_Cont1A_List5, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List5 == nil {
+		t.List5 = make(map[OnfTest1_Cont1A_List5_Key]*OnfTest1_Cont1A_List5)
+	}
+
+	key := OnfTest1_Cont1A_List5_Key{
+		Key1: Key1,
+		Key2: Key2,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List5[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List5", key)
+	}
+
+	t.List5[key] = &OnfTest1_Cont1A_List5{
+		Key1: &Key1,
+		Key2: &Key2,
+	}
+
+	return t.List5[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A.
+func (*OnfTest1_Cont1A) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// OnfTest1_Cont1A_Cont2A represents the /onf-test1/cont1a/cont2a YANG schema element.
+type OnfTest1_Cont1A_Cont2A struct {
+	Leaf2A	*uint8	`path:"leaf2a" module:"onf-test1"`
+	Leaf2B	*uint8	`path:"leaf2b" module:"onf-test1"`
+	Leaf2C	*string	`path:"leaf2c" module:"onf-test1"`
+	Leaf2D	*uint8	`path:"leaf2d" module:"onf-test1"`
+	Leaf2E	[]int16	`path:"leaf2e" module:"onf-test1"`
+	Leaf2F	Binary	`path:"leaf2f" module:"onf-test1"`
+	Leaf2G	*bool	`path:"leaf2g" module:"onf-test1"`
+	Leaf2H	*string	`path:"leaf2h" module:"onf-test1"`
+	Leaf2I	*string	`path:"leaf2i" module:"onf-test1"`
+	Leaf2J	*string	`path:"leaf2j" module:"onf-test1"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A_Cont2A implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A_Cont2A) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_Cont2A) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A_Cont2A"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_Cont2A) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A_Cont2A) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A_Cont2A.
+func (*OnfTest1_Cont1A_Cont2A) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// OnfTest1_Cont1A_List2A represents the /onf-test1/cont1a/list2a YANG schema element.
+type OnfTest1_Cont1A_List2A struct {
+	Name	*string	`path:"name" module:"onf-test1"`
+	RangeMax	*uint8	`path:"range-max" module:"onf-test1"`
+	RangeMin	*uint8	`path:"range-min" module:"onf-test1"`
+	Ref2D	*uint8	`path:"ref2d" module:"onf-test1"`
+	TxPower	*uint16	`path:"tx-power" module:"onf-test1"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A_List2A implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A_List2A) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1_Cont1A_List2A struct, which is a YANG list entry.
+func (t *OnfTest1_Cont1A_List2A) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List2A) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A_List2A"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List2A) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A_List2A) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A_List2A.
+func (*OnfTest1_Cont1A_List2A) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// OnfTest1_Cont1A_List4 represents the /onf-test1/cont1a/list4 YANG schema element.
+type OnfTest1_Cont1A_List4 struct {
+	Id	*string	`path:"id" module:"onf-test1-extra"`
+	Leaf4B	*string	`path:"leaf4b" module:"onf-test1-extra"`
+	List4A	map[OnfTest1_Cont1A_List4_List4A_Key]*OnfTest1_Cont1A_List4_List4A	`path:"list4a" module:"onf-test1-extra"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A_List4 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A_List4) IsYANGGoStruct() {}
+
+// OnfTest1_Cont1A_List4_List4A_Key represents the key for list List4A of element /onf-test1/cont1a/list4.
+type OnfTest1_Cont1A_List4_List4A_Key struct {
+	Fkey1	string	`path:"fkey1"`
+	Fkey2	uint8	`path:"fkey2"`
+}
+
+// IsYANGGoKeyStruct ensures that OnfTest1_Cont1A_List4_List4A_Key partially implements the
+// yang.GoKeyStruct interface. This allows functions that need to
+// handle this key struct to identify it as being generated by gogen.
+func (OnfTest1_Cont1A_List4_List4A_Key) IsYANGGoKeyStruct() {}
+
+// ΛListKeyMap returns the values of the OnfTest1_Cont1A_List4_List4A_Key key struct.
+func (t OnfTest1_Cont1A_List4_List4A_Key) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"fkey1": t.Fkey1,
+		"fkey2": t.Fkey2,
+	}, nil
+}
+
+// NewList4A creates a new entry in the List4A list of the
+// OnfTest1_Cont1A_List4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1_Cont1A_List4) NewList4A(Fkey1 string, Fkey2 uint8) (*OnfTest1_Cont1A_List4_List4A, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List4A == nil {
+		t.List4A = make(map[OnfTest1_Cont1A_List4_List4A_Key]*OnfTest1_Cont1A_List4_List4A)
+	}
+
+	key := OnfTest1_Cont1A_List4_List4A_Key{
+		Fkey1: Fkey1,
+		Fkey2: Fkey2,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List4A[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List4A", key)
+	}
+
+	t.List4A[key] = &OnfTest1_Cont1A_List4_List4A{
+		Fkey1: &Fkey1,
+		Fkey2: &Fkey2,
+	}
+
+	return t.List4A[key], nil
+}
+
+// ΛListKeyMap returns the keys of the OnfTest1_Cont1A_List4 struct, which is a YANG list entry.
+func (t *OnfTest1_Cont1A_List4) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List4) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A_List4"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List4) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A_List4) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A_List4.
+func (*OnfTest1_Cont1A_List4) ΛBelongingModule() string {
+	return "onf-test1-extra"
+}
+
+
+// OnfTest1_Cont1A_List4_List4A represents the /onf-test1/cont1a/list4/list4a YANG schema element.
+type OnfTest1_Cont1A_List4_List4A struct {
+	Displayname	*string	`path:"displayname" module:"onf-test1-extra"`
+	Fkey1	*string	`path:"fkey1" module:"onf-test1-extra"`
+	Fkey2	*uint8	`path:"fkey2" module:"onf-test1-extra"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A_List4_List4A implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A_List4_List4A) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1_Cont1A_List4_List4A struct, which is a YANG list entry.
+func (t *OnfTest1_Cont1A_List4_List4A) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Fkey1 == nil {
+		return nil, fmt.Errorf("nil value for key Fkey1")
+	}
+
+	if t.Fkey2 == nil {
+		return nil, fmt.Errorf("nil value for key Fkey2")
+	}
+
+	return map[string]interface{}{
+		"fkey1": *t.Fkey1,
+		"fkey2": *t.Fkey2,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List4_List4A) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A_List4_List4A"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List4_List4A) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A_List4_List4A) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A_List4_List4A.
+func (*OnfTest1_Cont1A_List4_List4A) ΛBelongingModule() string {
+	return "onf-test1-extra"
+}
+
+
+// OnfTest1_Cont1A_List5 represents the /onf-test1/cont1a/list5 YANG schema element.
+type OnfTest1_Cont1A_List5 struct {
+	Key1	*string	`path:"key1" module:"onf-test1-extra"`
+	Key2	*uint8	`path:"key2" module:"onf-test1-extra"`
+	Leaf5A	*string	`path:"leaf5a" module:"onf-test1-extra"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1A_List5 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1A_List5) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1_Cont1A_List5 struct, which is a YANG list entry.
+func (t *OnfTest1_Cont1A_List5) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Key1 == nil {
+		return nil, fmt.Errorf("nil value for key Key1")
+	}
+
+	if t.Key2 == nil {
+		return nil, fmt.Errorf("nil value for key Key2")
+	}
+
+	return map[string]interface{}{
+		"key1": *t.Key1,
+		"key2": *t.Key2,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List5) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1A_List5"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1A_List5) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1A_List5) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1A_List5.
+func (*OnfTest1_Cont1A_List5) ΛBelongingModule() string {
+	return "onf-test1-extra"
+}
+
+
+// OnfTest1_Cont1BState represents the /onf-test1/cont1b-state YANG schema element.
+type OnfTest1_Cont1BState struct {
+	Leaf2D	*uint16	`path:"leaf2d" module:"onf-test1"`
+	List2B	map[uint8]*OnfTest1_Cont1BState_List2B	`path:"list2b" module:"onf-test1"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1BState implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1BState) IsYANGGoStruct() {}
+
+// NewList2B creates a new entry in the List2B list of the
+// OnfTest1_Cont1BState struct. The keys of the list are populated from the input
+// arguments.
+func (t *OnfTest1_Cont1BState) NewList2B(Index uint8) (*OnfTest1_Cont1BState_List2B, error){
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.List2B == nil {
+		t.List2B = make(map[uint8]*OnfTest1_Cont1BState_List2B)
+	}
+
+	key := Index
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.List2B[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list List2B", key)
+	}
+
+	t.List2B[key] = &OnfTest1_Cont1BState_List2B{
+		Index: &Index,
+	}
+
+	return t.List2B[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1BState) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1BState"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1BState) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1BState) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1BState.
+func (*OnfTest1_Cont1BState) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// OnfTest1_Cont1BState_List2B represents the /onf-test1/cont1b-state/list2b YANG schema element.
+type OnfTest1_Cont1BState_List2B struct {
+	Index	*uint8	`path:"index" module:"onf-test1"`
+	Leaf3C	*string	`path:"leaf3c" module:"onf-test1"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_Cont1BState_List2B implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_Cont1BState_List2B) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1_Cont1BState_List2B struct, which is a YANG list entry.
+func (t *OnfTest1_Cont1BState_List2B) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1BState_List2B) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_Cont1BState_List2B"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_Cont1BState_List2B) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_Cont1BState_List2B) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_Cont1BState_List2B.
+func (*OnfTest1_Cont1BState_List2B) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// OnfTest1_List1A represents the /onf-test1/list1a YANG schema element.
+type OnfTest1_List1A struct {
+	ListId	*string	`path:"list-id" module:"onf-test1"`
+	Name	*string	`path:"name" module:"onf-test1"`
+}
+
+// IsYANGGoStruct ensures that OnfTest1_List1A implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*OnfTest1_List1A) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the OnfTest1_List1A struct, which is a YANG list entry.
+func (t *OnfTest1_List1A) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.ListId == nil {
+		return nil, fmt.Errorf("nil value for key ListId")
+	}
+
+	return map[string]interface{}{
+		"list-id": *t.ListId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_List1A) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["OnfTest1_List1A"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *OnfTest1_List1A) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *OnfTest1_List1A) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of OnfTest1_List1A.
+func (*OnfTest1_List1A) ΛBelongingModule() string {
+	return "onf-test1"
+}
+
+
+// E_OnfSwitchTypes_Speed is a derived int64 type which is used to represent
+// the enumerated node OnfSwitchTypes_Speed. An additional value named
+// OnfSwitchTypes_Speed_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfSwitchTypes_Speed int64
+
+// IsYANGGoEnum ensures that OnfSwitchTypes_Speed implements the yang.GoEnum
+// interface. This ensures that OnfSwitchTypes_Speed can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfSwitchTypes_Speed) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfSwitchTypes_Speed.
+func (E_OnfSwitchTypes_Speed) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfSwitchTypes_Speed.
+func (e E_OnfSwitchTypes_Speed) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfSwitchTypes_Speed")
+}
+
+const (
+	// OnfSwitchTypes_Speed_UNSET corresponds to the value UNSET of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_UNSET E_OnfSwitchTypes_Speed = 0
+	// OnfSwitchTypes_Speed_speed_100g corresponds to the value speed_100g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_100g E_OnfSwitchTypes_Speed = 1
+	// OnfSwitchTypes_Speed_speed_10g corresponds to the value speed_10g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_10g E_OnfSwitchTypes_Speed = 2
+	// OnfSwitchTypes_Speed_speed_1g corresponds to the value speed_1g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_1g E_OnfSwitchTypes_Speed = 3
+	// OnfSwitchTypes_Speed_speed_2_5g corresponds to the value speed_2_5g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_2_5g E_OnfSwitchTypes_Speed = 4
+	// OnfSwitchTypes_Speed_speed_25g corresponds to the value speed_25g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_25g E_OnfSwitchTypes_Speed = 5
+	// OnfSwitchTypes_Speed_speed_400g corresponds to the value speed_400g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_400g E_OnfSwitchTypes_Speed = 6
+	// OnfSwitchTypes_Speed_speed_40g corresponds to the value speed_40g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_40g E_OnfSwitchTypes_Speed = 7
+	// OnfSwitchTypes_Speed_speed_5g corresponds to the value speed_5g of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_5g E_OnfSwitchTypes_Speed = 8
+	// OnfSwitchTypes_Speed_speed_autoneg corresponds to the value speed_autoneg of OnfSwitchTypes_Speed
+	OnfSwitchTypes_Speed_speed_autoneg E_OnfSwitchTypes_Speed = 9
+)
+
+
+// E_OnfTest1Choice_Vehicle_Battery_Material is a derived int64 type which is used to represent
+// the enumerated node OnfTest1Choice_Vehicle_Battery_Material. An additional value named
+// OnfTest1Choice_Vehicle_Battery_Material_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfTest1Choice_Vehicle_Battery_Material int64
+
+// IsYANGGoEnum ensures that OnfTest1Choice_Vehicle_Battery_Material implements the yang.GoEnum
+// interface. This ensures that OnfTest1Choice_Vehicle_Battery_Material can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfTest1Choice_Vehicle_Battery_Material) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfTest1Choice_Vehicle_Battery_Material.
+func (E_OnfTest1Choice_Vehicle_Battery_Material) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfTest1Choice_Vehicle_Battery_Material.
+func (e E_OnfTest1Choice_Vehicle_Battery_Material) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfTest1Choice_Vehicle_Battery_Material")
+}
+
+const (
+	// OnfTest1Choice_Vehicle_Battery_Material_UNSET corresponds to the value UNSET of OnfTest1Choice_Vehicle_Battery_Material
+	OnfTest1Choice_Vehicle_Battery_Material_UNSET E_OnfTest1Choice_Vehicle_Battery_Material = 0
+	// OnfTest1Choice_Vehicle_Battery_Material_other corresponds to the value other of OnfTest1Choice_Vehicle_Battery_Material
+	OnfTest1Choice_Vehicle_Battery_Material_other E_OnfTest1Choice_Vehicle_Battery_Material = 1
+	// OnfTest1Choice_Vehicle_Battery_Material_lithium_ion corresponds to the value lithium_ion of OnfTest1Choice_Vehicle_Battery_Material
+	OnfTest1Choice_Vehicle_Battery_Material_lithium_ion E_OnfTest1Choice_Vehicle_Battery_Material = 2
+	// OnfTest1Choice_Vehicle_Battery_Material_lithium_polymer corresponds to the value lithium_polymer of OnfTest1Choice_Vehicle_Battery_Material
+	OnfTest1Choice_Vehicle_Battery_Material_lithium_polymer E_OnfTest1Choice_Vehicle_Battery_Material = 3
+)
+
+
+// E_OnfTest1Choice_Vehicle_EnginePosition is a derived int64 type which is used to represent
+// the enumerated node OnfTest1Choice_Vehicle_EnginePosition. An additional value named
+// OnfTest1Choice_Vehicle_EnginePosition_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfTest1Choice_Vehicle_EnginePosition int64
+
+// IsYANGGoEnum ensures that OnfTest1Choice_Vehicle_EnginePosition implements the yang.GoEnum
+// interface. This ensures that OnfTest1Choice_Vehicle_EnginePosition can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfTest1Choice_Vehicle_EnginePosition) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfTest1Choice_Vehicle_EnginePosition.
+func (E_OnfTest1Choice_Vehicle_EnginePosition) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfTest1Choice_Vehicle_EnginePosition.
+func (e E_OnfTest1Choice_Vehicle_EnginePosition) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfTest1Choice_Vehicle_EnginePosition")
+}
+
+const (
+	// OnfTest1Choice_Vehicle_EnginePosition_UNSET corresponds to the value UNSET of OnfTest1Choice_Vehicle_EnginePosition
+	OnfTest1Choice_Vehicle_EnginePosition_UNSET E_OnfTest1Choice_Vehicle_EnginePosition = 0
+	// OnfTest1Choice_Vehicle_EnginePosition_front corresponds to the value front of OnfTest1Choice_Vehicle_EnginePosition
+	OnfTest1Choice_Vehicle_EnginePosition_front E_OnfTest1Choice_Vehicle_EnginePosition = 1
+	// OnfTest1Choice_Vehicle_EnginePosition_mid corresponds to the value mid of OnfTest1Choice_Vehicle_EnginePosition
+	OnfTest1Choice_Vehicle_EnginePosition_mid E_OnfTest1Choice_Vehicle_EnginePosition = 2
+	// OnfTest1Choice_Vehicle_EnginePosition_rear corresponds to the value rear of OnfTest1Choice_Vehicle_EnginePosition
+	OnfTest1Choice_Vehicle_EnginePosition_rear E_OnfTest1Choice_Vehicle_EnginePosition = 3
+)
+
+
+// E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType is a derived int64 type which is used to represent
+// the enumerated node OnfTest1Choice_Vehicle_UnderCarriage_TrackType. An additional value named
+// OnfTest1Choice_Vehicle_UnderCarriage_TrackType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType int64
+
+// IsYANGGoEnum ensures that OnfTest1Choice_Vehicle_UnderCarriage_TrackType implements the yang.GoEnum
+// interface. This ensures that OnfTest1Choice_Vehicle_UnderCarriage_TrackType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfTest1Choice_Vehicle_UnderCarriage_TrackType.
+func (E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType.
+func (e E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType")
+}
+
+const (
+	// OnfTest1Choice_Vehicle_UnderCarriage_TrackType_UNSET corresponds to the value UNSET of OnfTest1Choice_Vehicle_UnderCarriage_TrackType
+	OnfTest1Choice_Vehicle_UnderCarriage_TrackType_UNSET E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType = 0
+	// OnfTest1Choice_Vehicle_UnderCarriage_TrackType_steel corresponds to the value steel of OnfTest1Choice_Vehicle_UnderCarriage_TrackType
+	OnfTest1Choice_Vehicle_UnderCarriage_TrackType_steel E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType = 1
+	// OnfTest1Choice_Vehicle_UnderCarriage_TrackType_rubber corresponds to the value rubber of OnfTest1Choice_Vehicle_UnderCarriage_TrackType
+	OnfTest1Choice_Vehicle_UnderCarriage_TrackType_rubber E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType = 2
+)
+
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_OnfSwitchTypes_Speed": {
+		1: {Name: "speed-100g", DefiningModule: "onf-switch-types"},
+		2: {Name: "speed-10g", DefiningModule: "onf-switch-types"},
+		3: {Name: "speed-1g", DefiningModule: "onf-switch-types"},
+		4: {Name: "speed-2-5g", DefiningModule: "onf-switch-types"},
+		5: {Name: "speed-25g", DefiningModule: "onf-switch-types"},
+		6: {Name: "speed-400g", DefiningModule: "onf-switch-types"},
+		7: {Name: "speed-40g", DefiningModule: "onf-switch-types"},
+		8: {Name: "speed-5g", DefiningModule: "onf-switch-types"},
+		9: {Name: "speed-autoneg", DefiningModule: "onf-switch-types"},
+	},
+	"E_OnfTest1Choice_Vehicle_Battery_Material": {
+		1: {Name: "other"},
+		2: {Name: "lithium-ion"},
+		3: {Name: "lithium-polymer"},
+	},
+	"E_OnfTest1Choice_Vehicle_EnginePosition": {
+		1: {Name: "front"},
+		2: {Name: "mid"},
+		3: {Name: "rear"},
+	},
+	"E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType": {
+		1: {Name: "steel"},
+		2: {Name: "rubber"},
+	},
+}
+
+
+var (
+	// ySchema is a byte slice contain a gzip compressed representation of the
+	// YANG schema from which the Go code was generated. When uncompressed the
+	// contents of the byte slice is a JSON document containing an object, keyed
+	// on the name of the generated struct, and containing the JSON marshalled
+	// contents of a goyang yang.Entry struct, which defines the schema for the
+	// fields within the struct.
+	ySchema = []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x73, 0xdb, 0x36,
+		0xbe, 0xe8, 0xff, 0xfe, 0x14, 0xbf, 0xd1, 0xdc, 0x3b, 0xb1, 0xb3, 0xa6, 0x2c, 0xab, 0x76, 0x36,
+		0xf5, 0x4c, 0xa7, 0x75, 0x9b, 0xb4, 0x9b, 0xd9, 0x66, 0xdb, 0x69, 0x93, 0xbd, 0xe7, 0x34, 0x72,
+		0x73, 0x20, 0x12, 0x92, 0x70, 0x42, 0x01, 0x5a, 0x02, 0xb4, 0xad, 0x26, 0xfe, 0xee, 0x77, 0x00,
+		0x90, 0x12, 0xf5, 0x24, 0x00, 0x52, 0x32, 0x6d, 0x43, 0xd3, 0xa9, 0x63, 0x19, 0x00, 0x41, 0xe0,
+		0xf7, 0x7e, 0x7e, 0x3e, 0x00, 0x00, 0x68, 0xfd, 0x0b, 0x8d, 0x71, 0xeb, 0x02, 0x5a, 0x11, 0xbe,
+		0x26, 0x21, 0x6e, 0x1d, 0xeb, 0x6f, 0xff, 0x49, 0x68, 0xd4, 0xba, 0x80, 0xd3, 0xec, 0xd7, 0x1f,
+		0x18, 0x1d, 0x90, 0x61, 0xeb, 0x02, 0x3a, 0xd9, 0x17, 0xaf, 0x48, 0xd2, 0xba, 0x00, 0xbd, 0x04,
+		0x00, 0x40, 0x2b, 0x64, 0x54, 0x9c, 0xa2, 0x85, 0xef, 0x16, 0x96, 0xcf, 0xfe, 0x7e, 0xbc, 0xf8,
+		0xd7, 0x57, 0x98, 0x87, 0x09, 0x99, 0x08, 0xc2, 0xa8, 0x1c, 0xf4, 0x6e, 0x84, 0x41, 0xb0, 0x09,
+		0xc4, 0xf8, 0x1a, 0xc7, 0x20, 0xa7, 0x20, 0x42, 0x71, 0xb2, 0x3c, 0x6b, 0x71, 0x73, 0xb3, 0xaf,
+		0x97, 0x37, 0x39, 0xfb, 0xc3, 0xaf, 0x09, 0x1e, 0x90, 0xdb, 0x95, 0xbd, 0x2d, 0xec, 0x4f, 0x9c,
+		0xb6, 0x8e, 0x57, 0xff, 0xfa, 0x3b, 0x4b, 0x93, 0x10, 0xaf, 0x9d, 0xa9, 0x77, 0x82, 0xa7, 0x37,
+		0x2c, 0x91, 0x9b, 0x69, 0x4d, 0xf4, 0x43, 0x8e, 0xd7, 0x0f, 0xfc, 0x07, 0xe2, 0x97, 0xc9, 0x30,
+		0x1d, 0x63, 0x2a, 0x5a, 0x17, 0x20, 0x92, 0x14, 0x6f, 0x18, 0x58, 0x18, 0x25, 0xf7, 0xb4, 0x32,
+		0xe8, 0x6e, 0xe1, 0x9b, 0xbb, 0xe5, 0xf3, 0x5c, 0xba, 0x96, 0x85, 0xeb, 0xe9, 0xa2, 0xcd, 0x2f,
+		0x52, 0xbc, 0xa6, 0x2e, 0xda, 0xf4, 0x16, 0x6b, 0xae, 0xab, 0x4b, 0xa3, 0x92, 0xeb, 0x2a, 0xb9,
+		0xb6, 0xd2, 0xeb, 0x33, 0xb9, 0x46, 0xb3, 0xeb, 0x34, 0xbd, 0x56, 0xeb, 0xeb, 0xb5, 0xbe, 0x66,
+		0xe3, 0xeb, 0x5e, 0x7f, 0xed, 0x1b, 0xae, 0xbf, 0x14, 0x0c, 0xf2, 0x4f, 0x2b, 0xc6, 0x68, 0xd0,
+		0x45, 0x5b, 0xc7, 0x2c, 0x1c, 0x67, 0x36, 0xbe, 0xe4, 0x65, 0x96, 0xc0, 0xe3, 0x5f, 0xe9, 0x18,
+		0x27, 0x24, 0x04, 0x39, 0x19, 0x08, 0xe5, 0x24, 0xc2, 0xf0, 0x43, 0x0e, 0x24, 0x60, 0xb2, 0xdc,
+		0x00, 0xa5, 0xb1, 0x3c, 0x9a, 0x0f, 0x5b, 0x07, 0x02, 0x00, 0xb4, 0xba, 0xad, 0xad, 0x63, 0xae,
+		0x4a, 0x9e, 0x95, 0xc1, 0x66, 0xa7, 0x64, 0x58, 0x19, 0x8c, 0xda, 0xc0, 0xaa, 0x1d, 0xcc, 0xda,
+		0xc2, 0xae, 0x33, 0x0c, 0x3b, 0xc3, 0xb2, 0x35, 0x4c, 0x6f, 0x87, 0xed, 0x12, 0x18, 0xcf, 0x3f,
+		0xad, 0x77, 0xd3, 0x09, 0xb6, 0x3b, 0xe7, 0x94, 0x50, 0xf1, 0xd2, 0xe4, 0xa8, 0x33, 0xa0, 0x38,
+		0x37, 0x18, 0xfa, 0x1b, 0xa2, 0x43, 0x6c, 0x04, 0xa9, 0x00, 0x60, 0x78, 0x75, 0x00, 0x00, 0xad,
+		0xb7, 0x84, 0xb6, 0x2e, 0x2c, 0x26, 0x00, 0x00, 0xb4, 0xfe, 0x8d, 0xe2, 0x14, 0x6f, 0x26, 0xb5,
+		0x9b, 0x3e, 0xad, 0x1f, 0x13, 0x14, 0x4a, 0xec, 0x7d, 0x45, 0x86, 0x44, 0xf0, 0x72, 0x30, 0x5f,
+		0x3d, 0x62, 0x3c, 0x44, 0x82, 0x5c, 0xcb, 0x67, 0x0f, 0x50, 0xcc, 0xb1, 0xf1, 0xec, 0xbb, 0x63,
+		0x8b, 0x23, 0x41, 0xb7, 0xee, 0x47, 0xf2, 0xd5, 0xc3, 0x39, 0x92, 0x83, 0x1a, 0x0f, 0x6e, 0x6f,
+		0x10, 0xe7, 0x41, 0x6e, 0xf5, 0x4c, 0x1e, 0x1d, 0xcc, 0x95, 0x8e, 0xba, 0xaa, 0x44, 0xd2, 0xf1,
+		0xad, 0x48, 0x50, 0x90, 0x52, 0x2e, 0x50, 0x3f, 0x36, 0x24, 0xee, 0x09, 0x1e, 0xe0, 0x04, 0xd3,
+		0x70, 0x27, 0x44, 0x38, 0xe7, 0x1c, 0xbf, 0xfd, 0xf8, 0x03, 0xbc, 0xe8, 0x9c, 0x75, 0x5a, 0x16,
+		0xa0, 0x63, 0xc9, 0xaf, 0xd7, 0xf1, 0xed, 0xf9, 0xbb, 0x59, 0xc2, 0x81, 0x2b, 0x0b, 0x5f, 0xcb,
+		0xca, 0x67, 0x2f, 0xdf, 0x34, 0x68, 0x3a, 0x70, 0x80, 0x33, 0x2d, 0xd1, 0xf6, 0x2d, 0x25, 0xe0,
+		0xbe, 0xa5, 0x04, 0xfc, 0x6f, 0x16, 0x0b, 0x34, 0xc4, 0xce, 0x12, 0xb0, 0x97, 0x4a, 0x6b, 0x03,
+		0xe9, 0x3d, 0x4b, 0xa5, 0x6f, 0x11, 0x8d, 0x90, 0x60, 0xc9, 0xb4, 0x5c, 0x0a, 0xf3, 0x12, 0xec,
+		0x16, 0xde, 0xd9, 0xf1, 0xe2, 0xc4, 0xf2, 0x91, 0x74, 0xbd, 0x34, 0x61, 0x38, 0xbf, 0x94, 0xfe,
+		0x87, 0x96, 0xf4, 0x3f, 0xb4, 0xa4, 0xff, 0xbf, 0x61, 0x14, 0x01, 0xa3, 0xf1, 0x74, 0x6f, 0x1c,
+		0xa0, 0xeb, 0x39, 0x40, 0x43, 0x38, 0x80, 0x3d, 0x55, 0xe7, 0x22, 0x21, 0x74, 0x68, 0x41, 0xd6,
+		0x4f, 0x5f, 0xee, 0x0a, 0x31, 0x22, 0x4b, 0xc4, 0x88, 0x2c, 0x11, 0xe3, 0x92, 0x32, 0x31, 0xc2,
+		0x09, 0x10, 0x2a, 0xf0, 0x10, 0x27, 0xfb, 0xc0, 0x0c, 0x2f, 0x1b, 0x3d, 0x5c, 0xcc, 0xf0, 0xf2,
+		0x4e, 0x03, 0x99, 0xbb, 0x97, 0x77, 0xec, 0xd0, 0xa2, 0x01, 0xf2, 0x0e, 0xb6, 0x24, 0xeb, 0xd8,
+		0x92, 0xac, 0xcb, 0x49, 0x10, 0x13, 0x2e, 0x3c, 0x41, 0xf7, 0x04, 0x7d, 0xdb, 0x39, 0x13, 0x2a,
+		0x4e, 0x5f, 0x58, 0x10, 0xf4, 0xee, 0xc3, 0x75, 0xc1, 0x74, 0xee, 0x93, 0xa4, 0x4b, 0x90, 0x69,
+		0x20, 0x45, 0xef, 0x74, 0x3c, 0x4d, 0xb7, 0xc2, 0xb0, 0x9f, 0x09, 0x17, 0x97, 0x42, 0x24, 0x66,
+		0x58, 0xf6, 0x96, 0xd0, 0xd7, 0x31, 0x96, 0xf8, 0x6f, 0x78, 0x54, 0xf2, 0x3a, 0x0b, 0x33, 0x4e,
+		0x5f, 0x9e, 0x9d, 0xbd, 0xf8, 0xfb, 0xd9, 0x59, 0xe7, 0xef, 0x5f, 0xfd, 0xbd, 0xf3, 0xf5, 0xf9,
+		0xf9, 0xe9, 0x8b, 0x53, 0x13, 0x89, 0xea, 0x97, 0x24, 0xc2, 0x09, 0x8e, 0xbe, 0x9f, 0xb6, 0x2e,
+		0x80, 0xa6, 0x71, 0xbc, 0x2b, 0x2e, 0x36, 0xb0, 0xe4, 0x62, 0x03, 0x4b, 0x2e, 0xd6, 0x27, 0x14,
+		0x25, 0x8b, 0x2a, 0x7b, 0xe8, 0xf9, 0x98, 0xe7, 0x63, 0x6b, 0xce, 0x59, 0x83, 0x8a, 0x05, 0x23,
+		0xfb, 0xda, 0x60, 0xe8, 0xcf, 0x98, 0x0e, 0xc5, 0xa8, 0x71, 0x9c, 0xac, 0xeb, 0x75, 0x93, 0x07,
+		0x7d, 0x26, 0x4d, 0x57, 0x4e, 0x86, 0x96, 0x64, 0x7d, 0x68, 0x49, 0xd6, 0xbf, 0x67, 0x2c, 0xc6,
+		0x88, 0x7a, 0x67, 0x9c, 0xa7, 0xeb, 0xe5, 0x74, 0x5d, 0xc3, 0x8a, 0x8d, 0x2d, 0xf6, 0x74, 0x57,
+		0x78, 0x31, 0xb2, 0xc4, 0x8b, 0x91, 0x25, 0x5e, 0xbc, 0x7f, 0xff, 0xe6, 0x95, 0x47, 0x0a, 0x8f,
+		0x14, 0xa5, 0xe7, 0x9c, 0xa6, 0x24, 0xb2, 0xf2, 0x4e, 0x18, 0x8c, 0xfd, 0x15, 0x09, 0x81, 0x13,
+		0x6a, 0x2c, 0xec, 0xb4, 0x3e, 0x74, 0x82, 0xaf, 0x51, 0x30, 0xb8, 0x0c, 0x7e, 0xbc, 0xfa, 0xfc,
+		0xf2, 0x2e, 0x28, 0xfe, 0x7a, 0x66, 0xf3, 0xeb, 0x69, 0xf7, 0xae, 0x75, 0x7f, 0xbc, 0x8e, 0x58,
+		0xe2, 0x34, 0xb1, 0xc4, 0xe9, 0x57, 0x48, 0x60, 0x40, 0x34, 0x82, 0x77, 0x64, 0xec, 0xc3, 0x4f,
+		0x3c, 0x72, 0x97, 0x9f, 0x73, 0x84, 0x04, 0x0e, 0x10, 0x8d, 0x02, 0x41, 0xc6, 0xf8, 0xde, 0xb1,
+		0xbc, 0xd7, 0x8b, 0x24, 0xc2, 0xca, 0x1f, 0xdd, 0xfc, 0xc7, 0x3b, 0xfd, 0xe3, 0x62, 0xe1, 0xc7,
+		0x61, 0xaf, 0xd7, 0xee, 0xf5, 0xa2, 0xbf, 0x1d, 0x7d, 0x7b, 0xf8, 0xc7, 0x97, 0x0f, 0xbd, 0xde,
+		0xdf, 0x7a, 0xbd, 0xe0, 0x6a, 0x61, 0xc4, 0xd1, 0x3d, 0xa2, 0xf9, 0xff, 0x5a, 0xa2, 0xf9, 0xff,
+		0x5a, 0xa2, 0xf9, 0x14, 0xd1, 0x61, 0x40, 0x22, 0x4c, 0x05, 0x19, 0x10, 0x9c, 0x78, 0x44, 0xf7,
+		0x88, 0x5e, 0x7a, 0xce, 0x4b, 0x30, 0x53, 0x37, 0xaa, 0x37, 0xd4, 0x78, 0xe1, 0xc3, 0xd2, 0x57,
+		0x8f, 0xc4, 0xc9, 0xca, 0xdb, 0x94, 0x53, 0xaa, 0xcb, 0x9a, 0xb1, 0x13, 0x19, 0x15, 0x05, 0x7f,
+		0x5d, 0x06, 0x7f, 0x7c, 0xbc, 0xca, 0xfe, 0xd1, 0x09, 0xbe, 0xee, 0xf5, 0x82, 0x8f, 0xed, 0xab,
+		0xe7, 0xa6, 0xd4, 0xa7, 0xfd, 0xa5, 0xdd, 0xfe, 0xf2, 0xe1, 0xcf, 0xdb, 0xff, 0xba, 0x6a, 0x3f,
+		0xff, 0xd2, 0xfe, 0xf0, 0xe7, 0xf8, 0xad, 0xfa, 0x47, 0xfb, 0xc3, 0x9f, 0xf1, 0xcf, 0x57, 0xed,
+		0xe7, 0x3b, 0x64, 0x69, 0x56, 0x79, 0x86, 0x97, 0x94, 0x32, 0x81, 0x32, 0x6e, 0xb4, 0x19, 0x0c,
+		0x5b, 0x3c, 0x1c, 0xe1, 0x31, 0x9a, 0x20, 0x45, 0x18, 0x5a, 0x27, 0x8c, 0x0e, 0x02, 0x81, 0xb9,
+		0x38, 0x3d, 0xd1, 0x59, 0xc1, 0x27, 0x5b, 0xb3, 0x4e, 0xf5, 0x0a, 0x22, 0x49, 0x43, 0x41, 0x33,
+		0x1a, 0xf6, 0x0b, 0x1d, 0xbc, 0x93, 0xf3, 0x3f, 0x4a, 0x26, 0x77, 0x7a, 0xa9, 0x7e, 0x74, 0x2f,
+		0x5b, 0x07, 0x66, 0x6f, 0xb4, 0xe6, 0x6d, 0x14, 0xfb, 0x3d, 0x35, 0xc8, 0x8f, 0xcd, 0xc6, 0x99,
+		0xe5, 0xc7, 0xfe, 0xbc, 0x96, 0x1d, 0x6f, 0x9e, 0xbe, 0x9d, 0x0d, 0xfb, 0x04, 0xd9, 0xfa, 0x12,
+		0x64, 0x4b, 0xd9, 0xa6, 0x79, 0x50, 0x9e, 0x09, 0x77, 0x34, 0xe5, 0x8a, 0x66, 0xbe, 0x3d, 0x73,
+		0xe9, 0x27, 0x27, 0xf5, 0x86, 0x74, 0xdd, 0x99, 0x9e, 0xdb, 0xd3, 0xf1, 0x3b, 0x33, 0xa7, 0xa4,
+		0xfd, 0xab, 0x9e, 0x76, 0x9a, 0xf7, 0xae, 0x8e, 0xb4, 0xf8, 0xaa, 0x0a, 0x3d, 0x23, 0xdc, 0x28,
+		0xdf, 0x3f, 0x1b, 0x67, 0x46, 0xcf, 0x2e, 0x81, 0x93, 0xf1, 0x24, 0xc6, 0x3a, 0xc4, 0x87, 0x0d,
+		0xa4, 0x5b, 0x74, 0x40, 0x86, 0x69, 0xa2, 0x58, 0x00, 0x10, 0x81, 0xc7, 0xdc, 0x27, 0xff, 0x37,
+		0x3e, 0xf9, 0x3f, 0xe3, 0xa2, 0x86, 0x8a, 0xa9, 0x1a, 0x6d, 0xa7, 0x96, 0xbe, 0x1b, 0x65, 0x20,
+		0x42, 0x38, 0x7c, 0xc2, 0x53, 0x1c, 0x41, 0x7f, 0x0a, 0x26, 0xeb, 0x78, 0x3d, 0xb4, 0x32, 0x50,
+		0x59, 0x03, 0x97, 0x21, 0x95, 0x6a, 0x42, 0xb4, 0xfb, 0xc3, 0x55, 0x3f, 0xcf, 0xbc, 0xfa, 0xb9,
+		0x7c, 0x24, 0x2f, 0xbd, 0xe7, 0xdc, 0x70, 0xfe, 0x96, 0x2b, 0x69, 0x25, 0x32, 0xe8, 0x31, 0x18,
+		0x1b, 0x5c, 0xc4, 0x0c, 0xf1, 0xe6, 0x53, 0x2c, 0x73, 0x36, 0x60, 0x8c, 0x6e, 0xe1, 0x5a, 0x5e,
+		0x1f, 0x0c, 0x58, 0x02, 0x62, 0x84, 0x41, 0xad, 0xe5, 0xa9, 0xba, 0xa7, 0xea, 0x3e, 0x53, 0xc3,
+		0x93, 0x74, 0xe8, 0x9e, 0x9f, 0x7b, 0xa2, 0x5e, 0x1f, 0x51, 0x37, 0x80, 0xce, 0x65, 0xa2, 0x4e,
+		0xa8, 0x35, 0x51, 0xcf, 0x54, 0x3a, 0xb5, 0x00, 0x08, 0x06, 0xd2, 0x30, 0x07, 0x49, 0x1a, 0x63,
+		0x0e, 0x84, 0xc2, 0x7f, 0x5f, 0xfe, 0xeb, 0xa7, 0x36, 0xbc, 0x25, 0x14, 0xc6, 0x29, 0x17, 0xd0,
+		0xc7, 0xd0, 0x4b, 0x3b, 0x9d, 0xaf, 0xc2, 0x6f, 0xc0, 0x80, 0x81, 0x78, 0xc2, 0x0f, 0xe0, 0xcb,
+		0x17, 0x00, 0x78, 0x26, 0xf1, 0x00, 0xe8, 0xa1, 0x67, 0x12, 0x2e, 0xe4, 0x1f, 0xee, 0x8f, 0x49,
+		0x60, 0xab, 0x34, 0x6d, 0x3d, 0xdc, 0x96, 0x39, 0xcc, 0x2a, 0x0f, 0x81, 0x60, 0xa0, 0x33, 0xbd,
+		0x81, 0x50, 0x25, 0xfa, 0x77, 0x51, 0x69, 0xc5, 0xcf, 0x65, 0x3c, 0xf6, 0x8c, 0xe0, 0xc1, 0x31,
+		0x02, 0x7b, 0xe2, 0x2e, 0xa1, 0x24, 0xc1, 0x03, 0x1b, 0xc3, 0xce, 0xdf, 0xcd, 0x9c, 0xb0, 0xda,
+		0x79, 0xb8, 0xe0, 0x32, 0x3c, 0xd1, 0x30, 0xd9, 0xda, 0x01, 0x7e, 0x89, 0xdb, 0x60, 0xc2, 0x6e,
+		0x70, 0x62, 0x8e, 0x62, 0xb3, 0x19, 0x96, 0xd6, 0xd2, 0x04, 0x51, 0x3e, 0x26, 0x02, 0x8c, 0x26,
+		0x7b, 0x54, 0x7a, 0x3a, 0xa8, 0x94, 0xda, 0xa6, 0xc9, 0xbe, 0xf0, 0x95, 0x4a, 0x77, 0x2f, 0x13,
+		0xf8, 0xdc, 0x22, 0x4b, 0xc4, 0xd8, 0x83, 0x9c, 0x64, 0xe5, 0x20, 0xfb, 0x27, 0x9e, 0x96, 0x38,
+		0xb6, 0xcc, 0xf2, 0x6d, 0xcd, 0xf3, 0x6c, 0x97, 0xf2, 0x6b, 0xb7, 0x78, 0x03, 0xcc, 0x92, 0x68,
+		0x37, 0xbd, 0x99, 0x45, 0xe1, 0xcc, 0x96, 0xd4, 0xe7, 0xeb, 0x08, 0x48, 0x98, 0xf9, 0x09, 0xd3,
+		0x71, 0x1f, 0x27, 0x87, 0xed, 0x93, 0x99, 0x29, 0xe2, 0x68, 0x66, 0x2b, 0x58, 0xfe, 0x1b, 0xba,
+		0x3d, 0x32, 0xa1, 0x6a, 0x8b, 0x8c, 0xd2, 0x90, 0x8d, 0xcc, 0x98, 0xd4, 0x88, 0x70, 0x20, 0x1c,
+		0x90, 0xb6, 0x5c, 0x70, 0x81, 0x84, 0xba, 0x00, 0x53, 0xae, 0x62, 0xc9, 0xbe, 0x60, 0x89, 0x85,
+		0x45, 0x85, 0xbd, 0x5b, 0x50, 0x0c, 0x57, 0x5e, 0x06, 0x2b, 0xfc, 0x6c, 0xd3, 0xeb, 0xd7, 0x84,
+		0xaa, 0x26, 0x01, 0x1c, 0xaf, 0x93, 0x84, 0x25, 0x97, 0x93, 0xc9, 0x3b, 0x34, 0xb4, 0xbf, 0x3f,
+		0x05, 0x2a, 0x6a, 0xf3, 0x7b, 0xba, 0x31, 0x2c, 0x77, 0x1b, 0xa0, 0xc9, 0x24, 0x10, 0x68, 0x78,
+		0x2f, 0x77, 0x56, 0x78, 0xe5, 0x7d, 0xdf, 0xd2, 0x5b, 0xcc, 0x39, 0x1a, 0x62, 0xc7, 0x6b, 0x92,
+		0xd8, 0x3e, 0x33, 0x10, 0xc6, 0x98, 0x73, 0x10, 0x23, 0x44, 0x81, 0x25, 0x80, 0xff, 0x93, 0xa2,
+		0x18, 0x04, 0x03, 0x53, 0xaf, 0x53, 0xbd, 0xb7, 0x39, 0xce, 0x5e, 0xeb, 0xde, 0x6e, 0xd3, 0xea,
+		0x64, 0xea, 0xba, 0xf4, 0x7a, 0xe3, 0x8d, 0x76, 0x1c, 0xfb, 0xb9, 0x35, 0x02, 0x09, 0xca, 0x63,
+		0x3f, 0x25, 0xab, 0xae, 0x18, 0xfb, 0x49, 0xb8, 0x38, 0x33, 0x0b, 0x95, 0x3a, 0x33, 0x8e, 0x94,
+		0x92, 0xa3, 0xe1, 0x86, 0x88, 0x11, 0x20, 0xc8, 0x54, 0x62, 0x20, 0x34, 0xc2, 0xb7, 0xcd, 0x08,
+		0x90, 0xc2, 0x0f, 0x31, 0x42, 0x0a, 0xef, 0x2d, 0x44, 0x8a, 0x58, 0x58, 0xd6, 0x88, 0xad, 0x59,
+		0xed, 0x67, 0x42, 0x3f, 0x29, 0x7b, 0x9a, 0x82, 0x7c, 0x15, 0x18, 0xc5, 0x1f, 0x86, 0xda, 0x8f,
+		0x1f, 0xa3, 0xde, 0x8f, 0x9f, 0x9e, 0x0d, 0x4d, 0x9c, 0x5e, 0x64, 0xd4, 0x57, 0x9c, 0x5e, 0x68,
+		0x30, 0x94, 0xff, 0x52, 0x24, 0x76, 0x47, 0xb9, 0x70, 0x67, 0x96, 0xb5, 0xd6, 0xcf, 0xfa, 0x2e,
+		0xb5, 0xe7, 0xce, 0x10, 0x30, 0xaa, 0x10, 0xeb, 0x0c, 0x01, 0xce, 0x35, 0x2e, 0x8f, 0x5b, 0x1e,
+		0xb7, 0x9e, 0x66, 0xdc, 0xa1, 0x37, 0xab, 0x01, 0x78, 0xb3, 0xda, 0x4e, 0xf2, 0x9b, 0x15, 0x8d,
+		0xb5, 0xa0, 0xe9, 0x7a, 0xbc, 0xad, 0x03, 0x72, 0x26, 0x46, 0x13, 0x0a, 0x68, 0xfe, 0x1b, 0x74,
+		0x65, 0x60, 0x39, 0x07, 0xc4, 0x95, 0x70, 0x2d, 0xfd, 0x94, 0xa6, 0x64, 0xfe, 0xd4, 0x93, 0xf9,
+		0x87, 0x47, 0xe6, 0xcb, 0x04, 0xf6, 0xfc, 0xd3, 0x8a, 0x08, 0x9f, 0xc4, 0x68, 0x6a, 0x94, 0xe2,
+		0xb0, 0x72, 0x3b, 0xc5, 0xc9, 0x86, 0xe7, 0xb0, 0x04, 0xb0, 0x52, 0xb5, 0x57, 0xbf, 0xa0, 0x18,
+		0xb2, 0xd5, 0x94, 0x7c, 0x0f, 0x48, 0x88, 0x84, 0xf4, 0x53, 0x81, 0x73, 0xf0, 0x8d, 0xc8, 0x40,
+		0xb9, 0xd6, 0x05, 0xc4, 0x8a, 0x75, 0x68, 0x33, 0x00, 0x37, 0x7d, 0xae, 0x99, 0xcc, 0x62, 0x0d,
+		0xd4, 0x2e, 0xc0, 0xed, 0x08, 0xe4, 0xae, 0xc0, 0x5e, 0x19, 0xe8, 0x2b, 0x03, 0xbf, 0x3b, 0x12,
+		0x58, 0x12, 0x76, 0xc3, 0xbb, 0x32, 0x96, 0x81, 0xdc, 0x65, 0x21, 0x17, 0x99, 0xc8, 0x55, 0x36,
+		0xca, 0x3f, 0x76, 0xe0, 0x00, 0xae, 0xb2, 0xd2, 0x8a, 0x80, 0x60, 0x29, 0x33, 0xd5, 0x26, 0x27,
+		0x54, 0x97, 0x17, 0x2c, 0x01, 0xa7, 0xb2, 0x4c, 0xb5, 0x72, 0x74, 0xe7, 0x0f, 0xff, 0xe8, 0x0e,
+		0x76, 0x78, 0xd0, 0xf7, 0x06, 0xd1, 0x1d, 0x0f, 0xd2, 0xae, 0x67, 0xd7, 0xed, 0x3c, 0x35, 0x98,
+		0x36, 0x1e, 0x7d, 0xb5, 0x47, 0x27, 0xd5, 0xe0, 0x13, 0x9e, 0x9e, 0xda, 0x4b, 0x74, 0x7a, 0x9a,
+		0x9b, 0x2c, 0x37, 0x60, 0x09, 0x26, 0x43, 0x2a, 0x15, 0x0d, 0x38, 0x85, 0x40, 0xea, 0x18, 0xb9,
+		0xd5, 0xf6, 0x1c, 0x9d, 0xd8, 0x2c, 0xec, 0x85, 0x35, 0x2f, 0xac, 0x01, 0x00, 0x54, 0x13, 0xd6,
+		0xcc, 0x8d, 0xc2, 0x2e, 0xc6, 0xe1, 0xed, 0x46, 0x62, 0xac, 0xac, 0xc4, 0xe7, 0xea, 0x5f, 0x0a,
+		0xf2, 0xf7, 0x8c, 0xfa, 0x5d, 0x37, 0xd4, 0xef, 0xd6, 0x80, 0xfa, 0xdd, 0x75, 0xa8, 0xdf, 0xf5,
+		0xa8, 0xef, 0x51, 0xff, 0x49, 0xa2, 0x7e, 0x77, 0x4f, 0x51, 0x0a, 0xc7, 0xa5, 0xae, 0xe7, 0x19,
+		0x7f, 0x07, 0x13, 0x54, 0x7f, 0x8c, 0x2d, 0x34, 0x76, 0xd0, 0x46, 0xdb, 0x28, 0x2a, 0x30, 0xff,
+		0x38, 0x40, 0x72, 0xc8, 0x68, 0x88, 0xc4, 0xe1, 0x33, 0x25, 0x5e, 0xc3, 0xb3, 0x63, 0xd0, 0x26,
+		0x88, 0xc3, 0x76, 0xfb, 0xe4, 0x3b, 0x12, 0x1d, 0x1d, 0xc3, 0xb3, 0xa0, 0xf0, 0xe5, 0xc9, 0x77,
+		0xea, 0x82, 0xd7, 0x7f, 0xdd, 0x3d, 0x3a, 0x82, 0x6f, 0xe6, 0xdf, 0x15, 0x8c, 0x77, 0x47, 0x36,
+		0x88, 0xe2, 0x12, 0x64, 0xb8, 0x4a, 0x4a, 0x67, 0xd1, 0x76, 0x1c, 0xa9, 0x74, 0x4a, 0xa7, 0x98,
+		0xc3, 0xaa, 0xa4, 0x16, 0x6a, 0x89, 0x41, 0xac, 0x8d, 0xee, 0xc2, 0xe6, 0x98, 0xc4, 0xf5, 0xa7,
+		0xb4, 0x23, 0x3d, 0xc5, 0xc6, 0xb5, 0xe4, 0x12, 0xb2, 0xb8, 0x02, 0x0d, 0x05, 0x50, 0x84, 0x01,
+		0x4b, 0xc6, 0xe8, 0xbe, 0x00, 0xc0, 0x35, 0xa4, 0x71, 0x77, 0x20, 0xb0, 0xe6, 0x68, 0x9a, 0x72,
+		0xeb, 0xb6, 0x21, 0x90, 0x5b, 0xaf, 0x3d, 0x0f, 0xf9, 0xd3, 0xef, 0x28, 0x70, 0x94, 0x11, 0x2a,
+		0x88, 0xc9, 0x27, 0x0c, 0x19, 0xf9, 0xd3, 0xe1, 0xd1, 0xed, 0xf6, 0x09, 0x89, 0xd4, 0x3f, 0x71,
+		0xa0, 0xbf, 0x51, 0x44, 0x6f, 0xe5, 0x9b, 0xae, 0xfe, 0xe6, 0xd9, 0xbd, 0xc2, 0x92, 0x7d, 0x40,
+		0xe5, 0x7e, 0x60, 0x69, 0x97, 0xe7, 0xbd, 0x2b, 0x00, 0x6d, 0x44, 0x67, 0x31, 0xc3, 0x30, 0x4e,
+		0xab, 0x70, 0xce, 0xb3, 0x13, 0x23, 0x6f, 0x2f, 0x98, 0x05, 0x77, 0x9e, 0xe9, 0xff, 0x5f, 0xb6,
+		0xf6, 0x99, 0x1c, 0xb2, 0x31, 0xa8, 0x6f, 0xc7, 0xa9, 0x21, 0x76, 0x72, 0x63, 0xb5, 0x6c, 0x91,
+		0x3a, 0x23, 0x78, 0xcf, 0xaa, 0x05, 0xf0, 0x9e, 0x55, 0x8d, 0xdf, 0x3d, 0x37, 0x8b, 0xdf, 0x3d,
+		0x77, 0x88, 0xdf, 0xd5, 0xa1, 0x06, 0x3e, 0x6c, 0xf7, 0x01, 0x84, 0xed, 0x1a, 0x19, 0x89, 0x67,
+		0xc7, 0x69, 0x60, 0xc2, 0x5d, 0x86, 0x0b, 0x39, 0x05, 0x58, 0xd6, 0xe3, 0xf6, 0x1c, 0x02, 0x30,
+		0xf2, 0xa0, 0xfa, 0x08, 0xc3, 0x1a, 0x80, 0xca, 0x1e, 0xb8, 0xcc, 0x58, 0xa8, 0x8f, 0x30, 0xf4,
+		0x11, 0x86, 0xb5, 0xb8, 0x0e, 0x7d, 0x84, 0xa1, 0xfd, 0xfc, 0x2d, 0x77, 0xd2, 0x32, 0x32, 0xfb,
+		0x17, 0x89, 0x79, 0xd7, 0x9e, 0x98, 0x77, 0x17, 0x88, 0xb9, 0x4e, 0x53, 0xf5, 0xc4, 0xdc, 0x13,
+		0xf3, 0xa7, 0x58, 0xab, 0xaa, 0xeb, 0x29, 0x79, 0xd5, 0xc0, 0x19, 0x4f, 0xc9, 0xd7, 0x7d, 0x94,
+		0xfb, 0xea, 0x1c, 0xd9, 0xe5, 0xff, 0x9c, 0xdb, 0xc6, 0x8a, 0x53, 0xa6, 0xfd, 0xb5, 0xf3, 0x48,
+		0x5b, 0x2f, 0xa7, 0x7b, 0xd2, 0xbe, 0xed, 0xa4, 0xbd, 0x9c, 0x0e, 0xe0, 0xe5, 0x74, 0x4f, 0xdd,
+		0x4d, 0xa8, 0xbb, 0x8b, 0x0d, 0x55, 0x19, 0x4b, 0xb6, 0xc8, 0xe5, 0xad, 0xd7, 0xb7, 0x82, 0x6f,
+		0x45, 0x14, 0x73, 0x43, 0xd6, 0xad, 0xb8, 0x18, 0x26, 0x2c, 0x35, 0x71, 0x74, 0x55, 0x33, 0x67,
+		0x45, 0x78, 0xcc, 0x28, 0x17, 0x09, 0x12, 0x98, 0xc3, 0xfc, 0x91, 0x96, 0x27, 0x77, 0xf5, 0xe8,
+		0x8d, 0xcb, 0xf7, 0x59, 0x8a, 0x28, 0x0f, 0x32, 0x38, 0x47, 0xb0, 0x10, 0x35, 0xb0, 0x3e, 0x96,
+		0x60, 0x35, 0x94, 0x40, 0xcb, 0x1f, 0xfb, 0x2c, 0x51, 0x54, 0x25, 0x6a, 0xe0, 0xf1, 0x54, 0x2a,
+		0xaa, 0x10, 0x15, 0xd0, 0x80, 0x82, 0x45, 0x1a, 0x6a, 0xec, 0x1c, 0xfe, 0x0f, 0xbf, 0x66, 0xd1,
+		0xe2, 0x5b, 0x3f, 0x98, 0xb2, 0x45, 0xd9, 0xb6, 0x4b, 0xdc, 0xc6, 0xe7, 0x28, 0xf3, 0x19, 0xaf,
+		0x38, 0x88, 0xed, 0xfd, 0xf1, 0x0f, 0xbf, 0xa0, 0x51, 0x9d, 0x67, 0xf6, 0x64, 0x4b, 0x1d, 0x9d,
+		0x57, 0x73, 0x94, 0x9e, 0x1b, 0x3b, 0x4a, 0x0f, 0xb6, 0xbc, 0xd9, 0x66, 0xc9, 0xeb, 0xf3, 0x41,
+		0x0d, 0x92, 0x96, 0x31, 0xc8, 0x39, 0x49, 0x56, 0x77, 0x07, 0x5b, 0x24, 0xa9, 0xd6, 0x65, 0x3a,
+		0x94, 0x0b, 0xe2, 0xc8, 0xe6, 0xf5, 0x72, 0xaa, 0x30, 0x0f, 0x7c, 0x35, 0xf5, 0x13, 0x47, 0x11,
+		0x20, 0x08, 0x59, 0x2a, 0xf9, 0x16, 0x1b, 0x00, 0xc5, 0x37, 0xca, 0xe6, 0xc0, 0x41, 0x30, 0xd8,
+		0xbe, 0x92, 0x77, 0x1c, 0x97, 0xc0, 0xc3, 0x3e, 0x1d, 0xc7, 0xdb, 0x4b, 0x80, 0xad, 0x72, 0x8f,
+		0x92, 0x88, 0x07, 0xa8, 0xa5, 0x24, 0x98, 0x21, 0xa8, 0x18, 0x83, 0x8c, 0x0d, 0xe8, 0x58, 0x82,
+		0x90, 0x2b, 0x93, 0x7b, 0xfc, 0x66, 0xa9, 0xb2, 0x78, 0x22, 0x3b, 0xd5, 0xcf, 0x5e, 0x05, 0xac,
+		0x45, 0x15, 0xb4, 0x53, 0x09, 0xcb, 0x0f, 0xae, 0xac, 0xb0, 0xc8, 0xb9, 0x1d, 0x2a, 0x9e, 0x57,
+		0x40, 0xc5, 0xad, 0xd1, 0x3d, 0x1e, 0x03, 0x1f, 0x0f, 0x06, 0x96, 0x59, 0xa3, 0xca, 0x65, 0xa3,
+		0xe5, 0x8f, 0xfd, 0x01, 0x5b, 0x58, 0xa9, 0xea, 0x3d, 0x66, 0x5b, 0xab, 0x95, 0xd9, 0xc9, 0x5f,
+		0xf9, 0x94, 0x1b, 0x77, 0xab, 0x97, 0x9d, 0xf5, 0xcb, 0x0e, 0xe6, 0xe0, 0x7e, 0xac, 0x61, 0x1b,
+		0xe8, 0xad, 0xcf, 0xa9, 0xa9, 0x8e, 0xcd, 0x9b, 0x09, 0xe8, 0x13, 0xc9, 0xa9, 0x71, 0xb1, 0xae,
+		0xd5, 0x7d, 0xf7, 0xcd, 0x4b, 0xa7, 0x71, 0xb0, 0xbe, 0xed, 0xe1, 0xae, 0x2b, 0x67, 0xd2, 0xec,
+		0xdf, 0x3a, 0xb7, 0x1b, 0x50, 0x69, 0x52, 0xb6, 0xcc, 0xde, 0xad, 0x77, 0x0e, 0x80, 0xd6, 0x4c,
+		0x2f, 0xa7, 0xa5, 0x05, 0xca, 0x40, 0x06, 0xd8, 0xce, 0xf3, 0x0d, 0x0c, 0x3a, 0x94, 0x89, 0x43,
+		0x5d, 0xb7, 0xf7, 0x03, 0xc7, 0x22, 0xc8, 0x1a, 0xb1, 0xf1, 0xc3, 0x01, 0x8b, 0x63, 0x76, 0x43,
+		0xe8, 0x30, 0xe0, 0xa4, 0x1f, 0x13, 0x3a, 0xbc, 0x98, 0x95, 0xf7, 0xcd, 0x9a, 0x53, 0x1d, 0x43,
+		0xfe, 0xaf, 0xa3, 0xab, 0x6d, 0x3c, 0xdd, 0x8a, 0x87, 0xcf, 0xf6, 0x75, 0x8d, 0x62, 0x12, 0x29,
+		0x4b, 0x29, 0x0c, 0x10, 0x89, 0x39, 0x90, 0xc1, 0xec, 0x79, 0x40, 0x38, 0x50, 0x26, 0x20, 0xa5,
+		0xe4, 0x3f, 0x29, 0xce, 0x8b, 0x3a, 0x96, 0x96, 0x7f, 0xb7, 0xc5, 0x4a, 0x77, 0x66, 0xed, 0x84,
+		0x73, 0x0b, 0x38, 0xe6, 0xfc, 0xfa, 0x3b, 0xd0, 0xa3, 0x6d, 0xb8, 0xee, 0x82, 0x36, 0xdd, 0x45,
+		0x26, 0x5d, 0x37, 0x5c, 0xaf, 0xc4, 0x96, 0x87, 0x56, 0xbf, 0x94, 0xe2, 0x3b, 0xed, 0xea, 0x9c,
+		0x4d, 0x39, 0xde, 0x4a, 0xaf, 0x38, 0xb5, 0x2d, 0x05, 0x17, 0x7d, 0x0c, 0x09, 0x9e, 0x60, 0x24,
+		0x49, 0xf2, 0xae, 0xf1, 0xc2, 0x96, 0x3b, 0x55, 0xbf, 0x04, 0xd3, 0xf7, 0xdd, 0x31, 0xe5, 0x5e,
+		0xa3, 0xb1, 0x4a, 0xab, 0x07, 0x97, 0x2d, 0x2e, 0xab, 0xd0, 0xe3, 0x77, 0x6c, 0x02, 0x31, 0xbe,
+		0xc6, 0x31, 0x10, 0x0e, 0x7a, 0x41, 0x51, 0xbf, 0xc5, 0x5d, 0x6f, 0x73, 0x97, 0x36, 0xf7, 0xb5,
+		0xef, 0x51, 0xfd, 0xd4, 0xb7, 0xfa, 0xa2, 0x4a, 0xbc, 0x6b, 0x65, 0x5e, 0xb5, 0x35, 0xe7, 0xb1,
+		0xdd, 0x8f, 0xd6, 0x3a, 0x58, 0xbf, 0xdb, 0xc2, 0xbe, 0x5a, 0x6a, 0xe9, 0x7e, 0xa0, 0xd4, 0xa8,
+		0x95, 0x5d, 0x15, 0x35, 0xeb, 0xf9, 0xa8, 0xe3, 0x83, 0x2d, 0xec, 0xb3, 0x75, 0x09, 0x1c, 0x87,
+		0x8c, 0x46, 0x20, 0x66, 0x27, 0x3c, 0xeb, 0x9e, 0x0a, 0x01, 0x28, 0x2d, 0x8e, 0x51, 0x25, 0x96,
+		0x69, 0xe5, 0x6d, 0x1e, 0xc7, 0xca, 0xdb, 0xf0, 0x3a, 0x22, 0x02, 0xf8, 0x74, 0xdc, 0x67, 0x31,
+		0xf0, 0x11, 0x4b, 0xe3, 0x28, 0x47, 0xa1, 0x6b, 0x22, 0x19, 0xfd, 0xca, 0xd3, 0xd7, 0xdb, 0x2b,
+		0xe7, 0xf6, 0xc9, 0xa5, 0x58, 0xef, 0x6d, 0xf6, 0xc8, 0xed, 0x5d, 0x1f, 0xcb, 0x20, 0xd9, 0xd8,
+		0xbc, 0xe8, 0xe6, 0x33, 0x5c, 0xd7, 0xb5, 0x71, 0xbb, 0xe7, 0x73, 0x93, 0x2b, 0xa8, 0x95, 0x75,
+		0x12, 0x2d, 0x4f, 0x23, 0xd5, 0xe3, 0x0c, 0xfd, 0x83, 0xcb, 0x97, 0x59, 0xe2, 0x0d, 0xec, 0x94,
+		0x79, 0x03, 0xbb, 0xb5, 0x78, 0x03, 0x1f, 0xa2, 0x33, 0xb0, 0x2e, 0x5f, 0x60, 0x69, 0x9c, 0xb0,
+		0x79, 0xfb, 0x4d, 0x83, 0xb6, 0x9b, 0x86, 0xb9, 0x1e, 0x66, 0xe6, 0x51, 0x73, 0xa3, 0xfe, 0x3c,
+		0x81, 0xa1, 0xd3, 0x31, 0x2d, 0x25, 0xe6, 0x1a, 0xdd, 0x6a, 0x1f, 0xd5, 0x7a, 0x67, 0x66, 0xdb,
+		0xb5, 0x7f, 0xdd, 0x6e, 0x33, 0x5f, 0xb7, 0xde, 0x20, 0x15, 0xe3, 0x9c, 0xf8, 0x6e, 0xdf, 0x2c,
+		0x29, 0xbe, 0xdb, 0x37, 0xa7, 0x66, 0x44, 0xd9, 0x18, 0xe5, 0x2c, 0x60, 0x83, 0x8c, 0xb8, 0x11,
+		0x81, 0xc7, 0x95, 0xf3, 0xe3, 0x3d, 0x61, 0xab, 0x4a, 0xd8, 0xca, 0x9b, 0x5a, 0xa9, 0x58, 0x03,
+		0xf3, 0xbe, 0x56, 0x26, 0xa1, 0x09, 0xcb, 0xbd, 0xac, 0x47, 0x19, 0x70, 0xd8, 0xc4, 0x35, 0xf8,
+		0x5e, 0xd6, 0xee, 0xb0, 0x64, 0x0d, 0x53, 0x86, 0x44, 0xc9, 0xe7, 0x51, 0x3a, 0xa7, 0x95, 0x74,
+		0x7c, 0xa6, 0xcd, 0xf2, 0x91, 0x74, 0xcf, 0xcf, 0x7d, 0xaa, 0x8d, 0xe1, 0xfc, 0xb2, 0x44, 0xca,
+		0xaf, 0x42, 0xbb, 0x44, 0xca, 0xaf, 0x42, 0xeb, 0xe8, 0x98, 0xcc, 0x23, 0x30, 0x53, 0x5a, 0x80,
+		0x50, 0x10, 0x19, 0x65, 0xaf, 0x9b, 0xa6, 0x77, 0x3d, 0x4d, 0x7f, 0xb0, 0x34, 0xdd, 0x27, 0x50,
+		0x3e, 0x71, 0xb2, 0xee, 0x13, 0x28, 0x8d, 0xa9, 0xba, 0x53, 0x11, 0xba, 0x6d, 0xfd, 0x82, 0x7d,
+		0x1d, 0xba, 0x45, 0x43, 0x70, 0x66, 0x87, 0x3d, 0xd9, 0xaa, 0xd1, 0x42, 0x99, 0x75, 0xf8, 0xfb,
+		0xdf, 0xe5, 0x22, 0xba, 0xa9, 0xf4, 0xf7, 0x8f, 0x37, 0xd7, 0xe2, 0xd5, 0x3c, 0x1e, 0x50, 0x2b,
+		0xf1, 0xd6, 0x69, 0x16, 0x95, 0x8c, 0xf6, 0xeb, 0x6d, 0xe6, 0x86, 0x97, 0x63, 0x62, 0xbf, 0x97,
+		0x82, 0x4f, 0x80, 0x44, 0x20, 0xd8, 0x24, 0x50, 0xd6, 0xf6, 0xcd, 0x46, 0xfc, 0xd5, 0xa1, 0x65,
+		0x96, 0x7c, 0x39, 0x03, 0x90, 0x50, 0x22, 0xd1, 0xdc, 0x9c, 0x7f, 0x48, 0x99, 0x80, 0x04, 0x87,
+		0x6c, 0x3c, 0xc6, 0x34, 0xc2, 0x11, 0xf4, 0x53, 0x31, 0x8b, 0xb6, 0xe0, 0xe9, 0x64, 0xc2, 0x12,
+		0x81, 0xa3, 0xa3, 0x0d, 0x96, 0xfa, 0xce, 0x26, 0x4b, 0x7d, 0xe7, 0xc9, 0x5a, 0xea, 0x37, 0xca,
+		0x23, 0xe5, 0xf2, 0xc7, 0x36, 0x79, 0x43, 0x56, 0xb9, 0x17, 0x38, 0xa1, 0x1b, 0x05, 0x8c, 0xd6,
+		0x87, 0xcb, 0xe0, 0x8f, 0xab, 0xcf, 0x5f, 0xdd, 0x05, 0x1f, 0x3a, 0xc1, 0xd7, 0x57, 0xcf, 0x5b,
+		0x96, 0xfe, 0xac, 0x47, 0x94, 0x5b, 0xb5, 0x1e, 0xb7, 0x08, 0x97, 0x6e, 0xb7, 0xcd, 0x08, 0xa5,
+		0xff, 0x5e, 0x8a, 0x45, 0x84, 0x8b, 0x15, 0x2c, 0xb2, 0xf5, 0x63, 0x75, 0xbc, 0x1f, 0x6b, 0xe9,
+		0xa3, 0x8e, 0x3f, 0x20, 0x91, 0x99, 0xe9, 0x37, 0x20, 0xa6, 0x9e, 0xac, 0x2c, 0x6c, 0x85, 0x44,
+		0x98, 0x0a, 0x32, 0x20, 0x38, 0x51, 0xae, 0xca, 0x5c, 0x2b, 0x2c, 0x4d, 0x73, 0xeb, 0xec, 0x27,
+		0xcd, 0xcd, 0x3b, 0xb6, 0x4c, 0xce, 0xa9, 0x54, 0x6f, 0x9b, 0xd3, 0xcf, 0x0a, 0x2e, 0x88, 0xad,
+		0x3d, 0x58, 0x67, 0x7b, 0xd9, 0xd2, 0x6c, 0x75, 0x19, 0x04, 0x13, 0xac, 0x3a, 0xa6, 0x86, 0x92,
+		0x5e, 0x28, 0xc8, 0x93, 0x73, 0x01, 0x53, 0x91, 0x4c, 0x4b, 0x23, 0x66, 0x3c, 0x1c, 0x36, 0x10,
+		0x0e, 0xcb, 0xdb, 0xda, 0x98, 0xb4, 0xb1, 0x99, 0xb7, 0xad, 0x29, 0xa4, 0x59, 0x77, 0xd1, 0x89,
+		0x82, 0xac, 0x5a, 0x24, 0xf8, 0x4c, 0x1d, 0x5b, 0x4f, 0x30, 0x1b, 0x2f, 0xdf, 0x4b, 0x5d, 0x86,
+		0xdb, 0xca, 0xf7, 0x5b, 0xb5, 0xcb, 0x72, 0xad, 0xd2, 0x49, 0x9b, 0xdc, 0xae, 0x45, 0xd6, 0x16,
+		0x36, 0xb4, 0x91, 0x5f, 0x6d, 0xd2, 0x3d, 0xe4, 0x59, 0x98, 0x85, 0x0d, 0xf1, 0x1b, 0x22, 0xc2,
+		0xd1, 0x66, 0xd1, 0x28, 0xfb, 0x7b, 0x99, 0x68, 0x34, 0x46, 0x14, 0x0d, 0x71, 0x04, 0x11, 0xbe,
+		0x26, 0xe1, 0xcc, 0xfa, 0x3a, 0x40, 0xfd, 0x84, 0x84, 0x79, 0xb0, 0x10, 0xe1, 0x80, 0x28, 0xe0,
+		0x5b, 0x9d, 0xf4, 0xc1, 0x06, 0x80, 0xe6, 0x52, 0xd4, 0x42, 0xa6, 0x71, 0xc4, 0xd2, 0x7e, 0x8c,
+		0x65, 0xe6, 0x1b, 0x8e, 0x80, 0xa7, 0x7d, 0xf5, 0xb7, 0x43, 0xa9, 0x8d, 0x1c, 0xb5, 0x7b, 0xf4,
+		0x47, 0x96, 0x00, 0x67, 0x92, 0x8c, 0xca, 0xa8, 0x65, 0x08, 0x99, 0x5c, 0xee, 0x96, 0x88, 0xa9,
+		0x7a, 0xa4, 0xde, 0xaf, 0x7c, 0x96, 0xd2, 0x92, 0x12, 0x3c, 0x18, 0xe0, 0x08, 0x04, 0xcb, 0xfe,
+		0x10, 0x8c, 0x59, 0x84, 0x63, 0xb8, 0x19, 0x91, 0x70, 0x04, 0x09, 0x96, 0x6c, 0x25, 0x14, 0x5c,
+		0xcd, 0x0c, 0x47, 0x4c, 0x6e, 0x9d, 0x0d, 0x40, 0x3e, 0xa9, 0xdd, 0xa3, 0x97, 0x51, 0x44, 0x74,
+		0x63, 0xec, 0x78, 0xba, 0x34, 0x62, 0x61, 0x31, 0x44, 0x23, 0x35, 0x65, 0xb6, 0x9e, 0x1a, 0x2c,
+		0xbf, 0x09, 0xf8, 0x04, 0xcb, 0xa7, 0x8f, 0x90, 0x80, 0x10, 0x51, 0xe8, 0xab, 0x35, 0x38, 0xa6,
+		0xed, 0x1e, 0x55, 0x7e, 0xc7, 0xbc, 0x11, 0x3c, 0xa0, 0x98, 0x51, 0x0c, 0x11, 0x03, 0xa9, 0x92,
+		0x0d, 0xc9, 0xb5, 0x64, 0x12, 0x2c, 0x1d, 0xce, 0xf7, 0x48, 0x18, 0x05, 0x46, 0x67, 0x2b, 0x03,
+		0xc7, 0x31, 0xd6, 0xdf, 0x1e, 0x22, 0xb5, 0xff, 0xa9, 0x7c, 0xc2, 0x33, 0x91, 0xc7, 0x68, 0xf5,
+		0xe8, 0x24, 0xc1, 0x11, 0x09, 0xa5, 0xf8, 0x7c, 0xa4, 0xb6, 0xc8, 0x19, 0x20, 0x0a, 0x48, 0xbe,
+		0x94, 0x7c, 0x27, 0xad, 0xe8, 0x25, 0x69, 0x8c, 0x81, 0x70, 0xf5, 0x48, 0xaa, 0x37, 0x8a, 0xe9,
+		0x80, 0x25, 0x21, 0xe6, 0xfa, 0x59, 0xf9, 0x22, 0xf2, 0x3c, 0x38, 0x20, 0x18, 0xe1, 0x78, 0x82,
+		0x93, 0x9c, 0x9d, 0xfd, 0xf6, 0xcb, 0x0f, 0xc1, 0x4f, 0xef, 0xdf, 0xa8, 0x7f, 0xff, 0x8f, 0x3a,
+		0xf0, 0xd9, 0xbe, 0xfe, 0x47, 0xde, 0x0f, 0xa6, 0x5c, 0x6e, 0x71, 0xf6, 0x00, 0x05, 0x17, 0x84,
+		0x43, 0x88, 0xb8, 0x62, 0x89, 0x7a, 0x74, 0x8f, 0xaa, 0x28, 0xe4, 0xac, 0x0f, 0x39, 0x57, 0x62,
+		0x1a, 0xa6, 0xe9, 0x18, 0x27, 0x2a, 0xd4, 0xf2, 0x5a, 0xda, 0xee, 0x78, 0x0e, 0x53, 0xf2, 0xfd,
+		0xf0, 0xad, 0xd0, 0xf0, 0x33, 0xdb, 0xde, 0x3e, 0xa4, 0x70, 0x7e, 0xd3, 0x3c, 0x29, 0x9c, 0xdf,
+		0xd4, 0x26, 0x85, 0xcf, 0x03, 0xbe, 0x4a, 0x25, 0xa0, 0xd2, 0xd8, 0xb0, 0xe5, 0x1e, 0xf3, 0x30,
+		0x46, 0x13, 0x79, 0x63, 0x1a, 0x65, 0x67, 0xd3, 0xf9, 0x45, 0xe6, 0xb8, 0x09, 0xb6, 0xca, 0x77,
+		0x7b, 0x2d, 0x3c, 0x31, 0x10, 0x0f, 0x4f, 0x12, 0x1a, 0x88, 0xbd, 0x45, 0x64, 0xcc, 0xee, 0x2e,
+		0xf8, 0x84, 0xa7, 0x5b, 0x87, 0xae, 0x87, 0x18, 0x35, 0xcd, 0xce, 0xbb, 0x27, 0xb1, 0x5e, 0x96,
+		0x49, 0x25, 0x14, 0x66, 0x80, 0x34, 0x07, 0xa1, 0x87, 0x10, 0xb1, 0x31, 0x10, 0x8f, 0xcf, 0xbb,
+		0x37, 0x10, 0xcd, 0xf3, 0xee, 0x4d, 0x11, 0x1d, 0x06, 0x73, 0x7d, 0xdf, 0xbb, 0xf9, 0x9a, 0xe6,
+		0xd2, 0xda, 0x97, 0x9b, 0xef, 0xec, 0x09, 0xba, 0xf9, 0x0c, 0x00, 0xb8, 0xcc, 0x8e, 0xbc, 0xfc,
+		0x69, 0x7d, 0x40, 0xc1, 0x5f, 0x97, 0xc1, 0x1f, 0x1f, 0xaf, 0xb2, 0x7f, 0x74, 0x82, 0xaf, 0x7b,
+		0xbd, 0xe0, 0x63, 0xfb, 0xea, 0xb9, 0x29, 0xad, 0x69, 0x7f, 0x69, 0xb7, 0xbf, 0x7c, 0xf8, 0xf3,
+		0xf6, 0xbf, 0xae, 0xda, 0xcf, 0xbf, 0xb4, 0x3f, 0xfc, 0x39, 0x7e, 0xab, 0xfe, 0xd1, 0xfe, 0xf0,
+		0x67, 0xfc, 0xf3, 0x55, 0xfb, 0x79, 0xeb, 0x5e, 0x82, 0x52, 0xae, 0x33, 0x30, 0x31, 0x4f, 0x11,
+		0x4c, 0xb1, 0x03, 0xd3, 0x52, 0xf3, 0x16, 0xb8, 0x95, 0x09, 0xf7, 0xf3, 0x0c, 0xeb, 0xa1, 0x32,
+		0xac, 0xb7, 0x88, 0x46, 0x48, 0xb0, 0x64, 0x5a, 0x4e, 0x94, 0x7d, 0xe8, 0xca, 0x76, 0x02, 0xee,
+		0x79, 0xda, 0xca, 0x91, 0x74, 0x3b, 0x3e, 0x76, 0xc5, 0x74, 0xbe, 0x53, 0xec, 0x8a, 0x09, 0x95,
+		0xf6, 0x31, 0x2c, 0xd2, 0x2a, 0xa9, 0xcd, 0x5f, 0x27, 0xd9, 0x8f, 0x32, 0x6b, 0x00, 0xac, 0x35,
+		0x54, 0xfe, 0xae, 0x26, 0x7f, 0xcc, 0x7e, 0x5c, 0xce, 0xd6, 0xa8, 0xe0, 0xbe, 0x89, 0x0c, 0xf2,
+		0xff, 0xe7, 0xad, 0x6f, 0x4b, 0x13, 0xed, 0x97, 0x59, 0x7a, 0xcc, 0xe8, 0x10, 0x0a, 0xd3, 0x60,
+		0x40, 0x70, 0x1c, 0x35, 0xc1, 0x7b, 0xf3, 0xb4, 0x6d, 0x16, 0x3b, 0xf1, 0x22, 0x1e, 0x1f, 0x54,
+		0x65, 0x99, 0x3b, 0x4b, 0x90, 0x7b, 0x3a, 0xc9, 0x71, 0xa7, 0x9d, 0xee, 0x99, 0xcf, 0x8d, 0x83,
+		0x59, 0xdf, 0xe8, 0xc0, 0xcc, 0x41, 0xbd, 0x30, 0xda, 0x8c, 0xb6, 0x65, 0x53, 0xb4, 0x7f, 0x5a,
+		0x30, 0x48, 0x39, 0x06, 0x42, 0x41, 0xda, 0xf6, 0x59, 0x02, 0x3f, 0xfc, 0xfc, 0xc6, 0x93, 0x39,
+		0x4f, 0xe6, 0x3c, 0x99, 0xdb, 0x0d, 0x99, 0x7b, 0xe9, 0x13, 0x80, 0x01, 0xa0, 0x35, 0x62, 0x5c,
+		0x04, 0x31, 0x0b, 0x51, 0x1c, 0xa0, 0xa1, 0x46, 0x9f, 0x12, 0x42, 0xb7, 0x32, 0xc3, 0x54, 0x90,
+		0x0b, 0x51, 0x0c, 0x6a, 0x06, 0xbc, 0xff, 0xad, 0x11, 0xa4, 0x6d, 0xad, 0x6f, 0xb1, 0xe1, 0xa4,
+		0x6d, 0x9d, 0xef, 0x71, 0xd7, 0xa4, 0x2d, 0x4d, 0xc8, 0xae, 0x83, 0xc0, 0x94, 0x53, 0xdf, 0x28,
+		0x1c, 0x71, 0x36, 0xd2, 0x10, 0xec, 0x08, 0xfd, 0x34, 0x8f, 0x44, 0x00, 0x35, 0xdb, 0xc3, 0xde,
+		0x7d, 0xc3, 0x9e, 0x81, 0xd1, 0xee, 0x1e, 0xe3, 0xc3, 0xf8, 0xf8, 0xa2, 0x18, 0x6b, 0xb2, 0xfc,
+		0xbb, 0x04, 0xbe, 0x0a, 0xa0, 0x2e, 0x23, 0x49, 0xca, 0xc1, 0x5c, 0x8d, 0x32, 0x2d, 0xb7, 0x20,
+		0x07, 0x6b, 0x4f, 0xad, 0xde, 0x67, 0x1b, 0xde, 0x8d, 0x08, 0x87, 0x85, 0x68, 0x6e, 0x04, 0x31,
+		0x13, 0x80, 0xa2, 0x6b, 0x44, 0x43, 0x1c, 0xc1, 0x20, 0xa5, 0xa1, 0x8e, 0xb3, 0x21, 0x62, 0x9a,
+		0x87, 0x79, 0x64, 0x91, 0x25, 0x32, 0xde, 0x87, 0x24, 0x5c, 0x00, 0x11, 0x40, 0xf8, 0x72, 0x6c,
+		0x90, 0x0c, 0x0c, 0x3a, 0xd6, 0x51, 0x43, 0x21, 0x1a, 0xe2, 0x40, 0x77, 0x43, 0x86, 0x3e, 0x56,
+		0xb9, 0xa0, 0xb0, 0x10, 0x7d, 0xb9, 0x70, 0x6c, 0x72, 0x97, 0x3d, 0x7a, 0xd8, 0x9f, 0xe6, 0x5d,
+		0x0d, 0x8e, 0x54, 0xee, 0xc3, 0x7f, 0x52, 0x14, 0x4b, 0xcf, 0xa1, 0xdc, 0x53, 0x22, 0x46, 0x72,
+		0xa9, 0x59, 0xe4, 0x0f, 0xc7, 0x34, 0x5b, 0x43, 0xbf, 0xe3, 0x61, 0xca, 0xe5, 0x53, 0xe6, 0x51,
+		0x34, 0x6a, 0x1b, 0xa8, 0x10, 0x77, 0x73, 0xa4, 0x83, 0x7c, 0xb2, 0x2a, 0x4a, 0xca, 0x83, 0x2d,
+		0xdf, 0x40, 0x6f, 0xf2, 0x58, 0x3d, 0x90, 0xf0, 0x59, 0x80, 0x0f, 0x8e, 0x66, 0x0f, 0x2b, 0xbc,
+		0x4a, 0xf6, 0x60, 0x44, 0xa3, 0x62, 0x74, 0x93, 0x7a, 0x89, 0x1e, 0x15, 0xa3, 0x44, 0xc5, 0x08,
+		0xa1, 0xf9, 0x2e, 0xe4, 0x71, 0x63, 0xc8, 0x27, 0x23, 0x69, 0xe2, 0x01, 0x7c, 0x1b, 0xea, 0x28,
+		0x24, 0x0c, 0x63, 0x74, 0x1b, 0x84, 0x23, 0x44, 0x29, 0x8e, 0x39, 0xb0, 0x81, 0x7e, 0x1c, 0x4b,
+		0x12, 0xcc, 0x27, 0x8c, 0x46, 0x84, 0x0e, 0x7b, 0x74, 0xe5, 0x9c, 0xb2, 0x58, 0x25, 0x1d, 0xca,
+		0xb4, 0xb8, 0xe1, 0xf9, 0x06, 0xb2, 0x63, 0xd4, 0x6f, 0x25, 0x46, 0x98, 0xc2, 0x98, 0x25, 0x6a,
+		0x52, 0x48, 0x06, 0x24, 0x54, 0x21, 0x54, 0x85, 0x89, 0xfa, 0xec, 0xf4, 0xbe, 0x7b, 0x54, 0x6e,
+		0x1c, 0x02, 0x20, 0xf3, 0xe4, 0x13, 0x46, 0x71, 0xbe, 0x3d, 0xb9, 0x72, 0x20, 0x2f, 0x7a, 0x25,
+		0x0e, 0xa8, 0xb0, 0x6d, 0x58, 0xb7, 0xeb, 0xef, 0x71, 0x88, 0x94, 0x1e, 0x93, 0x81, 0xce, 0x58,
+		0x62, 0x7a, 0xc4, 0x92, 0xa9, 0x36, 0xdc, 0x1c, 0x17, 0x1f, 0x98, 0x6d, 0x14, 0x47, 0x79, 0x7c,
+		0x3f, 0x57, 0x8a, 0x10, 0x19, 0x63, 0x40, 0x7c, 0x1e, 0x75, 0x45, 0xb8, 0x06, 0xae, 0x1e, 0x0d,
+		0x13, 0x55, 0x07, 0x4e, 0x9f, 0xb7, 0x54, 0x93, 0x28, 0xc6, 0x11, 0x07, 0xc1, 0x20, 0xc1, 0x22,
+		0x21, 0xf8, 0x1a, 0x43, 0xe6, 0x02, 0x22, 0x11, 0x70, 0xac, 0x6b, 0x8f, 0xa8, 0x23, 0xcc, 0xa3,
+		0x9c, 0xfa, 0x78, 0xc0, 0x12, 0x3d, 0x4a, 0xad, 0x8d, 0x6f, 0x65, 0xd4, 0xa3, 0x3a, 0x6d, 0x1d,
+		0x2c, 0x17, 0xc9, 0x53, 0xc8, 0x8f, 0x58, 0x0e, 0xbb, 0xfc, 0xf5, 0x4d, 0x16, 0xb9, 0x26, 0xff,
+		0x9a, 0xa8, 0x88, 0xaa, 0xfe, 0x74, 0x7e, 0x4a, 0xf3, 0x98, 0xb0, 0x59, 0xe8, 0xd5, 0x71, 0x0e,
+		0x3a, 0x3d, 0xba, 0x38, 0x24, 0x88, 0xf0, 0x00, 0xa5, 0x71, 0x76, 0xaa, 0x12, 0x9a, 0xe5, 0x7d,
+		0xe2, 0xf1, 0x84, 0x25, 0x28, 0x21, 0xf1, 0x14, 0xa2, 0x54, 0x2a, 0x11, 0x72, 0x2a, 0x10, 0x4a,
+		0x04, 0x41, 0x31, 0x4c, 0x12, 0xd6, 0x57, 0xf7, 0x72, 0x33, 0x42, 0x62, 0xfe, 0x7a, 0x3d, 0x9a,
+		0xbf, 0x52, 0x56, 0xe8, 0xab, 0x8f, 0x8f, 0x9a, 0x10, 0xc9, 0xf3, 0xb4, 0xf9, 0x5a, 0x69, 0x24,
+		0x4f, 0x81, 0xcc, 0x98, 0xbb, 0x42, 0x8b, 0x93, 0xec, 0x1c, 0xa2, 0x2b, 0xa1, 0xf0, 0x85, 0xa5,
+		0x72, 0x5c, 0xcf, 0x99, 0xc7, 0x32, 0xa9, 0x7b, 0x08, 0x2e, 0xd3, 0xad, 0xd0, 0x66, 0x0b, 0x75,
+		0xce, 0xd0, 0xe7, 0x0c, 0x85, 0xd6, 0xd0, 0xb8, 0x1d, 0x2a, 0x4b, 0xa0, 0xd3, 0x5c, 0xb2, 0x72,
+		0x90, 0xb0, 0x6c, 0x24, 0x2d, 0x23, 0x89, 0x4b, 0x82, 0xa5, 0xfc, 0x59, 0x04, 0xfe, 0x4a, 0x6f,
+		0xbd, 0xdb, 0x7e, 0x1d, 0x8b, 0x84, 0x7e, 0xaf, 0x00, 0xb3, 0x7c, 0x7a, 0x1f, 0xbe, 0x5b, 0x15,
+		0x58, 0xbf, 0xf9, 0x3f, 0x32, 0x04, 0xf8, 0xa4, 0xdd, 0x96, 0xff, 0xe5, 0x5f, 0x5e, 0xcd, 0xce,
+		0xf9, 0x3b, 0x9b, 0x83, 0x36, 0x38, 0xec, 0x9a, 0x8e, 0x31, 0xe7, 0x97, 0x7b, 0x3d, 0xce, 0xce,
+		0xae, 0xdb, 0x9d, 0x34, 0xb1, 0xf9, 0xc7, 0x42, 0x75, 0xf0, 0xfa, 0x01, 0xea, 0x18, 0xda, 0xfb,
+		0x6f, 0x0c, 0x22, 0x37, 0xb2, 0xc0, 0xf0, 0x66, 0x35, 0xed, 0x13, 0x36, 0x2e, 0x11, 0x67, 0x7d,
+		0xd7, 0x10, 0x51, 0xf9, 0x08, 0x1f, 0x4d, 0x9b, 0x89, 0x8d, 0xa7, 0x90, 0x55, 0x3e, 0x06, 0x42,
+		0xb7, 0x9c, 0xc3, 0xc9, 0x16, 0x55, 0x7e, 0xd7, 0xf0, 0xd4, 0xbc, 0xf6, 0x12, 0x75, 0x9c, 0xe5,
+		0x63, 0x6d, 0x2b, 0xb1, 0x45, 0x71, 0xca, 0xec, 0x07, 0xf6, 0xaa, 0xc3, 0xe2, 0xbc, 0xaa, 0xda,
+		0xc3, 0xc2, 0x6a, 0x65, 0x0a, 0x44, 0x66, 0xc3, 0xd0, 0xba, 0xae, 0x83, 0x59, 0x64, 0xcd, 0xc2,
+		0x81, 0xd7, 0x4c, 0xbc, 0x66, 0x02, 0xbe, 0x5e, 0xe4, 0x2e, 0xfd, 0x96, 0xee, 0xfe, 0x4b, 0xc3,
+		0x5b, 0x76, 0xf6, 0xdd, 0xae, 0x1c, 0xc9, 0xe9, 0x0b, 0x1f, 0x9c, 0x69, 0x85, 0x5f, 0x4d, 0x54,
+		0x3d, 0x34, 0x33, 0x39, 0x6c, 0x1f, 0x65, 0x1d, 0xa5, 0xbe, 0xc9, 0x2c, 0xe9, 0x35, 0xa8, 0x21,
+		0x1f, 0xbe, 0x2b, 0x48, 0x1a, 0xf3, 0xa1, 0xc5, 0x6f, 0xd5, 0xd8, 0x02, 0x2b, 0xba, 0x2f, 0x35,
+		0x65, 0x91, 0xb3, 0xe6, 0x42, 0x11, 0x96, 0xce, 0x11, 0x60, 0x09, 0xc4, 0x98, 0x4b, 0x8b, 0x38,
+		0xa2, 0x45, 0xb6, 0xd9, 0x5c, 0xb9, 0xb3, 0x99, 0x7a, 0xcc, 0xae, 0xce, 0xf8, 0x91, 0x29, 0x3a,
+		0x8b, 0xc7, 0xa4, 0x25, 0x36, 0xbe, 0x70, 0x26, 0x6c, 0xe0, 0xf5, 0x9d, 0x0a, 0x90, 0xe7, 0x78,
+		0xa4, 0x4f, 0x50, 0xed, 0x89, 0x5c, 0x9a, 0xcb, 0x99, 0x13, 0x9f, 0x4a, 0xc1, 0xe6, 0xcb, 0x92,
+		0xa5, 0xcf, 0x1d, 0xab, 0x8e, 0x46, 0x8d, 0x4f, 0x76, 0xf6, 0xf9, 0x60, 0xcd, 0x94, 0xae, 0xf7,
+		0xa6, 0x71, 0x18, 0x07, 0xc7, 0x37, 0xe1, 0x54, 0x9a, 0x4c, 0xd9, 0x4d, 0x82, 0xeb, 0x57, 0x49,
+		0x7b, 0x79, 0x90, 0xfd, 0x26, 0xda, 0xee, 0x1a, 0x6c, 0xef, 0xc9, 0xbc, 0x27, 0xf3, 0x9e, 0xcc,
+		0x3f, 0x31, 0x32, 0xff, 0xd2, 0x67, 0xfd, 0xd6, 0x41, 0xe4, 0x55, 0xec, 0x9d, 0x39, 0x75, 0xd7,
+		0xc3, 0xed, 0xc8, 0x7a, 0xa8, 0xc8, 0x6d, 0x9a, 0xe0, 0xac, 0x1c, 0x9c, 0x5a, 0xa3, 0x0d, 0xff,
+		0x56, 0x21, 0x80, 0x59, 0xf0, 0xa2, 0xd2, 0xf4, 0x59, 0x1f, 0xcf, 0xa3, 0xf6, 0x54, 0xd4, 0x64,
+		0x16, 0xa4, 0xa7, 0xfd, 0x0b, 0xf3, 0x90, 0xd2, 0x40, 0x87, 0xfb, 0xf5, 0xe8, 0x18, 0x23, 0xca,
+		0x81, 0xe8, 0x2a, 0x72, 0x8c, 0xc6, 0xd3, 0xa5, 0x20, 0xc9, 0x6c, 0xf5, 0xb5, 0xae, 0x8b, 0x95,
+		0xd0, 0x48, 0xef, 0xb3, 0x78, 0xac, 0x3e, 0x8b, 0xdd, 0x16, 0xa0, 0xb8, 0xc7, 0xc8, 0x2b, 0x85,
+		0x4a, 0xdc, 0x07, 0x5d, 0xad, 0x85, 0xab, 0xfd, 0x19, 0xa7, 0x4d, 0xae, 0x01, 0x1e, 0x77, 0x48,
+		0x96, 0x3a, 0x82, 0x00, 0xa5, 0x82, 0x51, 0x3c, 0x7c, 0x8a, 0xe1, 0x59, 0x35, 0x86, 0x66, 0xd9,
+		0x81, 0xdd, 0x71, 0x56, 0x2b, 0xf2, 0x50, 0x0d, 0x3c, 0xba, 0x27, 0xef, 0x88, 0xda, 0x8b, 0x0f,
+		0xdf, 0xb2, 0xc6, 0x1b, 0xc7, 0xc3, 0x7b, 0x5c, 0xfe, 0x8c, 0xc5, 0xf7, 0xf7, 0x21, 0x5b, 0x75,
+		0x40, 0x91, 0x0f, 0xd6, 0x72, 0x2a, 0x76, 0xb4, 0x98, 0x64, 0x66, 0x10, 0x99, 0xe5, 0x2b, 0x1f,
+		0xad, 0x56, 0x3e, 0x2a, 0xc1, 0xcd, 0xb2, 0xa2, 0x47, 0xbf, 0x6e, 0x04, 0x4a, 0xb3, 0xf4, 0x4d,
+		0xdd, 0x91, 0xaa, 0x34, 0x7f, 0x73, 0x53, 0xe3, 0xaa, 0x35, 0x9c, 0xb1, 0xf5, 0xcb, 0x64, 0xb9,
+		0xfd, 0x7f, 0x23, 0xda, 0x64, 0xfb, 0x54, 0xae, 0xed, 0xa9, 0x5c, 0x8c, 0x52, 0x2c, 0x13, 0x2b,
+		0x2d, 0xa2, 0x31, 0x67, 0x53, 0xec, 0x8c, 0x1c, 0x12, 0x3a, 0x52, 0x5e, 0x8c, 0xb7, 0xbc, 0x80,
+		0x74, 0xf2, 0x25, 0x62, 0x37, 0xd4, 0xdb, 0x15, 0x7c, 0x2c, 0x64, 0x05, 0xab, 0xf5, 0x2e, 0x9a,
+		0x0f, 0x23, 0x2e, 0x82, 0x39, 0xa4, 0x9b, 0x37, 0x21, 0x5e, 0x9c, 0x67, 0x87, 0x21, 0x3f, 0x23,
+		0x2e, 0xe0, 0x13, 0x65, 0x37, 0x54, 0xe7, 0x0f, 0xcf, 0x43, 0x86, 0xe1, 0x06, 0x71, 0x30, 0x5e,
+		0xd6, 0xa3, 0xcb, 0xd3, 0x41, 0x97, 0x08, 0x09, 0x1c, 0x20, 0x1a, 0x05, 0x12, 0x62, 0xea, 0xf6,
+		0xf5, 0x58, 0x57, 0x7d, 0xee, 0xf5, 0xa2, 0xcf, 0x67, 0x77, 0x81, 0xfc, 0xd1, 0xcd, 0x7f, 0xbc,
+		0xd3, 0x3f, 0x2e, 0x16, 0x7e, 0x1c, 0xf6, 0x7a, 0xed, 0x5e, 0x2f, 0xfa, 0xdb, 0xd1, 0xb7, 0x87,
+		0x7f, 0x7c, 0xf9, 0xd0, 0xeb, 0xfd, 0xad, 0xd7, 0x0b, 0xae, 0x16, 0x46, 0x1c, 0xb5, 0x1a, 0x22,
+		0xf6, 0xd6, 0x26, 0xf3, 0x6d, 0x93, 0xa6, 0xc0, 0x40, 0xe8, 0xfb, 0x7d, 0x7d, 0xef, 0x52, 0x30,
+		0x97, 0xfa, 0xb4, 0x5e, 0x63, 0x52, 0xa0, 0x66, 0x3e, 0xb4, 0x6a, 0xc7, 0xbc, 0xb5, 0x6d, 0x80,
+		0x0c, 0x49, 0x95, 0xcf, 0xea, 0xbf, 0x8f, 0x4a, 0x49, 0x11, 0x1b, 0x23, 0x42, 0xcb, 0xc2, 0x13,
+		0x7c, 0x25, 0xb8, 0x46, 0x55, 0x82, 0xeb, 0x9e, 0x7f, 0xf5, 0x78, 0x4a, 0xc1, 0x1d, 0x1f, 0x54,
+		0x66, 0x49, 0xad, 0xc3, 0xc3, 0xc3, 0x79, 0xeb, 0x81, 0x8f, 0x57, 0x87, 0x8b, 0x7d, 0x08, 0xae,
+		0x8e, 0x3e, 0x77, 0x8e, 0x5f, 0x9c, 0xde, 0x1d, 0x7d, 0x3b, 0xff, 0xfe, 0xaa, 0xd7, 0x6b, 0x1f,
+		0x3d, 0x77, 0x99, 0xf5, 0xed, 0xd1, 0x97, 0x5e, 0xaf, 0xdd, 0xaa, 0x5e, 0xdb, 0xce, 0xa4, 0x33,
+		0xdf, 0x26, 0xd2, 0xdc, 0xc8, 0xde, 0x7c, 0xef, 0x69, 0x84, 0x93, 0x18, 0x4d, 0x7d, 0x4b, 0xbe,
+		0x65, 0x71, 0xc0, 0xa8, 0x27, 0xdf, 0x82, 0x00, 0x60, 0xde, 0x94, 0x2f, 0x33, 0x81, 0x97, 0xb4,
+		0xe6, 0x5b, 0xeb, 0x65, 0x58, 0xd3, 0xa0, 0x4f, 0x8e, 0x9a, 0xb7, 0xae, 0x83, 0x00, 0x46, 0x38,
+		0xc1, 0x2b, 0x89, 0x92, 0x1c, 0x50, 0x82, 0x21, 0x26, 0x9f, 0x30, 0x20, 0x88, 0x49, 0x3f, 0x41,
+		0xc9, 0x54, 0xce, 0x9a, 0xc8, 0x36, 0xe1, 0xb3, 0xf0, 0x06, 0x3d, 0x43, 0x27, 0x55, 0x4e, 0xd5,
+		0x0c, 0x95, 0x9f, 0x99, 0xe8, 0x4e, 0x7b, 0x59, 0x49, 0xa2, 0x67, 0x7a, 0xd4, 0x33, 0x20, 0xd2,
+		0x7f, 0x45, 0x43, 0xcc, 0x75, 0x9c, 0x83, 0xea, 0x6e, 0x87, 0x54, 0xad, 0xa1, 0x18, 0x89, 0x59,
+		0xc3, 0x3d, 0x3e, 0xef, 0xa3, 0xa7, 0xfd, 0x3d, 0xb2, 0xbe, 0x15, 0x12, 0x30, 0x46, 0x2a, 0xd8,
+		0x41, 0xd5, 0x27, 0xba, 0x19, 0x61, 0x0a, 0xf9, 0x3e, 0xe6, 0x36, 0x5e, 0x2c, 0xab, 0x26, 0xfd,
+		0x43, 0xbe, 0xce, 0x0d, 0xd6, 0xcb, 0x13, 0x01, 0x68, 0x21, 0x7a, 0x5a, 0xae, 0x8d, 0xa0, 0x18,
+		0xb3, 0xa7, 0x37, 0x83, 0x62, 0xce, 0xd6, 0x75, 0x95, 0x52, 0xad, 0xf0, 0x7a, 0xb4, 0xf8, 0x70,
+		0xc1, 0x00, 0x25, 0x7d, 0x22, 0x64, 0x79, 0x24, 0x59, 0x20, 0x09, 0x71, 0x4e, 0x86, 0x34, 0x0f,
+		0xbd, 0x40, 0x02, 0x92, 0x94, 0xae, 0x11, 0xdf, 0x77, 0xd3, 0x8a, 0x6e, 0xdc, 0xc0, 0x56, 0x74,
+		0x63, 0xdf, 0x8a, 0xce, 0xd7, 0x3b, 0xf6, 0xad, 0xe8, 0xb6, 0xdc, 0x84, 0x0f, 0xdb, 0xad, 0x0a,
+		0x6b, 0xd6, 0x30, 0xb7, 0x1d, 0xf6, 0x4a, 0x60, 0xd0, 0x5c, 0xed, 0x02, 0xf0, 0xad, 0xe8, 0x7c,
+		0xfc, 0x2e, 0x80, 0x6f, 0x45, 0xe7, 0x5b, 0xd1, 0xd9, 0xc0, 0x9a, 0x6f, 0x45, 0x07, 0xe0, 0x19,
+		0x96, 0x3d, 0x9a, 0xf9, 0x56, 0x74, 0x9e, 0xa7, 0xd9, 0x02, 0xc5, 0x12, 0x80, 0xf8, 0x56, 0x74,
+		0x56, 0xa3, 0x7c, 0x2b, 0xba, 0x06, 0x07, 0x64, 0xe5, 0x19, 0x03, 0xc5, 0x5f, 0xaa, 0xb4, 0xa5,
+		0x7b, 0x2b, 0x57, 0xf8, 0x58, 0xfc, 0xb7, 0x6f, 0x50, 0xe7, 0xc0, 0xcc, 0xbd, 0x25, 0x03, 0x7c,
+		0xe7, 0xa6, 0xda, 0x79, 0x82, 0x6f, 0x50, 0x57, 0x85, 0x97, 0xf9, 0x06, 0x75, 0x9e, 0xcc, 0x79,
+		0x32, 0xe7, 0xc9, 0xdc, 0x2e, 0x72, 0xd0, 0x1f, 0x39, 0x91, 0xdb, 0x43, 0xbb, 0x24, 0x08, 0x40,
+		0xac, 0xeb, 0x97, 0x44, 0xb8, 0x50, 0x0d, 0x86, 0x08, 0xcd, 0x7e, 0x6b, 0xc3, 0x6b, 0x94, 0xb7,
+		0x22, 0x1a, 0x21, 0xbe, 0xe8, 0xee, 0xec, 0xd1, 0x55, 0x7f, 0x67, 0x23, 0x3a, 0xb2, 0x8c, 0x1f,
+		0x60, 0xec, 0xd6, 0xf8, 0xb1, 0x76, 0x64, 0x99, 0x7b, 0x27, 0x66, 0x85, 0x03, 0xd0, 0x10, 0x43,
+		0x00, 0x93, 0xd1, 0x94, 0xcb, 0x9e, 0x4d, 0x1a, 0xba, 0x18, 0xdd, 0x1e, 0xfd, 0xb7, 0x0c, 0x49,
+		0xf7, 0x1b, 0xb0, 0x3c, 0x7e, 0x84, 0x01, 0xcb, 0x63, 0x5f, 0xeb, 0x78, 0xf5, 0xe3, 0x6b, 0x1d,
+		0x5b, 0xde, 0xb2, 0xb3, 0x18, 0xb0, 0x72, 0x24, 0xdd, 0xf3, 0x73, 0x6f, 0xfe, 0x33, 0x9c, 0xef,
+		0x4b, 0x4a, 0xd6, 0x40, 0xd5, 0xbd, 0x0f, 0x68, 0x3f, 0x54, 0xdd, 0xfb, 0x75, 0x9e, 0x36, 0x61,
+		0xf7, 0x25, 0x25, 0xeb, 0xa2, 0xec, 0xbe, 0xa4, 0xa4, 0x27, 0xf3, 0x9e, 0xcc, 0x7b, 0x32, 0xef,
+		0x4b, 0x4a, 0x3e, 0x5e, 0x22, 0x5f, 0xa8, 0x99, 0x6f, 0x4e, 0xe3, 0x8b, 0x93, 0xec, 0x48, 0xfc,
+		0x25, 0xf0, 0x49, 0x4c, 0x64, 0xd4, 0x1c, 0x84, 0x88, 0x42, 0x44, 0xae, 0x49, 0x84, 0x17, 0xbb,
+		0x5e, 0xb1, 0xbc, 0x94, 0x8b, 0x6e, 0x23, 0x8d, 0x61, 0xb1, 0xc9, 0x73, 0x07, 0x08, 0xd5, 0xad,
+		0xc9, 0x17, 0x5b, 0x5a, 0x53, 0x36, 0xeb, 0x0b, 0x40, 0xfe, 0x52, 0x95, 0xc6, 0x4a, 0x77, 0xa6,
+		0x96, 0x35, 0xc2, 0xf5, 0xb2, 0x26, 0x96, 0x57, 0xde, 0xaa, 0xe4, 0xad, 0x4a, 0xde, 0xaa, 0xd4,
+		0x5c, 0x0a, 0xec, 0x3b, 0x68, 0x59, 0xe2, 0xc5, 0xfd, 0x72, 0xa5, 0xac, 0x46, 0xa7, 0x5d, 0xa5,
+		0x63, 0x6e, 0xc9, 0x8b, 0xe6, 0x69, 0x7a, 0xca, 0x61, 0x85, 0x39, 0x06, 0x94, 0x60, 0x40, 0x71,
+		0x0c, 0x2a, 0xa3, 0x30, 0x9d, 0xc8, 0x11, 0x38, 0xd2, 0x63, 0x38, 0x30, 0xaa, 0xdd, 0x5a, 0xf2,
+		0x5b, 0x9d, 0x78, 0xb7, 0xe4, 0xe0, 0x9a, 0xa8, 0xbb, 0x0c, 0x51, 0xdc, 0xa3, 0x52, 0x75, 0xc9,
+		0xcb, 0x0e, 0xa8, 0x82, 0x9f, 0xda, 0xdd, 0xf5, 0x1b, 0x12, 0x23, 0x9c, 0xe8, 0xa6, 0x48, 0x29,
+		0x27, 0x74, 0x08, 0x98, 0xa6, 0x63, 0x20, 0x8a, 0x83, 0xe9, 0x2f, 0x10, 0x85, 0x37, 0xca, 0xa7,
+		0x21, 0xa6, 0xfa, 0x21, 0x37, 0x24, 0x8e, 0x01, 0x85, 0x21, 0x9e, 0x08, 0x40, 0x74, 0xda, 0xa3,
+		0xf9, 0x9f, 0x41, 0xa5, 0x16, 0x12, 0x0e, 0x7d, 0xc4, 0x71, 0x04, 0x6c, 0x30, 0xc8, 0x52, 0x16,
+		0xe5, 0x76, 0x9f, 0x65, 0x05, 0x10, 0x84, 0xca, 0xf3, 0x29, 0x04, 0x99, 0x89, 0xe9, 0xe4, 0x61,
+		0x24, 0xf9, 0x78, 0x7e, 0xb5, 0x1f, 0x7e, 0x95, 0xc3, 0x89, 0x65, 0x55, 0x64, 0x13, 0xb6, 0x95,
+		0x43, 0xea, 0xf7, 0x88, 0x5b, 0x5c, 0x91, 0x4d, 0xf9, 0xf2, 0x45, 0x02, 0xcc, 0x8d, 0x59, 0x24,
+		0x58, 0xb1, 0xc9, 0xd5, 0x6d, 0x05, 0xa7, 0x9d, 0xce, 0xb0, 0xb5, 0x0b, 0xfe, 0x53, 0x71, 0x57,
+		0x4d, 0xdc, 0x54, 0x03, 0xf7, 0xd4, 0x0d, 0xce, 0x9b, 0xb8, 0xab, 0x26, 0x6e, 0xea, 0xac, 0x91,
+		0x90, 0x7e, 0xd6, 0xc4, 0x4d, 0x35, 0xf1, 0xfa, 0x8c, 0xab, 0x7c, 0xcf, 0x36, 0x66, 0x34, 0xf2,
+		0x6a, 0xc7, 0x7c, 0xcc, 0x28, 0x2f, 0xa0, 0xa8, 0xc6, 0x14, 0xa3, 0xfe, 0xcd, 0x42, 0xc8, 0x9c,
+		0xf3, 0x04, 0xec, 0xf2, 0x05, 0xca, 0xcf, 0xa3, 0x6a, 0xed, 0x5b, 0x9f, 0x5b, 0x01, 0x00, 0x60,
+		0x9d, 0x5b, 0xe1, 0x58, 0xf8, 0x76, 0x35, 0xad, 0xa2, 0x72, 0x09, 0xdc, 0xc5, 0x7a, 0xf3, 0xc6,
+		0x25, 0xd1, 0x66, 0x13, 0x1e, 0x51, 0x61, 0xb4, 0x27, 0x1d, 0x5c, 0x67, 0x1e, 0x7c, 0x6c, 0x9e,
+		0x99, 0xef, 0xa3, 0x90, 0x1b, 0x96, 0x6c, 0xd1, 0xf1, 0xc5, 0xd1, 0x6a, 0x49, 0x89, 0x77, 0x4f,
+		0x85, 0xdf, 0x45, 0x0d, 0xb4, 0x0d, 0xb4, 0xd8, 0x57, 0x42, 0x5b, 0x44, 0x98, 0xa6, 0x56, 0x42,
+		0x5b, 0x23, 0x1f, 0x58, 0x54, 0x45, 0x5b, 0x11, 0x09, 0x4c, 0xea, 0xa3, 0x5d, 0xe3, 0x11, 0x09,
+		0xd7, 0x34, 0xc4, 0x99, 0x97, 0x49, 0xc8, 0x06, 0x94, 0x55, 0x45, 0x93, 0xa6, 0x45, 0x60, 0x03,
+		0xc8, 0xc6, 0xf3, 0x7d, 0xd4, 0xee, 0x12, 0xa7, 0x61, 0xf3, 0x8a, 0x77, 0xc9, 0x4d, 0xd5, 0x55,
+		0xbd, 0xcb, 0x44, 0x0c, 0x33, 0x96, 0xbc, 0x2e, 0x61, 0xb3, 0xec, 0x95, 0xdd, 0x9a, 0x36, 0x41,
+		0xab, 0x90, 0x17, 0x36, 0x80, 0xf7, 0xef, 0xdf, 0xbc, 0xd2, 0xb5, 0xed, 0xf8, 0x88, 0xa5, 0x71,
+		0x04, 0x93, 0x84, 0x8d, 0x27, 0x42, 0x0d, 0x79, 0xff, 0x06, 0x04, 0x83, 0x11, 0x92, 0x05, 0xe8,
+		0x7a, 0xf4, 0x27, 0x4c, 0xb1, 0xb4, 0x3f, 0xeb, 0x29, 0xfd, 0x54, 0x88, 0xcd, 0x99, 0xb6, 0xfb,
+		0x14, 0xe4, 0xd6, 0x03, 0x88, 0x29, 0xa0, 0x58, 0x03, 0x8c, 0x35, 0xe0, 0x98, 0x03, 0xd0, 0x76,
+		0x3e, 0x58, 0x5d, 0x94, 0x4b, 0x53, 0x12, 0x55, 0x95, 0xdf, 0xcc, 0xb9, 0x6d, 0x27, 0xf8, 0x1a,
+		0x05, 0x83, 0xcb, 0xe0, 0xc7, 0xab, 0xcf, 0x2f, 0xef, 0x82, 0xe2, 0xaf, 0x67, 0x36, 0xbf, 0x9e,
+		0x76, 0xef, 0x5a, 0x3b, 0x4a, 0x4f, 0xba, 0xc1, 0x49, 0x10, 0x8e, 0x18, 0x09, 0xb1, 0x49, 0x9a,
+		0x52, 0x61, 0xb4, 0x69, 0xed, 0x3c, 0x3d, 0x5c, 0x97, 0x6c, 0xbc, 0xc1, 0x09, 0x28, 0x8f, 0x08,
+		0x04, 0x8b, 0xde, 0x9c, 0x6c, 0x10, 0xa1, 0x9c, 0x44, 0x58, 0x91, 0xd8, 0x12, 0xac, 0x3a, 0xf7,
+		0x58, 0xb5, 0x7b, 0xac, 0x2a, 0xcd, 0x3e, 0x52, 0x6d, 0xf5, 0x12, 0x12, 0x06, 0xa1, 0x89, 0xcb,
+		0x61, 0x76, 0xae, 0x8b, 0xd3, 0xec, 0xdc, 0x88, 0xaf, 0xb3, 0xb9, 0x1a, 0x9a, 0x70, 0x04, 0xeb,
+		0xf9, 0xf6, 0x26, 0xb8, 0x39, 0xbb, 0x4f, 0x1f, 0xdb, 0x76, 0x78, 0xb2, 0x85, 0x2b, 0x67, 0xf8,
+		0x72, 0x86, 0x33, 0x7b, 0x78, 0xdb, 0x0e, 0x77, 0x25, 0xf0, 0x67, 0x0c, 0x87, 0xf9, 0xa7, 0xd5,
+		0x57, 0x84, 0x79, 0x6a, 0xef, 0xfc, 0xca, 0x27, 0x1a, 0xbe, 0xff, 0x12, 0x4c, 0x7e, 0xaf, 0x67,
+		0xcf, 0xca, 0xcc, 0x22, 0x9b, 0x7e, 0x9e, 0xdb, 0x93, 0x29, 0x9d, 0x01, 0xd4, 0x05, 0x50, 0x1d,
+		0x01, 0xd6, 0x15, 0x70, 0x2b, 0x03, 0x70, 0x65, 0x40, 0x76, 0x07, 0x68, 0x33, 0xc0, 0x36, 0x04,
+		0x70, 0x6b, 0x40, 0xcf, 0x3f, 0xad, 0x10, 0x4d, 0x50, 0x48, 0xc4, 0xd4, 0xbd, 0xc9, 0xe0, 0x6c,
+		0x05, 0xcb, 0x13, 0x5b, 0x53, 0x30, 0xae, 0x9f, 0xa3, 0x81, 0xe3, 0x92, 0x66, 0x61, 0x10, 0x95,
+		0x31, 0xa2, 0x0a, 0x66, 0x54, 0xc4, 0x90, 0xaa, 0x98, 0x52, 0x1b, 0xc6, 0xd4, 0x86, 0x39, 0xd5,
+		0x31, 0xc8, 0x0e, 0x93, 0x2c, 0x31, 0xca, 0xa5, 0xb8, 0x9d, 0xbd, 0x72, 0x61, 0x14, 0xa4, 0x78,
+		0xfa, 0xc2, 0x05, 0x50, 0x32, 0x9c, 0x78, 0xe1, 0x30, 0xd5, 0x2e, 0x8a, 0x71, 0xf9, 0xe3, 0x06,
+		0x98, 0xe0, 0x1a, 0xe5, 0xb8, 0xd1, 0x82, 0xdb, 0x39, 0xae, 0xb6, 0x4e, 0xd5, 0x88, 0xbf, 0xea,
+		0xf6, 0xde, 0x9a, 0x40, 0x78, 0x09, 0x9c, 0x6f, 0xeb, 0x3b, 0xe2, 0x17, 0xe7, 0xe7, 0x5f, 0x9d,
+		0x3f, 0xde, 0x63, 0x3e, 0xd8, 0xcf, 0xac, 0xab, 0x06, 0xf4, 0x1d, 0x1e, 0x23, 0x81, 0x13, 0x82,
+		0x62, 0x77, 0x71, 0x60, 0xb6, 0x42, 0x35, 0x71, 0x20, 0x97, 0x88, 0xf3, 0xe5, 0xf2, 0xde, 0x11,
+		0x37, 0x58, 0xaa, 0xfa, 0x8c, 0xcf, 0x12, 0xce, 0x10, 0x55, 0x31, 0x99, 0x58, 0xcb, 0xcc, 0xaa,
+		0xef, 0x03, 0x46, 0x51, 0x8f, 0xb2, 0x01, 0xcc, 0x4c, 0x07, 0xb2, 0x88, 0x09, 0x48, 0x55, 0x91,
+		0x03, 0xd2, 0x59, 0x08, 0xd9, 0x1f, 0x08, 0x87, 0x6b, 0xf9, 0x14, 0x4e, 0xc6, 0x93, 0x38, 0x1b,
+		0x47, 0x19, 0xfc, 0x27, 0x45, 0xb1, 0xb2, 0xfa, 0x71, 0x2f, 0x81, 0x00, 0x78, 0x09, 0x64, 0x37,
+		0x38, 0x7f, 0xb7, 0x77, 0x29, 0xa2, 0x80, 0x28, 0x15, 0x44, 0x89, 0xd3, 0x33, 0x87, 0xb9, 0xaf,
+		0x69, 0x3a, 0x76, 0x87, 0x95, 0x77, 0xec, 0x77, 0x9d, 0x3e, 0x78, 0x51, 0x45, 0xac, 0xe8, 0xb4,
+		0x2e, 0xa0, 0xc5, 0x64, 0x44, 0x77, 0xab, 0x02, 0xe7, 0x3c, 0x95, 0xab, 0xc4, 0xb2, 0x2c, 0x52,
+		0x3a, 0x0e, 0xdc, 0x4e, 0x32, 0xff, 0xb4, 0xba, 0xc5, 0xb5, 0x26, 0x2c, 0x9e, 0x8e, 0x71, 0xd2,
+		0x72, 0x63, 0x37, 0xc7, 0xae, 0x27, 0xfb, 0x86, 0x8a, 0x6a, 0xc7, 0x5a, 0x3c, 0x0b, 0x6b, 0xd9,
+		0x78, 0xed, 0x4a, 0xf9, 0x49, 0x6c, 0xec, 0xe3, 0x6c, 0xf2, 0xc9, 0x6e, 0xfa, 0x02, 0x3a, 0x7b,
+		0xe2, 0xdf, 0x77, 0xf7, 0xdb, 0xea, 0xdd, 0xd4, 0xf2, 0x66, 0x16, 0x9a, 0x65, 0xe2, 0x8a, 0x15,
+		0x98, 0x8b, 0xd3, 0xcc, 0x9a, 0x7f, 0x92, 0x59, 0x51, 0x4f, 0x8a, 0x26, 0xfe, 0x93, 0x05, 0x3b,
+		0xed, 0x89, 0x9d, 0x8d, 0x0c, 0xd6, 0x3a, 0x70, 0xdf, 0xc9, 0x67, 0xfe, 0xa0, 0x56, 0xff, 0xf8,
+		0x6f, 0xfd, 0xc8, 0x8f, 0x99, 0xac, 0xd0, 0xaa, 0x29, 0x41, 0xc6, 0xe0, 0x24, 0xe7, 0x06, 0xe8,
+		0x31, 0x13, 0x2c, 0xb1, 0x37, 0x17, 0x2e, 0xcd, 0x77, 0xb3, 0x1a, 0xbe, 0x95, 0x73, 0x17, 0x6d,
+		0x86, 0xcb, 0x6e, 0x91, 0xac, 0x8a, 0x5b, 0xe6, 0x16, 0xc9, 0x05, 0x22, 0x6f, 0x59, 0xdc, 0x9b,
+		0x94, 0xf2, 0x74, 0x2d, 0x8b, 0x0a, 0xb2, 0xcd, 0x8a, 0x51, 0x6c, 0xbc, 0xe4, 0xc2, 0x1a, 0xd5,
+		0xd4, 0x09, 0x99, 0x97, 0x2c, 0x97, 0xc9, 0x8b, 0xce, 0xa9, 0x85, 0xdb, 0xf0, 0xfa, 0x16, 0x49,
+		0xc1, 0x5f, 0x37, 0xa0, 0x7b, 0xa6, 0x7a, 0x97, 0xaa, 0xbf, 0x3c, 0x03, 0x96, 0xc0, 0xb3, 0x41,
+		0xc2, 0xa8, 0x78, 0x06, 0x58, 0x84, 0x6d, 0xaf, 0x07, 0xd4, 0x8d, 0x59, 0xb5, 0x61, 0x58, 0x6d,
+		0x98, 0x56, 0x1d, 0xe3, 0xec, 0x30, 0xcf, 0x12, 0x03, 0x6b, 0xd4, 0x03, 0x8c, 0x6b, 0x71, 0xb8,
+		0x04, 0x37, 0x54, 0x0d, 0x5a, 0xdd, 0xf4, 0x69, 0x8a, 0x3d, 0xf1, 0xd4, 0xdb, 0x13, 0x77, 0x6d,
+		0x4f, 0xec, 0x76, 0xbc, 0x31, 0xf1, 0x31, 0x18, 0x13, 0x15, 0xf7, 0x56, 0x0a, 0x41, 0x55, 0x11,
+		0x40, 0x2f, 0x52, 0x4d, 0x06, 0x50, 0x2b, 0x81, 0xd3, 0x4a, 0x9e, 0x9d, 0x03, 0x78, 0x76, 0xde,
+		0x54, 0x76, 0xee, 0x9d, 0x83, 0xce, 0x9c, 0xc6, 0x3b, 0x07, 0x77, 0xce, 0xcc, 0xbd, 0x73, 0xb0,
+		0xd1, 0xfc, 0xbc, 0x56, 0x23, 0x41, 0x96, 0x63, 0x63, 0xad, 0xb6, 0xdb, 0xa5, 0x0f, 0x17, 0x49,
+		0x81, 0x5d, 0x1a, 0x71, 0x11, 0xc2, 0x2b, 0xa7, 0x13, 0x9b, 0xa5, 0xbc, 0x3c, 0x60, 0x7b, 0xad,
+		0x93, 0x91, 0x12, 0x8c, 0xcd, 0xb6, 0x79, 0x20, 0xae, 0x32, 0x63, 0xd6, 0x66, 0xbc, 0xad, 0x14,
+		0x27, 0x6a, 0x79, 0xd8, 0x35, 0x1c, 0x72, 0x6b, 0x07, 0xd5, 0x98, 0x48, 0x88, 0x2d, 0xe3, 0xa9,
+		0x67, 0x33, 0xec, 0x42, 0xa9, 0xdf, 0x50, 0x99, 0xc5, 0x80, 0x62, 0xf8, 0x81, 0x8d, 0xfb, 0x29,
+		0x97, 0x5f, 0xc3, 0x6b, 0x3a, 0x24, 0xd4, 0x07, 0x53, 0xfb, 0x60, 0x6a, 0x68, 0x85, 0x69, 0x5f,
+		0x41, 0xb9, 0x65, 0x84, 0xe9, 0x3c, 0xb2, 0x74, 0x71, 0xbe, 0x9b, 0x93, 0x44, 0xc3, 0x23, 0x70,
+		0xf2, 0x97, 0xad, 0xdb, 0xa3, 0xe3, 0xdd, 0x1e, 0xde, 0xed, 0xe1, 0x10, 0xf6, 0x69, 0xaf, 0xd1,
+		0xb9, 0x6b, 0x72, 0x0e, 0x1a, 0x9c, 0xa3, 0xe6, 0xf6, 0xf9, 0x60, 0xaf, 0x9a, 0x5a, 0x55, 0x0d,
+		0xad, 0x36, 0x95, 0xa1, 0xba, 0xaa, 0xe0, 0xa0, 0x89, 0x55, 0xd2, 0xc0, 0xea, 0xd0, 0xbc, 0x9a,
+		0x74, 0x7c, 0x3b, 0xd2, 0x7c, 0xae, 0xf6, 0x19, 0x2e, 0xa0, 0x98, 0x50, 0x30, 0x61, 0x9c, 0x58,
+		0x49, 0xf2, 0x85, 0xc8, 0xad, 0xc5, 0x05, 0x2a, 0xf1, 0xc2, 0x7c, 0x95, 0x4d, 0x7d, 0xdf, 0x30,
+		0x1a, 0x00, 0x92, 0x8d, 0x5b, 0x16, 0x82, 0x06, 0x54, 0x75, 0x67, 0xc2, 0xb3, 0xfa, 0x96, 0x93,
+		0x09, 0x46, 0x09, 0x20, 0x9d, 0xe2, 0xcc, 0xd1, 0x18, 0x43, 0x8c, 0xaf, 0x71, 0x3c, 0x8b, 0xaf,
+		0x44, 0x1c, 0x03, 0xa6, 0x89, 0x20, 0x98, 0x03, 0xa1, 0xf2, 0xbb, 0x1e, 0x1d, 0x10, 0x29, 0x2b,
+		0xb2, 0x54, 0x4c, 0x52, 0xe1, 0x79, 0x31, 0x80, 0xe7, 0xc5, 0x56, 0x04, 0xb2, 0x02, 0x5f, 0x75,
+		0x0b, 0x7c, 0x74, 0x09, 0x78, 0x74, 0x0b, 0x74, 0xac, 0x16, 0xe0, 0xa8, 0x03, 0x1b, 0x55, 0xb4,
+		0x82, 0x8b, 0x11, 0x58, 0x05, 0x34, 0x8e, 0x49, 0xe4, 0x32, 0x57, 0x05, 0x30, 0x26, 0x18, 0x59,
+		0x46, 0x2d, 0x5a, 0xdb, 0xc8, 0x9d, 0xa3, 0x14, 0xb3, 0x63, 0x71, 0xe2, 0x5f, 0xea, 0x50, 0x9c,
+		0x1c, 0xbe, 0xfa, 0x48, 0x2e, 0xa0, 0x7b, 0xbf, 0x56, 0xbb, 0x5a, 0x78, 0xd7, 0x20, 0xc5, 0x71,
+		0x59, 0x82, 0xff, 0x46, 0xc4, 0x2b, 0x4e, 0x76, 0xe3, 0x59, 0x3f, 0xa6, 0x38, 0x56, 0x29, 0xff,
+		0xdb, 0x33, 0xfe, 0x1d, 0x43, 0xdb, 0xce, 0x3d, 0x5f, 0xf1, 0x7c, 0xc5, 0x3a, 0xb4, 0x2d, 0x22,
+		0x98, 0xe3, 0x58, 0x5b, 0xcc, 0x9c, 0x1d, 0xdb, 0xc5, 0x45, 0xaa, 0x39, 0xb6, 0x5f, 0xa9, 0x95,
+		0xc0, 0x65, 0x25, 0x33, 0x3b, 0x5c, 0x65, 0x74, 0xa8, 0x82, 0x16, 0x15, 0xd1, 0xa3, 0x2a, 0x9a,
+		0xd4, 0x86, 0x2e, 0xb5, 0xa1, 0x4d, 0x75, 0xf4, 0xb1, 0x43, 0x23, 0x4b, 0x74, 0x72, 0x46, 0xab,
+		0xfc, 0xa3, 0xda, 0xd5, 0xf4, 0x09, 0x0b, 0x32, 0x0c, 0x99, 0xe0, 0x24, 0xc4, 0x15, 0x92, 0x14,
+		0x16, 0xda, 0xe0, 0xac, 0x59, 0xd7, 0xf1, 0xf4, 0xd7, 0x44, 0x98, 0x8e, 0xd1, 0x2d, 0x19, 0xa7,
+		0x63, 0xc8, 0x56, 0x06, 0x36, 0x80, 0xf9, 0xf3, 0x94, 0x5e, 0xa4, 0xf5, 0x38, 0x08, 0x11, 0xcd,
+		0x5a, 0x04, 0xb8, 0x3e, 0xdd, 0x2d, 0x22, 0xa5, 0x32, 0x02, 0xd7, 0x81, 0xc8, 0x35, 0x21, 0x74,
+		0x5d, 0x88, 0x5d, 0x3b, 0x82, 0xd7, 0x8e, 0xe8, 0xf5, 0x21, 0xbc, 0x1b, 0xe2, 0x3b, 0x12, 0x80,
+		0x2a, 0x36, 0xd4, 0xea, 0xba, 0xdf, 0x56, 0x1b, 0xeb, 0xcb, 0x2a, 0xf0, 0x66, 0x27, 0x3a, 0xd6,
+		0x68, 0x82, 0x5d, 0xfe, 0x54, 0x83, 0x77, 0xa8, 0x2b, 0x98, 0x66, 0xc5, 0xee, 0x58, 0x31, 0x38,
+		0xa3, 0x76, 0x1b, 0x64, 0xfd, 0x36, 0xc9, 0x9a, 0x30, 0x63, 0x09, 0x4b, 0x6e, 0xeb, 0xbf, 0x0a,
+		0xe3, 0xf2, 0xb5, 0x8f, 0xe9, 0x32, 0x0e, 0xee, 0x67, 0xf6, 0x55, 0x33, 0xf3, 0x0c, 0x6d, 0x75,
+		0x32, 0xb7, 0x78, 0x93, 0x6a, 0x21, 0x11, 0x79, 0xfc, 0xc1, 0x49, 0xc1, 0x5e, 0x70, 0x52, 0x54,
+		0x90, 0x1a, 0x10, 0xcd, 0x3c, 0x44, 0x9c, 0xc5, 0xd2, 0x08, 0x5f, 0x4d, 0xed, 0x5b, 0x5c, 0xa6,
+		0x9a, 0xe2, 0xf7, 0x53, 0xb6, 0xd6, 0xc9, 0xaf, 0x58, 0x24, 0xcc, 0x6b, 0x80, 0xbb, 0x15, 0x14,
+		0xbd, 0x06, 0xb8, 0x57, 0x0d, 0x30, 0x53, 0xa2, 0x02, 0x2c, 0xbb, 0xaf, 0xb1, 0xb8, 0x1e, 0xf5,
+		0x6f, 0x79, 0xd1, 0x5a, 0x75, 0xbf, 0x5c, 0xef, 0x43, 0x43, 0x0c, 0xd9, 0x03, 0xd6, 0x2b, 0x7d,
+		0x6d, 0xc0, 0xed, 0x61, 0x1b, 0x5e, 0xbf, 0x3c, 0x87, 0x6f, 0xe0, 0xe5, 0xf9, 0xff, 0xf5, 0x4a,
+		0xa0, 0x57, 0x02, 0xf7, 0x87, 0xf3, 0x6e, 0xb8, 0xef, 0x48, 0x03, 0x00, 0xbc, 0x02, 0xb7, 0xf1,
+		0xe3, 0x15, 0xb8, 0xc6, 0xe8, 0x0c, 0x5e, 0x81, 0x6b, 0xd0, 0x65, 0x3c, 0x76, 0x05, 0xce, 0x41,
+		0x76, 0x65, 0xa1, 0x40, 0x14, 0x07, 0xe3, 0x0a, 0xe8, 0x3e, 0x23, 0xa0, 0x85, 0xb5, 0xea, 0x91,
+		0x7f, 0xde, 0x12, 0xaa, 0xec, 0xde, 0x97, 0xff, 0x7c, 0x03, 0xbf, 0xa8, 0xc5, 0x65, 0xe7, 0x5c,
+		0xb7, 0x14, 0x73, 0xf0, 0x82, 0x0d, 0x80, 0x17, 0x6c, 0xbc, 0x75, 0x1b, 0xc0, 0x0b, 0x47, 0xd5,
+		0x3e, 0xbb, 0x11, 0x8e, 0x5e, 0x9e, 0x7b, 0xe9, 0xc8, 0x09, 0x4d, 0x76, 0x20, 0x1d, 0x7d, 0x7d,
+		0xe6, 0x85, 0xa3, 0xc7, 0x26, 0x1c, 0x3d, 0x11, 0xeb, 0xf6, 0xa2, 0x1d, 0xb8, 0x01, 0xf6, 0xed,
+		0xbc, 0x5c, 0xa2, 0xa3, 0x5d, 0xdb, 0xa5, 0xae, 0xe6, 0xb2, 0x1c, 0xf9, 0x8b, 0x5c, 0x03, 0xe4,
+		0x29, 0x79, 0x2b, 0xf6, 0xae, 0x04, 0x42, 0x6f, 0xc5, 0xde, 0x9b, 0x15, 0x5b, 0xa1, 0x44, 0xa0,
+		0x90, 0xde, 0xa9, 0x0c, 0xde, 0x7a, 0x1c, 0x2b, 0x2c, 0x58, 0x8f, 0xf6, 0x36, 0xc7, 0x3a, 0x1d,
+		0x67, 0xab, 0x6c, 0xd3, 0xff, 0x98, 0x46, 0x09, 0x1b, 0x62, 0xda, 0xee, 0xd1, 0x37, 0x02, 0x08,
+		0x87, 0x3e, 0xe6, 0x02, 0x26, 0x8a, 0x4d, 0x86, 0x18, 0x04, 0x83, 0x21, 0xb9, 0xc6, 0x80, 0x74,
+		0x55, 0x3d, 0x31, 0x42, 0x02, 0xf8, 0x88, 0xdd, 0x14, 0xb2, 0x3f, 0xe4, 0x77, 0xcf, 0xe4, 0x3c,
+		0x4c, 0x7b, 0x34, 0x1c, 0x31, 0x8e, 0x69, 0x1b, 0xde, 0x73, 0x42, 0x87, 0x80, 0x74, 0xc1, 0xed,
+		0x29, 0x3c, 0x93, 0xb3, 0x9f, 0x65, 0x95, 0xbd, 0x55, 0xe3, 0x3c, 0xca, 0x44, 0xb6, 0x32, 0x05,
+		0x42, 0x45, 0x4a, 0x24, 0xf3, 0x84, 0x11, 0xa1, 0x42, 0x95, 0xf4, 0xbe, 0x19, 0x91, 0x50, 0x97,
+		0xf2, 0x86, 0x1b, 0x24, 0x17, 0x97, 0xcb, 0x71, 0x95, 0x51, 0x8d, 0xa3, 0x63, 0xe0, 0x84, 0x86,
+		0xb3, 0xe2, 0xde, 0x88, 0x46, 0x7a, 0x24, 0x4a, 0x30, 0xb0, 0x31, 0x11, 0x02, 0x47, 0x3d, 0x3a,
+		0x48, 0xd8, 0x58, 0x6d, 0xd2, 0x2a, 0x0f, 0x65, 0x13, 0xc1, 0xf1, 0x1a, 0xaa, 0xd7, 0x50, 0x1d,
+		0x3e, 0x4f, 0x59, 0x43, 0x75, 0x2e, 0x3e, 0xb8, 0x8c, 0x7b, 0xa7, 0x2f, 0xbd, 0x48, 0x5a, 0xab,
+		0x48, 0xaa, 0x45, 0x38, 0x5f, 0xc5, 0xda, 0xe4, 0xb0, 0x9e, 0x50, 0xbd, 0x92, 0xfc, 0xf5, 0x9d,
+		0x4b, 0x95, 0x58, 0xb5, 0x8c, 0x34, 0x7c, 0x25, 0xa7, 0x57, 0x69, 0x55, 0x68, 0x74, 0x9a, 0xca,
+		0x26, 0xdc, 0x41, 0x88, 0x92, 0x84, 0xa0, 0xa1, 0x41, 0xab, 0xd3, 0xa5, 0xf1, 0x66, 0xcd, 0x4e,
+		0xdf, 0x65, 0x66, 0x08, 0x88, 0xb0, 0x40, 0x24, 0xe6, 0x25, 0x3d, 0x4c, 0x4f, 0x7d, 0x0f, 0xd3,
+		0x22, 0xe4, 0xdc, 0x53, 0x0f, 0x53, 0x94, 0x08, 0x12, 0xa6, 0x31, 0x12, 0x38, 0x32, 0xaf, 0xb8,
+		0x53, 0x9c, 0x64, 0x59, 0x74, 0x87, 0xcf, 0x7a, 0x50, 0x17, 0x16, 0xf9, 0xb6, 0x7c, 0x95, 0x01,
+		0x4a, 0x63, 0x61, 0x64, 0x96, 0x6d, 0x29, 0x53, 0xd5, 0x76, 0x7c, 0xbf, 0x32, 0xab, 0xf0, 0xd3,
+		0xf1, 0x15, 0x7e, 0xdc, 0x41, 0xda, 0x1e, 0xb4, 0xeb, 0x61, 0x2b, 0xc6, 0xc2, 0xde, 0xbc, 0xfd,
+		0x29, 0x63, 0x31, 0x46, 0x26, 0xfe, 0xc4, 0x19, 0xed, 0x3a, 0xdd, 0x41, 0xe5, 0x2b, 0x91, 0xd1,
+		0x4f, 0xd3, 0x7c, 0xd5, 0x39, 0xa4, 0x2c, 0x4d, 0xb4, 0x43, 0xc9, 0x79, 0x87, 0xea, 0x7c, 0x9d,
+		0xb5, 0xa5, 0x15, 0x0a, 0xdd, 0xa8, 0x08, 0x05, 0x04, 0x21, 0xa3, 0x02, 0x11, 0x8a, 0x93, 0x1e,
+		0x1d, 0xa1, 0x78, 0x10, 0xc8, 0xc9, 0x9f, 0x80, 0xf0, 0x5c, 0x59, 0x55, 0x3d, 0xe7, 0xfb, 0x09,
+		0xbe, 0x26, 0x62, 0xda, 0xee, 0xd1, 0xcb, 0x42, 0xd7, 0xaa, 0x54, 0x2a, 0xc0, 0x4a, 0x6d, 0x5e,
+		0xea, 0x7f, 0x35, 0xd7, 0xa2, 0x27, 0x09, 0xbb, 0x26, 0x11, 0x06, 0x4c, 0x59, 0x3a, 0x1c, 0x15,
+		0x7a, 0x5a, 0x15, 0x97, 0x0a, 0x11, 0xcd, 0xb7, 0x01, 0x88, 0x4e, 0xe1, 0xbf, 0x2f, 0xff, 0xf5,
+		0x13, 0x60, 0x2a, 0x88, 0x98, 0x42, 0x1f, 0x53, 0x8c, 0xc4, 0x08, 0x88, 0x00, 0xc1, 0xd4, 0x5f,
+		0x23, 0x3c, 0x11, 0x23, 0x08, 0x54, 0x4f, 0x89, 0xe3, 0xf9, 0xf6, 0x8f, 0x55, 0xc9, 0x88, 0x76,
+		0x7b, 0x66, 0x2b, 0x18, 0x32, 0x16, 0x2d, 0xd8, 0x0a, 0xa4, 0x9a, 0x3f, 0x4b, 0xd1, 0xcd, 0xea,
+		0x41, 0xc8, 0xb7, 0x1b, 0x23, 0x01, 0xcf, 0xb3, 0x33, 0x97, 0xe3, 0xc6, 0xe8, 0x13, 0x06, 0x22,
+		0x00, 0x23, 0x4e, 0x70, 0x02, 0x82, 0xf5, 0x68, 0xd6, 0x82, 0x7f, 0x9a, 0xcf, 0x32, 0x52, 0xdb,
+		0x0d, 0xbd, 0x58, 0x9e, 0xfa, 0x34, 0x91, 0xfa, 0x18, 0xd7, 0x17, 0x53, 0xf8, 0xc2, 0xed, 0xe2,
+		0xb1, 0x17, 0x30, 0x3e, 0x9f, 0xec, 0x96, 0x99, 0xfe, 0x86, 0x6a, 0xf3, 0x12, 0x11, 0x30, 0x42,
+		0x1c, 0xf4, 0x82, 0x96, 0xf9, 0xe7, 0x67, 0x3e, 0xff, 0xdc, 0xe7, 0x9f, 0x5b, 0xe7, 0x9f, 0xd3,
+		0x74, 0xdc, 0xc7, 0x49, 0x90, 0x41, 0x9c, 0xb3, 0xcb, 0x66, 0x71, 0x99, 0x6a, 0xae, 0x1b, 0xbd,
+		0x56, 0xce, 0x02, 0x5d, 0x96, 0x33, 0x17, 0x4f, 0x97, 0x3f, 0xad, 0x6e, 0xcb, 0x6a, 0xce, 0x95,
+		0xaf, 0xfe, 0x5e, 0x33, 0x2e, 0xd7, 0x86, 0xd3, 0xb5, 0xe1, 0x76, 0x75, 0x1c, 0xb7, 0xc3, 0x75,
+		0x4b, 0x9c, 0xb7, 0x17, 0xb5, 0x6b, 0x8f, 0xf8, 0xa9, 0x10, 0xe9, 0xf3, 0x48, 0x8a, 0xbf, 0xfb,
+		0x4e, 0x2e, 0xbb, 0x0a, 0xd4, 0x99, 0x97, 0x20, 0xf4, 0x85, 0xdf, 0x2b, 0xce, 0x6a, 0x42, 0x23,
+		0x17, 0xc5, 0xd2, 0x03, 0xe1, 0x42, 0xaa, 0x16, 0xe5, 0x6d, 0xbd, 0x46, 0xc5, 0x56, 0x6e, 0x72,
+		0x21, 0x70, 0x6d, 0x33, 0xed, 0x79, 0x39, 0x80, 0xe7, 0xe5, 0x3b, 0xa1, 0xa2, 0x55, 0xfc, 0xac,
+		0xbe, 0xb9, 0x73, 0x2d, 0xcd, 0x9d, 0xb9, 0xc0, 0x38, 0xae, 0xdc, 0xdc, 0x39, 0x49, 0xfb, 0xfd,
+		0x07, 0xd8, 0x87, 0x39, 0xdb, 0x76, 0xb5, 0x16, 0xcc, 0xfa, 0x04, 0x7d, 0xd3, 0x64, 0x27, 0xa7,
+		0xa4, 0xb3, 0x93, 0x72, 0xd1, 0x59, 0x78, 0xb2, 0x64, 0x8d, 0x3e, 0x29, 0xda, 0xaa, 0xf6, 0x58,
+		0xf9, 0xf1, 0x66, 0x84, 0x71, 0xec, 0x6a, 0x5f, 0x2b, 0x4e, 0x76, 0xac, 0xfc, 0xc8, 0x12, 0x10,
+		0x23, 0x65, 0xe7, 0xc6, 0x31, 0x57, 0xb6, 0xb6, 0x52, 0x03, 0x33, 0xe2, 0x18, 0x62, 0xf2, 0x09,
+		0x4b, 0xab, 0x32, 0xe2, 0xeb, 0x6d, 0xca, 0x90, 0x9b, 0x94, 0x7b, 0x74, 0xc1, 0xa6, 0xdc, 0xee,
+		0xd1, 0xd7, 0x28, 0x8f, 0x2e, 0x0b, 0x11, 0x85, 0x11, 0x52, 0x71, 0x68, 0x53, 0x98, 0x1b, 0x37,
+		0xa4, 0xa1, 0x9b, 0x17, 0x4c, 0xdf, 0x1c, 0x58, 0xa2, 0xcc, 0xe1, 0x3c, 0x37, 0x97, 0xab, 0x32,
+		0xc9, 0x38, 0xb7, 0x0c, 0x72, 0x1c, 0x0f, 0x20, 0x62, 0x98, 0x03, 0x65, 0x22, 0xaf, 0x9a, 0x9c,
+		0x3d, 0x75, 0xcc, 0x22, 0x1c, 0x43, 0x00, 0xfd, 0x54, 0xc8, 0x77, 0xa4, 0x30, 0x65, 0x29, 0x70,
+		0x81, 0x12, 0x01, 0x7c, 0x82, 0x43, 0x32, 0x98, 0x12, 0x3a, 0xec, 0x51, 0x44, 0x81, 0x08, 0x3c,
+		0x86, 0x3e, 0x96, 0x25, 0x99, 0x09, 0x1d, 0x82, 0x60, 0xc0, 0xa8, 0x7e, 0xc4, 0x31, 0x10, 0x15,
+		0x78, 0x27, 0x8d, 0xfe, 0xf8, 0x36, 0x8c, 0xd3, 0x08, 0xab, 0xd1, 0x1c, 0x54, 0x9c, 0x9b, 0x8a,
+		0xe8, 0x90, 0x06, 0xff, 0xff, 0x37, 0x22, 0xb1, 0x9e, 0xc1, 0xe7, 0xaf, 0xc6, 0x99, 0x2a, 0xdb,
+		0x8c, 0x06, 0xea, 0xf8, 0x38, 0x10, 0x0a, 0x21, 0x1b, 0x8f, 0x19, 0x55, 0xab, 0xaa, 0x98, 0x3f,
+		0xd9, 0x58, 0x5b, 0x3e, 0x2f, 0x22, 0x5c, 0xe6, 0x73, 0xa5, 0x84, 0x8f, 0x7a, 0x14, 0xcf, 0x4e,
+		0xa9, 0x3f, 0x95, 0x2b, 0xc9, 0x3d, 0x51, 0x09, 0xad, 0x31, 0xe2, 0x23, 0xf5, 0x0b, 0x1a, 0xcb,
+		0x1f, 0xd2, 0x7d, 0x11, 0x8e, 0x48, 0x1c, 0x25, 0x98, 0x7a, 0xbb, 0xe8, 0xde, 0xe4, 0xae, 0x27,
+		0x6f, 0x17, 0xd5, 0x04, 0xa3, 0xb2, 0x5d, 0x34, 0x5b, 0xa6, 0x2e, 0xbb, 0xa8, 0x5e, 0x2e, 0x77,
+		0x0c, 0x12, 0x0e, 0xe3, 0x5c, 0x5c, 0x3c, 0x06, 0x44, 0x23, 0xe0, 0x0c, 0x88, 0xd0, 0xd5, 0xd5,
+		0xfb, 0x18, 0xc2, 0x58, 0x52, 0x8a, 0x79, 0xb8, 0x6b, 0x8f, 0x12, 0x3e, 0x0b, 0x74, 0xf5, 0x4a,
+		0x4f, 0xdd, 0x48, 0x57, 0x1b, 0xf2, 0xd5, 0x86, 0x84, 0xd5, 0x91, 0xd1, 0x0e, 0x29, 0x2d, 0x91,
+		0xb3, 0x21, 0x4a, 0x8f, 0x37, 0x7e, 0xba, 0x5a, 0xe6, 0x7c, 0xe7, 0xcb, 0xf5, 0xd0, 0x5c, 0xa3,
+		0xf1, 0xd3, 0xa5, 0x4b, 0xfa, 0x43, 0x39, 0xe3, 0x27, 0x64, 0xfd, 0xcc, 0x34, 0x99, 0x28, 0x21,
+		0xd7, 0x98, 0xba, 0x4b, 0x15, 0x8b, 0xcb, 0xd4, 0x2c, 0x55, 0xe8, 0x55, 0xa5, 0x32, 0x81, 0x43,
+		0x94, 0xaa, 0xec, 0x1b, 0xc2, 0x61, 0x84, 0x38, 0x20, 0x88, 0xb4, 0x6b, 0x15, 0x88, 0x80, 0x50,
+		0x29, 0x0a, 0xfd, 0x59, 0x3a, 0xcc, 0xe6, 0x88, 0x1d, 0xad, 0xfb, 0x08, 0xa9, 0x1e, 0x70, 0xa1,
+		0xd4, 0x8d, 0x99, 0xb8, 0x02, 0x03, 0x82, 0xe3, 0x08, 0x58, 0x32, 0x57, 0x83, 0x94, 0x26, 0x85,
+		0x32, 0x8d, 0x04, 0x71, 0xad, 0x77, 0xdc, 0x10, 0xa5, 0x02, 0x15, 0x42, 0x92, 0xfa, 0xaa, 0xf7,
+		0x0c, 0x27, 0xfd, 0x58, 0x3d, 0xe3, 0x13, 0x65, 0x37, 0x9b, 0x44, 0x1c, 0x20, 0x83, 0xd9, 0xc6,
+		0xaf, 0x25, 0x3e, 0x71, 0xb8, 0xc1, 0xf3, 0x3c, 0x1e, 0xef, 0x5e, 0xf6, 0xd2, 0x99, 0x97, 0xce,
+		0x00, 0xbc, 0x84, 0xb5, 0xfa, 0xf1, 0x12, 0xd6, 0xce, 0xb9, 0xbf, 0x97, 0xb0, 0x76, 0x7f, 0xc6,
+		0xbe, 0xb1, 0x78, 0x73, 0x0c, 0xf0, 0x45, 0x63, 0xf6, 0xa3, 0x4e, 0xfc, 0xda, 0x7e, 0x0e, 0x0f,
+		0x33, 0x03, 0xcc, 0x28, 0x13, 0x0b, 0x8c, 0xdb, 0x96, 0xbf, 0x97, 0xcb, 0xfd, 0x90, 0xaf, 0x66,
+		0x9a, 0x51, 0x76, 0xb0, 0xe5, 0xcd, 0xf3, 0xb6, 0xf9, 0x2b, 0x2d, 0xd8, 0x5a, 0xaf, 0x6f, 0x05,
+		0x5f, 0xcb, 0x07, 0x3f, 0x1f, 0x94, 0xc9, 0x47, 0xb7, 0xe2, 0x62, 0x98, 0xb0, 0x74, 0x12, 0x08,
+		0xb4, 0x29, 0xd9, 0xd6, 0x58, 0x0a, 0x5a, 0x90, 0x72, 0xf4, 0x71, 0x00, 0xbe, 0x45, 0xe3, 0x49,
+		0x8c, 0x79, 0xab, 0xe4, 0x5d, 0x97, 0xa4, 0xe1, 0xed, 0x9d, 0xfe, 0x97, 0x3a, 0xfa, 0xaf, 0x21,
+		0xc6, 0x4e, 0x9d, 0xfb, 0xb7, 0x77, 0xe8, 0x5f, 0xbe, 0x8d, 0x12, 0xf8, 0x33, 0x85, 0xbb, 0x35,
+		0x87, 0x6e, 0x04, 0x60, 0xad, 0x83, 0xf5, 0x87, 0x79, 0x77, 0x50, 0xd8, 0xec, 0xa6, 0x4d, 0xb6,
+		0x08, 0xff, 0x11, 0x7d, 0xc2, 0xbf, 0x31, 0xb6, 0x7a, 0xa3, 0xcb, 0x1b, 0x6f, 0x1d, 0x1f, 0x6c,
+		0xd8, 0xd8, 0x2b, 0x7c, 0x3d, 0xc3, 0xf6, 0xbb, 0x83, 0xbb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff,
+		0x03, 0x00, 0x19, 0xfc, 0x1e, 0xcc, 0x02, 0x5f, 0x02, 0x00,
+	}
+)
+
+
+// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
+// correspond with the leaf. The type is represented as a reflect.Type. The naming
+// of the map ensures that there are no clashes with valid YANG identifiers.
+func initΛEnumTypes(){
+  ΛEnumTypes = map[string][]reflect.Type{
+	"/switch-model/port/speeds": []reflect.Type{
+		reflect.TypeOf((E_OnfSwitchTypes_Speed)(0)),
+	},
+	"/switch/port/speed": []reflect.Type{
+		reflect.TypeOf((E_OnfSwitchTypes_Speed)(0)),
+	},
+	"/vehicle/power-choice/electric-case/battery/material": []reflect.Type{
+		reflect.TypeOf((E_OnfTest1Choice_Vehicle_Battery_Material)(0)),
+	},
+	"/vehicle/power-choice/ice-case/engine-position": []reflect.Type{
+		reflect.TypeOf((E_OnfTest1Choice_Vehicle_EnginePosition)(0)),
+	},
+	"/vehicle/under-carriage/traction-choice/tracks-case/track-type": []reflect.Type{
+		reflect.TypeOf((E_OnfTest1Choice_Vehicle_UnderCarriage_TrackType)(0)),
+	},
+  }
+}
+
@@ -0,0 +1,420 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema_gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+const (
+	integerPattern = `^-?[0-9]+$`
+	decimalPattern = `^-?[0-9]+(\.[0-9]+)?$`
+	identityPrefix = "identity-"
+)
+
+// Schema is the subset of the JSON Schema vocabulary the YANG constraints are translated to
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	DependentSchemas     map[string]*Schema `json:"dependentSchemas,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *uint64            `json:"minItems,omitempty"`
+	MaxItems             *uint64            `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+	MinLength            *uint64            `json:"minLength,omitempty"`
+	MaxLength            *uint64            `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+type SchemaGenSettings struct {
+	ModelType    string
+	ModelVersion string
+	Title        string
+	Description  string
+	ID           string
+}
+
+// ApplyDefaults fills in the title and description from the model type and version
+func (settings *SchemaGenSettings) ApplyDefaults() {
+	if settings.Title == "" {
+		settings.Title = fmt.Sprintf("%s-%s", settings.ModelType, settings.ModelVersion)
+	}
+	if settings.Description == "" {
+		settings.Description = fmt.Sprintf("JSON Schema of the configuration of the %s onos-config model plugin", settings.ModelType)
+	}
+}
+
+// BuildJSONSchema builds the JSON Schema of the whole data tree of yangSchema, against which the JSON configs of
+// the model can be validated. Keys and mandatory leaves are required, config false nodes are read-only and the
+// cases of a choice exclude each other
+func BuildJSONSchema(yangSchema *ytypes.Schema, settings *SchemaGenSettings) (*Schema, error) {
+	settings.ApplyDefaults()
+	root := path.RootEntry(yangSchema.SchemaTree)
+	if root == nil {
+		return nil, fmt.Errorf("no root entry found in the schema")
+	}
+
+	b := &builder{root: root, defs: make(map[string]*Schema)}
+	schema, err := b.container(root, false)
+	if err != nil {
+		return nil, err
+	}
+	schema.Schema = Draft
+	schema.ID = settings.ID
+	schema.Title = settings.Title
+	schema.Description = settings.Description
+	if len(b.defs) > 0 {
+		schema.Defs = b.defs
+	}
+	return schema, nil
+}
+
+type builder struct {
+	root *yang.Entry
+	// defs holds the schemas of the identities, shared by their identityref leaves
+	defs map[string]*Schema
+}
+
+// container returns the object schema of a container, a list entry or the root
+func (b *builder) container(entry *yang.Entry, readOnly bool) (*Schema, error) {
+	closed := false
+	schema := &Schema{Type: "object", Description: entry.Description, AdditionalProperties: &closed, ReadOnly: readOnly}
+	if err := b.addChildren(schema, entry, readOnly); err != nil {
+		return nil, err
+	}
+	if entry.IsList() {
+		for _, key := range strings.Fields(entry.Key) {
+			schema.Required = appendUnique(schema.Required, key)
+		}
+	}
+	sort.Strings(schema.Required)
+	return schema, nil
+}
+
+// addChildren adds the schema of the children of entry to the properties of schema; the children of choices and
+// cases are properties of the schema itself, as in the JSON encoding of the data tree
+func (b *builder) addChildren(schema *Schema, entry *yang.Entry, readOnly bool) error {
+	for _, name := range path.SortedDirNames(entry) {
+		child := entry.Dir[name]
+		if child.IsChoice() {
+			if err := b.addChoice(schema, child, readOnly); err != nil {
+				return err
+			}
+			continue
+		}
+		childReadOnly := readOnly || child.Config == yang.TSFalse
+		property, err := b.node(child, childReadOnly)
+		if err != nil {
+			return err
+		}
+		if schema.Properties == nil {
+			schema.Properties = make(map[string]*Schema)
+		}
+		schema.Properties[child.Name] = property
+		if child.IsLeaf() && child.Mandatory == yang.TSTrue {
+			schema.Required = appendUnique(schema.Required, child.Name)
+		}
+	}
+	return nil
+}
+
+// addChoice adds the children of every case of choice to schema, and a dependent schema per child which
+// excludes the children of the other cases
+func (b *builder) addChoice(schema *Schema, choice *yang.Entry, readOnly bool) error {
+	cases := make([][]string, 0, len(choice.Dir))
+	for _, name := range path.SortedDirNames(choice) {
+		c := choice.Dir[name]
+		caseEntry := c
+		if !c.IsCase() {
+			// A shorthand case, made of a single node
+			caseEntry = &yang.Entry{Name: c.Name, Kind: yang.CaseEntry, Dir: map[string]*yang.Entry{c.Name: c}}
+		}
+		caseSchema := &Schema{}
+		if err := b.addChildren(caseSchema, caseEntry, readOnly); err != nil {
+			return err
+		}
+		names := sortedSchemaNames(caseSchema.Properties)
+		cases = append(cases, names)
+		for _, name := range names {
+			if schema.Properties == nil {
+				schema.Properties = make(map[string]*Schema)
+			}
+			schema.Properties[name] = caseSchema.Properties[name]
+		}
+	}
+
+	for i, names := range cases {
+		others := make([]*Schema, 0)
+		for j, otherNames := range cases {
+			if i == j {
+				continue
+			}
+			for _, other := range otherNames {
+				others = append(others, &Schema{Required: []string{other}})
+			}
+		}
+		if len(others) == 0 {
+			continue
+		}
+		for _, name := range names {
+			if schema.DependentSchemas == nil {
+				schema.DependentSchemas = make(map[string]*Schema)
+			}
+			schema.DependentSchemas[name] = &Schema{Not: &Schema{AnyOf: others}}
+		}
+	}
+
+	if choice.Mandatory == yang.TSTrue {
+		oneCase := make([]*Schema, 0)
+		for _, names := range cases {
+			for _, name := range names {
+				oneCase = append(oneCase, &Schema{Required: []string{name}})
+			}
+		}
+		schema.AllOf = append(schema.AllOf, &Schema{AnyOf: oneCase})
+	}
+	return nil
+}
+
+// node returns the schema of a container, list, leaf or leaf-list
+func (b *builder) node(entry *yang.Entry, readOnly bool) (*Schema, error) {
+	switch {
+	case entry.IsList():
+		item, err := b.container(entry, readOnly)
+		if err != nil {
+			return nil, err
+		}
+		item.Description = ""
+		schema := &Schema{Type: "array", Description: entry.Description, Items: item, UniqueItems: true, ReadOnly: readOnly}
+		setElements(schema, entry.ListAttr)
+		return schema, nil
+	case entry.IsLeafList():
+		item, err := b.leafType(entry, entry.Type)
+		if err != nil {
+			return nil, err
+		}
+		schema := &Schema{Type: "array", Description: entry.Description, Items: item, UniqueItems: !readOnly, ReadOnly: readOnly}
+		setElements(schema, entry.ListAttr)
+		if defaults := path.DefaultValues(entry); len(defaults) > 0 {
+			values := make([]interface{}, 0, len(defaults))
+			for _, value := range defaults {
+				values = append(values, path.JSONValue(entry.Type, value))
+			}
+			schema.Default = values
+		}
+		return schema, nil
+	case entry.IsLeaf():
+		schema, err := b.leafType(entry, entry.Type)
+		if err != nil {
+			return nil, err
+		}
+		schema.Description = entry.Description
+		schema.ReadOnly = readOnly
+		if defaults := path.DefaultValues(entry); len(defaults) > 0 {
+			schema.Default = path.JSONValue(entry.Type, defaults[0])
+		}
+		return schema, nil
+	default:
+		return b.container(entry, readOnly)
+	}
+}
+
+func setElements(schema *Schema, listAttr *yang.ListAttr) {
+	if listAttr == nil {
+		return
+	}
+	if listAttr.MinElements > 0 {
+		minElements := listAttr.MinElements
+		schema.MinItems = &minElements
+	}
+	if listAttr.MaxElements > 0 && listAttr.MaxElements != ^uint64(0) {
+		maxElements := listAttr.MaxElements
+		schema.MaxItems = &maxElements
+	}
+}
+
+// leafType returns the schema of the values of a leaf or leaf-list of the given type
+func (b *builder) leafType(entry *yang.Entry, yangType *yang.YangType) (*Schema, error) {
+	if yangType == nil {
+		return &Schema{}, nil
+	}
+	switch yangType.Kind {
+	case yang.Ystring:
+		schema := &Schema{Type: "string"}
+		setLength(schema, yangType.Length)
+		patterns := yangType.POSIXPattern
+		if len(patterns) == 0 {
+			patterns = yangType.Pattern
+		}
+		for i, pattern := range patterns {
+			anchored := "^(?:" + pattern + ")$"
+			if i == 0 {
+				schema.Pattern = anchored
+			} else {
+				schema.AllOf = append(schema.AllOf, &Schema{Pattern: anchored})
+			}
+		}
+		return schema, nil
+	case yang.Ybool:
+		return &Schema{Type: "boolean"}, nil
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		schema := &Schema{Type: "integer"}
+		setRange(schema, yangType.Range)
+		return schema, nil
+	case yang.Yint64, yang.Yuint64:
+		// RFC 7951 encodes 64 bit integers as strings
+		schema := &Schema{Type: []string{"integer", "string"}, Pattern: integerPattern}
+		setRange(schema, yangType.Range)
+		return schema, nil
+	case yang.Ydecimal64:
+		schema := &Schema{Type: []string{"number", "string"}, Pattern: decimalPattern}
+		setRange(schema, yangType.Range)
+		return schema, nil
+	case yang.Yenum:
+		schema := &Schema{Type: "string"}
+		if yangType.Enum != nil {
+			schema.Enum = yangType.Enum.Names()
+			sort.Strings(schema.Enum)
+		}
+		return schema, nil
+	case yang.Yidentityref:
+		return b.identity(yangType.IdentityBase), nil
+	case yang.Ybinary:
+		schema := &Schema{Type: "string", ContentEncoding: "base64"}
+		return schema, nil
+	case yang.Ybits:
+		return &Schema{Type: "string"}, nil
+	case yang.Yempty:
+		// RFC 7951 encodes empty leaves as [null], onos-config as null
+		return &Schema{Type: []string{"array", "null"}, Items: &Schema{Type: "null"}, MinItems: uint64Ptr(1), MaxItems: uint64Ptr(1)}, nil
+	case yang.Yunion:
+		schema := &Schema{}
+		for _, member := range yangType.Type {
+			memberSchema, err := b.leafType(entry, member)
+			if err != nil {
+				return nil, err
+			}
+			schema.AnyOf = append(schema.AnyOf, memberSchema)
+		}
+		return schema, nil
+	case yang.Yleafref:
+		target := path.ResolveLeafRef(entry, yangType.Path)
+		if target == nil || target == entry {
+			return &Schema{}, nil
+		}
+		return b.leafType(target, target.Type)
+	default:
+		return &Schema{}, nil
+	}
+}
+
+// identity returns a reference to the schema of the values of an identity, which may be prefixed by their module
+func (b *builder) identity(base *yang.Identity) *Schema {
+	if base == nil {
+		return &Schema{Type: "string"}
+	}
+	def := identityPrefix + base.Name
+	if _, ok := b.defs[def]; !ok {
+		names := make([]string, 0, len(base.Values))
+		for _, value := range base.Values {
+			names = append(names, value.Name)
+		}
+		sort.Strings(names)
+		schema := &Schema{Type: "string", Description: fmt.Sprintf("The identities derived from %s", base.Name)}
+		if len(names) > 0 {
+			quoted := make([]string, 0, len(names))
+			for _, name := range names {
+				quoted = append(quoted, regexp.QuoteMeta(name))
+			}
+			schema.Pattern = fmt.Sprintf("^([^:]+:)?(%s)$", strings.Join(quoted, "|"))
+		} else {
+			schema.Not = &Schema{}
+		}
+		b.defs[def] = schema
+	}
+	return &Schema{Ref: "#/$defs/" + def}
+}
+
+func setRange(schema *Schema, yangRange yang.YangRange) {
+	if len(yangRange) == 0 {
+		return
+	}
+	if len(yangRange) == 1 {
+		schema.Minimum = json.Number(yangRange[0].Min.String())
+		schema.Maximum = json.Number(yangRange[0].Max.String())
+		return
+	}
+	for _, r := range yangRange {
+		schema.AnyOf = append(schema.AnyOf, &Schema{Minimum: json.Number(r.Min.String()), Maximum: json.Number(r.Max.String())})
+	}
+}
+
+func setLength(schema *Schema, length yang.YangRange) {
+	if len(length) == 0 {
+		return
+	}
+	minLength, maxLength := length[0].Min.Value, length[len(length)-1].Max.Value
+	if minLength > 0 {
+		schema.MinLength = &minLength
+	}
+	if maxLength != ^uint64(0) {
+		schema.MaxLength = &maxLength
+	}
+	if len(length) > 1 {
+		for _, r := range length {
+			rMin, rMax := r.Min.Value, r.Max.Value
+			schema.AnyOf = append(schema.AnyOf, &Schema{MinLength: &rMin, MaxLength: &rMax})
+		}
+	}
+}
+
+func sortedSchemaNames(schemas map[string]*Schema) []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
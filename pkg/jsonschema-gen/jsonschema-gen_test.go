@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema_gen
+
+import (
+	"encoding/json"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testEntry(name string, kind yang.EntryKind, children ...*yang.Entry) *yang.Entry {
+	entry := &yang.Entry{Name: name, Kind: kind, Dir: make(map[string]*yang.Entry)}
+	for _, child := range children {
+		child.Parent = entry
+		entry.Dir[child.Name] = child
+	}
+	return entry
+}
+
+func testLeaf(name string, yangType *yang.YangType) *yang.Entry {
+	return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: yangType}
+}
+
+func testSchema() *ytypes.Schema {
+	mtu := testLeaf("mtu", &yang.YangType{Kind: yang.Yuint16, Range: yang.YangRange{{Min: yang.FromInt(64), Max: yang.FromInt(9000)}}})
+	mtu.Default = []string{"1500"}
+	name := testLeaf("name", &yang.YangType{Kind: yang.Ystring, Length: yang.YangRange{{Min: yang.FromInt(1), Max: yang.FromInt(16)}}, Pattern: []string{"eth[0-9]+"}})
+	kind := testLeaf("kind", &yang.YangType{Kind: yang.Yidentityref, IdentityBase: &yang.Identity{Name: "interface-type", Values: []*yang.Identity{{Name: "ethernet"}, {Name: "loopback"}}}})
+	kind.Mandatory = yang.TSTrue
+	status := testLeaf("status", &yang.YangType{Kind: yang.Yenum, Enum: yang.NewEnumType()})
+	status.Config = yang.TSFalse
+	_ = status.Type.Enum.Set("up", 1)
+	_ = status.Type.Enum.Set("down", 2)
+	list := testEntry("interface", yang.DirectoryEntry, name, mtu, kind, status)
+	list.ListAttr = &yang.ListAttr{MaxElements: 8}
+	list.Key = "name"
+
+	ref := testLeaf("primary", &yang.YangType{Kind: yang.Yleafref, Path: "../../interfaces/interface[name=current()/../primary]/mtu"})
+	dhcp := testEntry("dhcp", yang.CaseEntry, testLeaf("dhcp-server", &yang.YangType{Kind: yang.Ystring}))
+	static := testEntry("static", yang.CaseEntry, testLeaf("address", &yang.YangType{Kind: yang.Ystring}), testLeaf("gateway", &yang.YangType{Kind: yang.Ystring}))
+	choice := testEntry("addressing", yang.ChoiceEntry, dhcp, static)
+	system := testEntry("system", yang.DirectoryEntry, ref, choice)
+
+	root := testEntry("device", yang.DirectoryEntry, testEntry("interfaces", yang.DirectoryEntry, list), system)
+	root.Annotation = map[string]interface{}{"isFakeRoot": true}
+	return &ytypes.Schema{SchemaTree: map[string]*yang.Entry{"Device": root}}
+}
+
+func TestBuildJSONSchema(t *testing.T) {
+	schema, err := BuildJSONSchema(testSchema(), &SchemaGenSettings{ModelType: "test", ModelVersion: "1.0.0"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, Draft, schema.Schema)
+	assert.Equal(t, "test-1.0.0", schema.Title)
+	assert.False(t, *schema.AdditionalProperties)
+
+	interfaces := schema.Properties["interfaces"].Properties["interface"]
+	assert.Equal(t, "array", interfaces.Type)
+	assert.Equal(t, uint64(8), *interfaces.MaxItems)
+	item := interfaces.Items
+	assert.Equal(t, []string{"kind", "name"}, item.Required)
+
+	name := item.Properties["name"]
+	assert.Equal(t, "^(?:eth[0-9]+)$", name.Pattern)
+	assert.Equal(t, uint64(1), *name.MinLength)
+	assert.Equal(t, uint64(16), *name.MaxLength)
+
+	mtu := item.Properties["mtu"]
+	assert.Equal(t, "integer", mtu.Type)
+	assert.Equal(t, json.Number("64"), mtu.Minimum)
+	assert.Equal(t, json.Number("9000"), mtu.Maximum)
+	assert.Equal(t, uint64(1500), mtu.Default)
+
+	assert.Equal(t, "#/$defs/identity-interface-type", item.Properties["kind"].Ref)
+	assert.Equal(t, "^([^:]+:)?(ethernet|loopback)$", schema.Defs["identity-interface-type"].Pattern)
+
+	status := item.Properties["status"]
+	assert.Equal(t, []string{"down", "up"}, status.Enum)
+	assert.True(t, status.ReadOnly)
+
+	system := schema.Properties["system"]
+	assert.Equal(t, "integer", system.Properties["primary"].Type)
+	assert.Contains(t, system.Properties, "dhcp-server")
+	assert.Contains(t, system.Properties, "address")
+	assert.NotContains(t, system.Properties, "addressing")
+	assert.Equal(t, []string{"address"}, system.DependentSchemas["dhcp-server"].Not.AnyOf[0].Required)
+	assert.Equal(t, []string{"gateway"}, system.DependentSchemas["dhcp-server"].Not.AnyOf[1].Required)
+	assert.Equal(t, []string{"dhcp-server"}, system.DependentSchemas["gateway"].Not.AnyOf[0].Required)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/onosproject/config-models/pkg/path"
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ytypes"
 	"golang.org/x/text/cases"
@@ -17,6 +18,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 )
 
@@ -43,12 +45,13 @@ const (
 	xYangType        = "x-yang-type"
 )
 
-var swagger openapi3.T
-
 var respGet200Desc = "GET OK 200"
 var pathPrefix string
 var targetParameter *openapi3.ParameterRef
 
+// buildLock serializes BuildOpenapi calls, which share pathPrefix and targetParameter
+var buildLock sync.Mutex
+
 type ApiGenSettings struct {
 	ModelType    string
 	ModelVersion string
@@ -106,13 +109,15 @@ func (settings *ApiGenSettings) ApplyDefaults() {
 }
 
 func BuildOpenapi(yangSchema *ytypes.Schema, settings *ApiGenSettings) (*openapi3.T, error) {
+	buildLock.Lock()
+	defer buildLock.Unlock()
 	settings.ApplyDefaults()
 
 	pathPrefix = fmt.Sprintf("/%s/v%s/{%s}", strings.ToLower(settings.ModelType), settings.ModelVersion, settings.TargetAlias)
 	targetParameter = targetParam(settings.TargetAlias)
 
 	var hasLeafref = false
-	topEntry := yangSchema.SchemaTree["Device"]
+	topEntry := path.RootEntry(yangSchema.SchemaTree)
 	paths, components, err := buildSchema(topEntry, yang.TSFalse, "", settings.TargetAlias, &hasLeafref)
 	if err != nil {
 		return nil, err
@@ -160,7 +165,7 @@ func BuildOpenapi(yangSchema *ytypes.Schema, settings *ApiGenSettings) (*openapi
 		addLeafRefSchema(components)
 	}
 
-	swagger = openapi3.T{
+	swagger := openapi3.T{
 		OpenAPI: "3.0.0",
 		Info: &openapi3.Info{
 			Title:       settings.Title,
@@ -258,7 +263,8 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 		RequestBodies: make(map[string]*openapi3.RequestBodyRef),
 	}
 
-	for _, dirEntry := range deviceEntry.Dir {
+	for _, name := range sortedDirNames(deviceEntry) {
+		dirEntry := deviceEntry.Dir[name]
 		itemPath := fmt.Sprintf("%s/%s", parentPath, dirEntry.Name)
 		if dirEntry.IsLeaf() || dirEntry.IsLeafList() {
 			// No need to recurse
@@ -503,7 +509,8 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			if err != nil {
 				return nil, nil, err
 			}
-			for _, v := range components.Schemas {
+			for _, k := range sortedSchemaNames(components.Schemas) {
+				v := components.Schemas[k]
 				title := v.Value.Title
 				if caseName, ok := v.Value.Extensions[xCase]; ok {
 					v.Value.Extensions[xChoiceCase] = caseName
@@ -550,7 +557,8 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			caseSchema.Description = dirEntry.Description
 			caseSchema.Properties = make(map[string]*openapi3.SchemaRef, 0)
 			caseSchema.Extensions = map[string]interface{}{xCase: dirEntry.Name}
-			for k, v := range components.Schemas {
+			for _, k := range sortedSchemaNames(components.Schemas) {
+				v := components.Schemas[k]
 				simpleName := strings.ToLower(strings.TrimPrefix(k, fmt.Sprintf("%s_", toUnderScore(parentPath))))
 				switch v.Value.Type {
 				case "object":
@@ -650,7 +658,8 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			})
 			newPath.Get.AddResponse(200, respGet200)
 
-			for k, v := range components.Schemas {
+			for _, k := range sortedSchemaNames(components.Schemas) {
+				v := components.Schemas[k]
 				switch v.Value.Type {
 				case "array": // List as a child of container
 					schemaPath := pathToSchemaName(itemPath)
@@ -844,7 +853,8 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			if len(strings.Split(itemPath, "/")) <= 2 {
 				addAdditionalProperties(asSingle, additionalPropertyTarget(targetAlias))
 			}
-			for k, v := range components.Schemas {
+			for _, k := range sortedSchemaNames(components.Schemas) {
+				v := components.Schemas[k]
 				switch v.Value.Type {
 				case "array": // List as a child of list
 					schemaPath := pathToSchemaName(itemPath)
@@ -1031,6 +1041,28 @@ func newPathItem(dirEntry *yang.Entry, itemPath string, parentPath string, pathT
 	return &newPath
 }
 
+// sortedDirNames returns the names of the children of entry in order, so that the
+// generated specs do not depend on map iteration order
+func sortedDirNames(entry *yang.Entry) []string {
+	names := make([]string, 0, len(entry.Dir))
+	for name := range entry.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedSchemaNames returns the names of the schemas in order; the first schema processed
+// can be overridden by a later one with the same property name, so order matters
+func sortedSchemaNames(schemas openapi3.Schemas) []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func toUnderScore(itemPath string) string {
 	pathParts := make([]string, 0)
 	for _, pathPart := range strings.Split(itemPath, "/") {
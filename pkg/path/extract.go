@@ -26,11 +26,21 @@ var roPaths []*admin.ReadOnlyPath
 var rwPaths []*admin.ReadWritePath
 var nsMappings []*admin.Namespace
 
+// RootEntry returns the fake root that ygot generates at the top of the data tree, whatever its name
+func RootEntry(entries map[string]*yang.Entry) *yang.Entry {
+	for _, entry := range entries {
+		if isFakeRoot, ok := entry.Annotation["isFakeRoot"].(bool); ok && isFakeRoot {
+			return entry
+		}
+	}
+	return entries["Device"]
+}
+
 // ExtractPaths parse the schema entries out in to flat paths
 func ExtractPaths(entries map[string]*yang.Entry) ([]*admin.ReadOnlyPath, []*admin.ReadWritePath, []*admin.Namespace) {
 	var err error
 	var namespaceMappings map[string]string
-	roPaths, rwPaths, namespaceMappings, err = extractPaths(entries["Device"], yang.TSUnset, "", "")
+	roPaths, rwPaths, namespaceMappings, err = extractPaths(RootEntry(entries), yang.TSUnset, "", "")
 	if err != nil {
 		log.Errorf(err.Error())
 		panic(err)
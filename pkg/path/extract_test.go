@@ -692,3 +692,15 @@ var (
 		0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xf8, 0x5b, 0x38, 0xe1, 0xd3, 0x00, 0x00,
 	}
 )
+
+func Test_RootEntry(t *testing.T) {
+	schemaTree, err := ygot.GzipToSchema(testdevice10XSchema)
+	assert.NoError(t, err)
+	root := RootEntry(schemaTree)
+	if assert.NotNil(t, root) {
+		assert.Equal(t, "device", root.Name)
+	}
+
+	renamed := &yang.Entry{Name: "my-root", Annotation: map[string]interface{}{"isFakeRoot": true}}
+	assert.Equal(t, renamed, RootEntry(map[string]*yang.Entry{"MyRoot": renamed, "Other": {Name: "other"}}))
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"strings"
+)
+
+// ResolveLeafRef returns the entry a leafref path points to, or nil if it cannot be found. Relative paths are
+// evaluated from entry, absolute ones from the root of its tree, and predicates are ignored
+func ResolveLeafRef(entry *yang.Entry, leafRefPath string) *yang.Entry {
+	// Relative paths are evaluated from the leaf itself
+	current := entry
+	if strings.HasPrefix(leafRefPath, "/") {
+		current = root(entry)
+	}
+	for _, element := range strings.Split(strings.Trim(removePredicates(leafRefPath), "/"), "/") {
+		if current == nil {
+			return nil
+		}
+		element = strings.TrimSpace(element)
+		switch element {
+		case "", ".":
+		case "..":
+			current = dataParent(current)
+		default:
+			if i := strings.Index(element, ":"); i >= 0 {
+				element = element[i+1:]
+			}
+			current = DataChildren(current)[element]
+		}
+	}
+	return current
+}
+
+func root(entry *yang.Entry) *yang.Entry {
+	for entry.Parent != nil {
+		entry = entry.Parent
+	}
+	return entry
+}
+
+// dataParent returns the parent of entry in the data tree, skipping choices and cases
+func dataParent(entry *yang.Entry) *yang.Entry {
+	parent := entry.Parent
+	for parent != nil && (parent.IsChoice() || parent.IsCase()) {
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// DataChildren returns the children of entry in the data tree, by name, those of its choices and cases included
+func DataChildren(entry *yang.Entry) map[string]*yang.Entry {
+	children := make(map[string]*yang.Entry)
+	for name, child := range entry.Dir {
+		if child.IsChoice() || child.IsCase() {
+			for caseName, caseChild := range DataChildren(child) {
+				children[caseName] = caseChild
+			}
+			continue
+		}
+		children[name] = child
+	}
+	return children
+}
+
+func removePredicates(p string) string {
+	var sb strings.Builder
+	depth := 0
+	for _, r := range p {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_ResolveLeafRef(t *testing.T) {
+	device := &yang.Entry{Name: "device", Kind: yang.DirectoryEntry, Dir: make(map[string]*yang.Entry)}
+	addChild := func(parent *yang.Entry, child *yang.Entry) *yang.Entry {
+		if parent.Dir == nil {
+			parent.Dir = make(map[string]*yang.Entry)
+		}
+		child.Parent = parent
+		parent.Dir[child.Name] = child
+		return child
+	}
+	interfaces := addChild(device, &yang.Entry{Name: "interfaces", Kind: yang.DirectoryEntry})
+	iface := addChild(interfaces, &yang.Entry{Name: "interface", Kind: yang.DirectoryEntry, Key: "name", ListAttr: &yang.ListAttr{}})
+	name := addChild(iface, &yang.Entry{Name: "name", Kind: yang.LeafEntry})
+	choice := addChild(iface, &yang.Entry{Name: "addressing", Kind: yang.ChoiceEntry})
+	static := addChild(choice, &yang.Entry{Name: "static", Kind: yang.CaseEntry})
+	address := addChild(static, &yang.Entry{Name: "address", Kind: yang.LeafEntry})
+	ref := addChild(iface, &yang.Entry{Name: "ref", Kind: yang.LeafEntry})
+
+	assert.Equal(t, name, ResolveLeafRef(ref, "/interfaces/interface/name"))
+	assert.Equal(t, name, ResolveLeafRef(ref, "/if:interfaces/if:interface[if:name=current()/../ref]/if:name"))
+	assert.Equal(t, name, ResolveLeafRef(ref, "../name"))
+	assert.Equal(t, address, ResolveLeafRef(ref, "../address"))
+	assert.Equal(t, name, ResolveLeafRef(address, "../name"))
+	assert.Nil(t, ResolveLeafRef(ref, "../missing"))
+	assert.Nil(t, ResolveLeafRef(ref, "/interfaces/interface/name/../../../../x"))
+}
+
+func Test_removePredicates(t *testing.T) {
+	assert.Equal(t, "../a/b/c", removePredicates("../a[x=current()/../y]/b[z='[1]']/c"))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// DecodeNotification decodes a config sent as a proto encoded gnmi.Notification. It returns false for JSON trees,
+// which cannot start with anything but white space, '{' or '[', and for anything that does not decode as a
+// notification without unknown fields, so that the config is then reported as invalid JSON rather than proto
+func DecodeNotification(config []byte) (*gnmi.Notification, bool) {
+	trimmed := bytes.TrimLeft(config, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] == '{' || trimmed[0] == '[' {
+		return nil, false
+	}
+	notification := &gnmi.Notification{}
+	if err := proto.Unmarshal(config, notification); err != nil {
+		log.Debugf("Config is neither JSON nor a proto encoded notification: %v", err)
+		return nil, false
+	}
+	if len(notification.ProtoReflect().GetUnknown()) > 0 {
+		log.Debugf("Config is neither JSON nor a proto encoded notification: unknown fields")
+		return nil, false
+	}
+	return notification, true
+}
+
+// UnmarshalNotification sets the values of the updates of a gnmi.Notification, whose paths are relative to the
+// notification prefix, in the root struct of schema
+func UnmarshalNotification(notification *gnmi.Notification, schema *ytypes.Schema) error {
+	if len(notification.Delete) > 0 {
+		return fmt.Errorf("a config cannot delete paths")
+	}
+	for _, update := range notification.Update {
+		updatePath := &gnmi.Path{Elem: append(append([]*gnmi.PathElem{}, notification.GetPrefix().GetElem()...), update.GetPath().GetElem()...)}
+		if err := ytypes.SetNode(schema.RootSchema(), schema.Root, updatePath, update.Val, &ytypes.InitMissingElements{}); err != nil {
+			pathString, _ := ygot.PathToString(updatePath)
+			return fmt.Errorf("unable to set %s: %v", pathString, err)
+		}
+	}
+	return nil
+}
+
+// NotificationToJSON returns the JSON tree of the config held by a gnmi.Notification, as GetPathValues expects it
+func NotificationToJSON(notification *gnmi.Notification, schema *ytypes.Schema) ([]byte, error) {
+	if err := UnmarshalNotification(notification, schema); err != nil {
+		return nil, err
+	}
+	json, err := ygot.EmitJSON(schema.Root, &ygot.EmitJSONConfig{Format: ygot.RFC7951, SkipValidation: true})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(json), nil
+}
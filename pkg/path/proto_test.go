@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+)
+
+// protoDevice and its children hold a few of the testdevice-1.0.x leaves, as the Golang bindings would
+type protoDevice struct {
+	Cont1A *protoCont1A `path:"cont1a" module:"onf-test1"`
+}
+
+func (*protoDevice) IsYANGGoStruct()                         {}
+func (*protoDevice) Validate(...ygot.ValidationOption) error { return nil }
+func (*protoDevice) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*protoDevice) ΛBelongingModule() string                { return "" }
+
+type protoCont1A struct {
+	Leaf1A *string                 `path:"leaf1a" module:"onf-test1"`
+	List2A map[string]*protoList2A `path:"list2a" module:"onf-test1"`
+}
+
+func (*protoCont1A) IsYANGGoStruct()                         {}
+func (*protoCont1A) Validate(...ygot.ValidationOption) error { return nil }
+func (*protoCont1A) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*protoCont1A) ΛBelongingModule() string                { return "onf-test1" }
+
+type protoList2A struct {
+	Name    *string `path:"name" module:"onf-test1"`
+	TxPower *uint16 `path:"tx-power" module:"onf-test1"`
+}
+
+func (*protoList2A) IsYANGGoStruct()                         {}
+func (*protoList2A) Validate(...ygot.ValidationOption) error { return nil }
+func (*protoList2A) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*protoList2A) ΛBelongingModule() string                { return "onf-test1" }
+
+func (t *protoList2A) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *t.Name}, nil
+}
+
+func protoSchema(t *testing.T) *ytypes.Schema {
+	schemaTree, err := ygot.GzipToSchema(testdevice10XSchema)
+	assert.NoError(t, err)
+	// The root schema is looked up by the name of the root struct
+	schemaTree["protoDevice"] = schemaTree["Device"]
+	return &ytypes.Schema{Root: &protoDevice{}, SchemaTree: schemaTree}
+}
+
+func protoNotification(t *testing.T, notification *gnmi.Notification) []byte {
+	config, err := proto.Marshal(notification)
+	assert.NoError(t, err)
+	return config
+}
+
+var sampleNotification = &gnmi.Notification{
+	Prefix: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "cont1a"}}},
+	Update: []*gnmi.Update{
+		{
+			Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "leaf1a"}}},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "leaf1aval"}},
+		},
+		{
+			Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "list2a", Key: map[string]string{"name": "l2a1"}}, {Name: "tx-power"}}},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 5}},
+		},
+	},
+}
+
+func Test_DecodeNotification(t *testing.T) {
+	notification, ok := DecodeNotification(protoNotification(t, sampleNotification))
+	if assert.True(t, ok) {
+		assert.True(t, proto.Equal(sampleNotification, notification))
+	}
+	_, ok = DecodeNotification(protoNotification(t, &gnmi.Notification{Timestamp: 10}))
+	assert.True(t, ok)
+
+	_, ok = DecodeNotification([]byte(`{"cont1a": {}}`))
+	assert.False(t, ok)
+	_, ok = DecodeNotification([]byte("\n  {}"))
+	assert.False(t, ok)
+	_, ok = DecodeNotification([]byte{})
+	assert.False(t, ok)
+	// Neither JSON nor a notification, so left for the JSON decoder to report
+	_, ok = DecodeNotification([]byte("not json"))
+	assert.False(t, ok)
+	_, ok = DecodeNotification([]byte{0xff, 0xff})
+	assert.False(t, ok)
+	// A varint in field 15, which a notification does not define
+	_, ok = DecodeNotification([]byte{0x78, 0x01})
+	assert.False(t, ok)
+}
+
+func Test_UnmarshalNotification(t *testing.T) {
+	schema := protoSchema(t)
+	assert.NoError(t, UnmarshalNotification(sampleNotification, schema))
+	device := schema.Root.(*protoDevice)
+	if assert.NotNil(t, device.Cont1A) {
+		assert.Equal(t, "leaf1aval", *device.Cont1A.Leaf1A)
+		if assert.Contains(t, device.Cont1A.List2A, "l2a1") {
+			assert.Equal(t, uint16(5), *device.Cont1A.List2A["l2a1"].TxPower)
+		}
+	}
+
+	err := UnmarshalNotification(&gnmi.Notification{
+		Update: []*gnmi.Update{{
+			Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "cont1a"}, {Name: "leaf1a"}}},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}},
+		}},
+	}, protoSchema(t))
+	assert.ErrorContains(t, err, "unable to set /cont1a/leaf1a")
+
+	err = UnmarshalNotification(&gnmi.Notification{
+		Delete: []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "cont1a"}}}},
+	}, protoSchema(t))
+	assert.EqualError(t, err, "a config cannot delete paths")
+}
+
+func Test_NotificationToJSON(t *testing.T) {
+	config, err := NotificationToJSON(sampleNotification, protoSchema(t))
+	assert.NoError(t, err)
+
+	pathValues, err := GetPathValues("", config)
+	assert.NoError(t, err)
+	values := make(map[string]string)
+	for _, pathValue := range pathValues {
+		value := pathValue.GetValue()
+		values[pathValue.Path] = value.ValueToString()
+	}
+	assert.Equal(t, map[string]string{
+		"/t1:cont1a/leaf1a":                     "leaf1aval",
+		"/t1:cont1a/list2a[name=l2a1]/tx-power": "5",
+	}, values)
+}
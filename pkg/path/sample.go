@@ -0,0 +1,660 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxPatternRepeats bounds the repetitions tried when generating a string matching the patterns of a type
+const maxPatternRepeats = 64
+
+// SampleConfig builds a JSON config of the data tree of the schema entries, which passes the validation of the
+// model plugin. The minimal config holds the list keys, the mandatory nodes and the leaves with a default, along
+// with whatever their leafrefs point to; the full config holds every config node. Values satisfy the ranges,
+// lengths, patterns, enumerations and identities of their types. The optional nodes with must or when statements,
+// which cannot be evaluated without the generated Golang bindings, are left out unless a leafref points into them
+func SampleConfig(entries map[string]*yang.Entry, full bool) ([]byte, error) {
+	root := RootEntry(entries)
+	if root == nil {
+		return nil, fmt.Errorf("no root entry found in the schema")
+	}
+
+	s := &sampler{full: full, required: make(map[*yang.Entry]bool), values: make(map[*yang.Entry]interface{}),
+		dropped: make(map[*yang.Entry]bool)}
+	// Populating the targets of the leafrefs may add more leafrefs, until every target is in the config
+	for {
+		s.targets = make([]*yang.Entry, 0)
+		config, err := s.container(root)
+		if err != nil {
+			return nil, err
+		}
+		if !s.requireTargets() {
+			content, err := json.MarshalIndent(config, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(content, '\n'), nil
+		}
+	}
+}
+
+type sampler struct {
+	full bool
+	// required are the entries the targets of the leafrefs of the config are in
+	required map[*yang.Entry]bool
+	// values are the values of the leaves, so that every leafref gets the value of its target
+	values map[*yang.Entry]interface{}
+	// targets are the leaves the leafrefs of the config point to
+	targets []*yang.Entry
+	// dropped are the optional nodes of a full config left out for want of a valid value
+	dropped map[*yang.Entry]bool
+}
+
+// leftOut tells whether an entry is in a node left out of the config
+func (s *sampler) leftOut(entry *yang.Entry) bool {
+	for ; entry != nil; entry = entry.Parent {
+		if s.dropped[entry] {
+			return true
+		}
+	}
+	return false
+}
+
+// requireTargets marks the targets of the leafrefs and their ancestors as required, and tells whether any was not
+func (s *sampler) requireTargets() bool {
+	added := false
+	for _, target := range s.targets {
+		if s.leftOut(target) {
+			continue
+		}
+		for entry := target; entry != nil; entry = entry.Parent {
+			if !s.required[entry] {
+				s.required[entry] = true
+				added = true
+			}
+		}
+	}
+	return added
+}
+
+// container returns the JSON object of a container, a list entry or the root
+func (s *sampler) container(entry *yang.Entry) (map[string]interface{}, error) {
+	object := make(map[string]interface{})
+	keys := make(map[string]bool)
+	if entry.IsList() {
+		for _, key := range strings.Fields(entry.Key) {
+			keys[key] = true
+		}
+	}
+	if err := s.addChildren(object, entry, keys); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// addChildren adds the children of entry to object; the children of choices and cases are members of the object
+// itself, as in the JSON encoding of the data tree
+func (s *sampler) addChildren(object map[string]interface{}, entry *yang.Entry, keys map[string]bool) error {
+	for _, name := range SortedDirNames(entry) {
+		child := entry.Dir[name]
+		if child.Config == yang.TSFalse {
+			continue
+		}
+		if child.IsChoice() {
+			if err := s.addChoice(object, child); err != nil {
+				return err
+			}
+			continue
+		}
+		if !keys[name] && !s.included(child) {
+			continue
+		}
+		targets := len(s.targets)
+		value, err := s.node(child)
+		if err != nil && s.optional(child) && !keys[name] {
+			// Full configs leave out the optional nodes no valid value can be generated for, such as the
+			// identityrefs whose base has no derived identity in the model
+			s.targets = s.targets[:targets]
+			s.dropped[child] = true
+			continue
+		} else if err != nil {
+			return err
+		}
+		if value != nil {
+			object[name] = value
+		}
+	}
+	return nil
+}
+
+// included tells whether a node is part of the config, besides the list keys which always are
+func (s *sampler) included(entry *yang.Entry) bool {
+	if s.required[entry] {
+		return true
+	}
+	if hasConditions(entry) {
+		// The mandatory nodes are kept, hoping that their values satisfy the conditions
+		return IsMandatory(entry)
+	}
+	return s.full || minimal(entry)
+}
+
+// IsMandatory tells whether a leaf is mandatory or a list or leaf-list has a min-elements
+func IsMandatory(entry *yang.Entry) bool {
+	if entry.IsLeaf() {
+		return entry.Mandatory == yang.TSTrue
+	}
+	return (entry.IsList() || entry.IsLeafList()) && entry.ListAttr != nil && entry.ListAttr.MinElements > 0
+}
+
+// optional tells whether a node is only part of the config because it is a full one
+func (s *sampler) optional(entry *yang.Entry) bool {
+	return s.full && !s.required[entry] && !minimal(entry)
+}
+
+// minimal tells whether a node without must or when statements is part of a minimal config
+func minimal(entry *yang.Entry) bool {
+	switch {
+	case entry.IsList():
+		return IsMandatory(entry)
+	case entry.IsLeafList(), entry.IsLeaf():
+		return IsMandatory(entry) || len(DefaultValues(entry)) > 0
+	case IsPresence(entry):
+		return false
+	default:
+		// Non presence containers are left out when empty
+		return true
+	}
+}
+
+// addChoice adds the nodes of one case of a choice to object: the case a leafref points into, else the default
+// case, else the first one. Optional choices are left out of minimal configs unless they have a default case
+func (s *sampler) addChoice(object map[string]interface{}, choice *yang.Entry) error {
+	if !s.required[choice] && hasConditions(choice) {
+		return nil
+	}
+	selected := ""
+	for _, name := range SortedDirNames(choice) {
+		if s.required[choice.Dir[name]] {
+			selected = name
+			break
+		}
+	}
+	if selected == "" && len(choice.Default) > 0 {
+		if _, ok := choice.Dir[choice.Default[0]]; ok {
+			selected = choice.Default[0]
+		}
+	}
+	if selected == "" && (s.full || choice.Mandatory == yang.TSTrue) {
+		if names := SortedDirNames(choice); len(names) > 0 {
+			selected = names[0]
+		}
+	}
+	if selected == "" {
+		return nil
+	}
+
+	c := choice.Dir[selected]
+	if !c.IsCase() {
+		// A shorthand case, made of a single node
+		c = &yang.Entry{Name: c.Name, Kind: yang.CaseEntry, Parent: choice, Dir: map[string]*yang.Entry{c.Name: c}}
+	}
+	before := len(object)
+	if err := s.addChildren(object, c, nil); err != nil {
+		return err
+	}
+	if len(object) == before && choice.Mandatory == yang.TSTrue {
+		return fmt.Errorf("unable to populate mandatory choice %s", choice.Path())
+	}
+	return nil
+}
+
+// node returns the JSON value of a container, list, leaf or leaf-list; nil leaves it out of the config
+func (s *sampler) node(entry *yang.Entry) (interface{}, error) {
+	switch {
+	case entry.IsList():
+		item, err := s.container(entry)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{item}, nil
+	case entry.IsLeafList():
+		if defaults := DefaultValues(entry); len(defaults) > 0 && !s.required[entry] {
+			values := make([]interface{}, 0, len(defaults))
+			for _, value := range defaults {
+				values = append(values, JSONValue(entry.Type, value))
+			}
+			return values, nil
+		}
+		value, err := s.leafValue(entry)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{value}, nil
+	case entry.IsLeaf():
+		return s.leafValue(entry)
+	default:
+		object, err := s.container(entry)
+		if err != nil {
+			return nil, err
+		}
+		if len(object) == 0 && !IsPresence(entry) {
+			return nil, nil
+		}
+		return object, nil
+	}
+}
+
+// leafValue returns the value of a leaf, or the first value of a leaf-list: its default if any, else a value
+// of its type. The value of a leafref is the one of its target
+func (s *sampler) leafValue(entry *yang.Entry) (interface{}, error) {
+	target := s.leafRefTarget(entry)
+	if target != nil && s.leftOut(target) {
+		return nil, fmt.Errorf("%s refers to %s, which is left out of the config", entry.Path(), target.Path())
+	}
+	if value, ok := s.values[entry]; ok {
+		if target != nil {
+			s.targets = append(s.targets, target)
+		}
+		return value, nil
+	}
+	if target != nil {
+		value, err := s.leafValue(target)
+		if err != nil {
+			return nil, err
+		}
+		s.targets = append(s.targets, target)
+		s.values[entry] = value
+		return value, nil
+	}
+
+	var value interface{}
+	if defaults := DefaultValues(entry); len(defaults) > 0 {
+		value = JSONValue(entry.Type, defaults[0])
+	} else {
+		var ok bool
+		if value, ok = s.typeValue(entry, entry.Type); !ok {
+			return nil, fmt.Errorf("unable to generate a value of %s", entry.Path())
+		}
+	}
+	s.values[entry] = value
+	return value, nil
+}
+
+// leafRefTarget returns the leaf a leafref points to, or nil if entry is not a leafref
+func (s *sampler) leafRefTarget(entry *yang.Entry) *yang.Entry {
+	if entry.Type == nil || entry.Type.Kind != yang.Yleafref {
+		return nil
+	}
+	target := ResolveLeafRef(entry, entry.Type.Path)
+	if target == nil || target == entry || target.Type == nil {
+		return nil
+	}
+	return target
+}
+
+// typeValue returns a JSON value of the type, the value of the leafrefs of a union being the one of their target
+func (s *sampler) typeValue(entry *yang.Entry, yangType *yang.YangType) (interface{}, bool) {
+	if yangType == nil {
+		return nil, false
+	}
+	switch yangType.Kind {
+	case yang.Ystring:
+		return stringValue(entry.Name, yangType)
+	case yang.Ybool:
+		return false, true
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		n := rangeValue(yangType.Range, 0)
+		value, err := strconv.ParseInt(n.String(), 10, 64)
+		return value, err == nil
+	case yang.Yint64, yang.Yuint64:
+		// RFC 7951 encodes 64 bit integers as strings
+		return rangeValue(yangType.Range, 0).String(), true
+	case yang.Ydecimal64:
+		return rangeValue(yangType.Range, uint8(yangType.FractionDigits)).String(), true
+	case yang.Yenum:
+		if yangType.Enum == nil || len(yangType.Enum.Names()) == 0 {
+			return nil, false
+		}
+		values := yangType.Enum.ValueMap()
+		lowest := int64(0)
+		first := true
+		for value := range values {
+			if first || value < lowest {
+				lowest, first = value, false
+			}
+		}
+		return values[lowest], true
+	case yang.Yidentityref:
+		base := yangType.IdentityBase
+		if base == nil || len(base.Values) == 0 {
+			return nil, false
+		}
+		identities := make([]string, 0, len(base.Values))
+		for _, identity := range base.Values {
+			// The module of the identities is not part of the schema tree, and ygot accepts unprefixed names
+			identities = append(identities, identity.Name)
+		}
+		sort.Strings(identities)
+		return identities[0], true
+	case yang.Ybinary:
+		return base64.StdEncoding.EncodeToString([]byte(fitLength(entry.Name, yangType.Length))), true
+	case yang.Ybits:
+		if yangType.Bit == nil || len(yangType.Bit.Names()) == 0 {
+			return "", true
+		}
+		return yangType.Bit.Names()[0], true
+	case yang.Yempty:
+		return []interface{}{nil}, true
+	case yang.Yunion:
+		for _, member := range yangType.Type {
+			if value, ok := s.typeValue(entry, member); ok {
+				return value, true
+			}
+		}
+		return nil, false
+	case yang.Yleafref:
+		target := ResolveLeafRef(entry, yangType.Path)
+		if target == nil || target == entry {
+			return nil, false
+		}
+		value, err := s.leafValue(target)
+		if err != nil {
+			return nil, false
+		}
+		s.targets = append(s.targets, target)
+		return value, true
+	default:
+		return nil, false
+	}
+}
+
+// rangeValue returns the number of the ranges closest to zero, zero if there are none
+func rangeValue(ranges yang.YangRange, fractionDigits uint8) yang.Number {
+	zero := yang.Number{FractionDigits: fractionDigits}
+	if len(ranges) == 0 {
+		return zero
+	}
+	var closest *yang.Number
+	for _, r := range ranges {
+		candidate := zero
+		switch {
+		case zero.Less(r.Min):
+			candidate = r.Min
+		case r.Max.Less(zero):
+			candidate = r.Max
+		}
+		magnitude := candidate
+		magnitude.Negative = false
+		if closest == nil {
+			closest = &candidate
+			continue
+		}
+		current := *closest
+		current.Negative = false
+		if magnitude.Less(current) {
+			closest = &candidate
+		}
+	}
+	return *closest
+}
+
+// stringValue returns a string satisfying the lengths and patterns of the type: the name of the leaf if it does,
+// else one generated from the patterns
+func stringValue(name string, yangType *yang.YangType) (string, bool) {
+	patterns, isPOSIX := util.SanitizedPattern(yangType)
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		var r *regexp.Regexp
+		var err error
+		if isPOSIX {
+			r, err = regexp.CompilePOSIX(pattern)
+		} else {
+			r, err = regexp.Compile(pattern)
+		}
+		if err != nil {
+			return "", false
+		}
+		regexps = append(regexps, r)
+	}
+	valid := func(value string) bool {
+		if !LengthAllowed(yangType.Length, utf8.RuneCountInString(value)) {
+			return false
+		}
+		for _, r := range regexps {
+			if !r.MatchString(value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if value := fitLength(name, yangType.Length); valid(value) {
+		return value, true
+	}
+	for _, pattern := range patterns {
+		flags := syntax.Perl
+		if isPOSIX {
+			flags = syntax.POSIX
+		}
+		re, err := syntax.Parse(pattern, flags)
+		if err != nil {
+			continue
+		}
+		re = re.Simplify()
+		for repeats := 0; repeats <= maxPatternRepeats; repeats++ {
+			var sb strings.Builder
+			if !GenerateString(&sb, re, repeats, firstChoice) {
+				break
+			}
+			if value := sb.String(); valid(value) {
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// fitLength pads or truncates value to the first allowed length
+func fitLength(value string, length yang.YangRange) string {
+	if len(length) == 0 || LengthAllowed(length, utf8.RuneCountInString(value)) {
+		return value
+	}
+	target := int(length[0].Min.Value)
+	if utf8.RuneCountInString(value) > target && length[0].Max.Value < uint64(utf8.RuneCountInString(value)) {
+		target = int(length[0].Max.Value)
+		return string([]rune(value)[:target])
+	}
+	return value + strings.Repeat("x", target-utf8.RuneCountInString(value))
+}
+
+// LengthAllowed tells whether a string of n characters satisfies the length ranges of a type, if any
+func LengthAllowed(length yang.YangRange, n int) bool {
+	if len(length) == 0 {
+		return true
+	}
+	for _, r := range length {
+		if r.Min.Value <= uint64(n) && uint64(n) <= r.Max.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// preferredRunes are tried first when picking a character of a class, for readable values
+var preferredRunes = []rune{'a', 'b', 'c', '1', '0', 'A', '-', '_', '.'}
+
+// maxRepeats bounds the repetitions GenerateString picks for the repeatable parts without a maximum
+const maxRepeats = 8
+
+// GenerateString writes a string matching the regular expression, and returns false if the expression cannot match
+// anything. pick makes every choice, returning a number in [0, n) like the Intn method of a seeded rand.Rand: the
+// rune of a character class, among its preferred readable runes first, how many times a repeatable part is repeated
+// on top of its minimum and of extra, and the first alternative tried. Picking 0 gives the most readable string
+func GenerateString(sb *strings.Builder, re *syntax.Regexp, extra int, pick func(n int) int) bool {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return false
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		r, ok := classRune(re.Rune, pick)
+		if !ok {
+			return false
+		}
+		sb.WriteRune(r)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		r, _ := classRune([]rune{'!', '~'}, pick)
+		sb.WriteRune(r)
+	case syntax.OpCapture:
+		return GenerateString(sb, re.Sub[0], extra, pick)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		lowest, highest := 0, -1
+		switch re.Op {
+		case syntax.OpPlus:
+			lowest = 1
+		case syntax.OpQuest:
+			highest = 1
+		case syntax.OpRepeat:
+			lowest, highest = re.Min, re.Max
+		}
+		span := maxRepeats
+		if highest >= 0 {
+			span = highest - lowest
+		}
+		count := lowest + extra + pick(span+1)
+		if highest >= 0 && count > highest {
+			count = highest
+		}
+		for i := 0; i < count; i++ {
+			if !GenerateString(sb, re.Sub[0], extra, pick) {
+				return false
+			}
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !GenerateString(sb, sub, extra, pick) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		first := pick(len(re.Sub))
+		for i := range re.Sub {
+			var alternative strings.Builder
+			if GenerateString(&alternative, re.Sub[(first+i)%len(re.Sub)], extra, pick) {
+				sb.WriteString(alternative.String())
+				return true
+			}
+		}
+		return false
+	}
+	// Anchors, word boundaries and empty matches write nothing
+	return true
+}
+
+// classRune picks a rune of a character class, given as pairs of bounds, among its preferred runes then its other
+// printable ASCII runes. A class without any is represented by its first rune
+func classRune(ranges []rune, pick func(n int) int) (rune, bool) {
+	if len(ranges) == 0 {
+		return 0, false
+	}
+	inClass := func(r rune) bool {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if ranges[i] <= r && r <= ranges[i+1] {
+				return true
+			}
+		}
+		return false
+	}
+	candidates := make([]rune, 0)
+	for _, r := range preferredRunes {
+		if inClass(r) {
+			candidates = append(candidates, r)
+		}
+	}
+	for r := '!'; r <= '~'; r++ {
+		if inClass(r) && !strings.ContainsRune(string(preferredRunes), r) {
+			candidates = append(candidates, r)
+		}
+	}
+	if len(candidates) == 0 {
+		return ranges[0], true
+	}
+	return candidates[pick(len(candidates))], true
+}
+
+// firstChoice is the picker of GenerateString that gives the most readable strings
+func firstChoice(int) int {
+	return 0
+}
+
+// DefaultValues returns the default values of a leaf or leaf-list, including the ones of its type
+func DefaultValues(entry *yang.Entry) []string {
+	if len(entry.Default) > 0 {
+		return entry.Default
+	}
+	if entry.Type != nil && entry.Type.HasDefault && entry.Mandatory != yang.TSTrue &&
+		(entry.ListAttr == nil || entry.ListAttr.MinElements == 0) {
+		return []string{entry.Type.Default}
+	}
+	return nil
+}
+
+// JSONValue converts a value of a YANG type, such as a default, to its RFC 7951 JSON encoding: booleans and
+// integers of up to 32 bits are JSON booleans and numbers, empty is [null] and everything else, including 64-bit
+// integers and values that do not parse as their type, is a string
+func JSONValue(yangType *yang.YangType, value string) interface{} {
+	if yangType == nil {
+		return value
+	}
+	switch yangType.Kind {
+	case yang.Ybool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case yang.Yint8, yang.Yint16, yang.Yint32:
+		if i, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return i
+		}
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		if u, err := strconv.ParseUint(value, 10, 32); err == nil {
+			return u
+		}
+	case yang.Yempty:
+		return []interface{}{nil}
+	}
+	return value
+}
+
+// hasConditions tells whether a node has must or when statements
+func hasConditions(entry *yang.Entry) bool {
+	return len(entry.Extra["must"]) > 0 || len(entry.Extra["when"]) > 0
+}
+
+// IsPresence tells whether a node is a presence container
+func IsPresence(entry *yang.Entry) bool {
+	return entry.IsContainer() && len(entry.Extra["presence"]) > 0
+}
+
+// SortedDirNames returns the names of the children of a node, sorted
+func SortedDirNames(entry *yang.Entry) []string {
+	names := make([]string, 0, len(entry.Dir))
+	for name := range entry.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
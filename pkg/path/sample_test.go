@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package path
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"testing"
+)
+
+func sampleSchema(t *testing.T) map[string]*yang.Entry {
+	device := &yang.Entry{Name: "device", Kind: yang.DirectoryEntry, Annotation: map[string]interface{}{"isFakeRoot": true}}
+	addChild := func(parent *yang.Entry, child *yang.Entry) *yang.Entry {
+		if parent.Dir == nil {
+			parent.Dir = make(map[string]*yang.Entry)
+		}
+		child.Parent = parent
+		parent.Dir[child.Name] = child
+		return child
+	}
+	mode := yang.NewEnumType()
+	assert.NoError(t, mode.Set("fast", 2))
+	assert.NoError(t, mode.Set("slow", 1))
+
+	iface := addChild(device, &yang.Entry{Name: "interface", Kind: yang.DirectoryEntry, Key: "name", ListAttr: &yang.ListAttr{}})
+	addChild(iface, &yang.Entry{Name: "name", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Ystring, Pattern: []string{"eth[0-9]+"}}})
+	addChild(iface, &yang.Entry{Name: "mtu", Kind: yang.LeafEntry, Mandatory: yang.TSTrue, Type: &yang.YangType{Kind: yang.Yuint16,
+		Range: yang.YangRange{{Min: yang.FromInt(1500), Max: yang.FromInt(9000)}}}})
+	addChild(iface, &yang.Entry{Name: "enabled", Kind: yang.LeafEntry, Default: []string{"true"}, Type: &yang.YangType{Kind: yang.Ybool}})
+	addChild(iface, &yang.Entry{Name: "speed", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Yint64}})
+	addChild(iface, &yang.Entry{Name: "counter", Kind: yang.LeafEntry, Config: yang.TSFalse, Type: &yang.YangType{Kind: yang.Yuint32}})
+	addChild(device, &yang.Entry{Name: "uplink", Kind: yang.LeafEntry, Mandatory: yang.TSTrue, Type: &yang.YangType{Kind: yang.Yleafref,
+		Path: "/interface/name"}})
+	settings := addChild(device, &yang.Entry{Name: "settings", Kind: yang.DirectoryEntry})
+	addChild(settings, &yang.Entry{Name: "mode", Kind: yang.LeafEntry, Mandatory: yang.TSTrue, Type: &yang.YangType{Kind: yang.Yenum, Enum: mode}})
+	addChild(settings, &yang.Entry{Name: "ratio", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Ydecimal64, FractionDigits: 2,
+		Range: yang.YangRange{{Min: yang.Number{Value: 150, FractionDigits: 2}, Max: yang.Number{Value: 300, FractionDigits: 2}}}}})
+	addChild(settings, &yang.Entry{Name: "checked", Kind: yang.LeafEntry, Extra: map[string][]interface{}{"must": {"../ratio > 2"}},
+		Type: &yang.YangType{Kind: yang.Ystring}})
+	addChild(settings, &yang.Entry{Name: "tag", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Yidentityref,
+		IdentityBase: &yang.Identity{Name: "tag"}}})
+	return map[string]*yang.Entry{"Device": device}
+}
+
+func Test_SampleConfig(t *testing.T) {
+	// The leafref of uplink brings in an interface, with its key and mandatory leaves
+	config, err := SampleConfig(sampleSchema(t), false)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"interface": [{"name": "eth1", "mtu": 1500, "enabled": true}],
+		"settings": {"mode": "slow"},
+		"uplink": "eth1"
+	}`, string(config))
+
+	// The identityref without any identity and the leaf with a must statement are left out
+	config, err = SampleConfig(sampleSchema(t), true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"interface": [{"name": "eth1", "mtu": 1500, "enabled": true, "speed": "0"}],
+		"settings": {"mode": "slow", "ratio": "1.50"},
+		"uplink": "eth1"
+	}`, string(config))
+}
+
+func Test_SampleConfig_Testdevice(t *testing.T) {
+	schemaTree, err := ygot.GzipToSchema(testdevice10XSchema)
+	assert.NoError(t, err)
+	config, err := SampleConfig(schemaTree, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "{}\n", string(config))
+
+	// cont1a and its lists have must statements
+	config, err = SampleConfig(schemaTree, true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"leafAtTopLevel": "AAA-"}`, string(config))
+}
+
+func Test_stringValue(t *testing.T) {
+	for _, test := range []struct {
+		yangType *yang.YangType
+		expected string
+	}{
+		{&yang.YangType{Kind: yang.Ystring}, "leaf"},
+		{&yang.YangType{Kind: yang.Ystring, Length: yang.YangRange{{Min: yang.FromInt(6), Max: yang.FromInt(8)}}}, "leafxx"},
+		{&yang.YangType{Kind: yang.Ystring, Length: yang.YangRange{{Min: yang.FromInt(1), Max: yang.FromInt(2)}}}, "le"},
+		{&yang.YangType{Kind: yang.Ystring, Pattern: []string{"[A-Z]{3}-[0-9]+"}}, "AAA-1"},
+		{&yang.YangType{Kind: yang.Ystring, Pattern: []string{"(up|down)"}}, "up"},
+	} {
+		value, ok := stringValue("leaf", test.yangType)
+		if assert.True(t, ok, test.expected) {
+			assert.Equal(t, test.expected, value)
+		}
+	}
+}
+
+func Test_JSONValue(t *testing.T) {
+	assert.Equal(t, true, JSONValue(&yang.YangType{Kind: yang.Ybool}, "true"))
+	assert.Equal(t, int64(-5), JSONValue(&yang.YangType{Kind: yang.Yint16}, "-5"))
+	assert.Equal(t, uint64(80), JSONValue(&yang.YangType{Kind: yang.Yuint32}, "80"))
+	assert.Equal(t, []interface{}{nil}, JSONValue(&yang.YangType{Kind: yang.Yempty}, ""))
+	// RFC 7951 encodes 64-bit integers and decimals as strings, and so are values that are not of their type
+	assert.Equal(t, "10", JSONValue(&yang.YangType{Kind: yang.Yuint64}, "10"))
+	assert.Equal(t, "1.5", JSONValue(&yang.YangType{Kind: yang.Ydecimal64}, "1.5"))
+	assert.Equal(t, "max", JSONValue(&yang.YangType{Kind: yang.Yuint8}, "max"))
+	assert.Equal(t, "up", JSONValue(nil, "up"))
+}
+
+func Test_GenerateString(t *testing.T) {
+	for _, pattern := range []string{`[a-z]{2,5}-[0-9]+`, `(eth|ge)[0-9]/[0-9]{1,2}`, `.+@[A-Z]?x*`} {
+		re, err := syntax.Parse(pattern, syntax.Perl)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		matcher := regexp.MustCompile("^(" + pattern + ")$")
+		random := rand.New(rand.NewSource(1))
+		for i := 0; i < 32; i++ {
+			var sb strings.Builder
+			if assert.True(t, GenerateString(&sb, re.Simplify(), 0, random.Intn), pattern) {
+				assert.Regexp(t, matcher, sb.String(), pattern)
+			}
+		}
+		// Always picking the first choice gives the readable string
+		var sb strings.Builder
+		assert.True(t, GenerateString(&sb, re.Simplify(), 0, firstChoice), pattern)
+		assert.Regexp(t, matcher, sb.String(), pattern)
+	}
+	var sb strings.Builder
+	re, _ := syntax.Parse(`[a-c]{2}[0-9]`, syntax.Perl)
+	assert.True(t, GenerateString(&sb, re.Simplify(), 0, firstChoice))
+	assert.Equal(t, "aa1", sb.String())
+}
@@ -0,0 +1,540 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validate checks JSON configs against the schema and the must statements of a model, and reports every
+// violation it finds rather than the first one. The generated ValidateConfig of every model calls it.
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ViolationKind is the kind of constraint of the model a config violates
+type ViolationKind string
+
+const (
+	// TypeViolation is a value which is not of the type of its leaf, or a node the model does not have
+	TypeViolation ViolationKind = "type"
+	// RangeViolation is a number outside the range of its type
+	RangeViolation ViolationKind = "range"
+	// LengthViolation is a string or a binary value outside the length of its type
+	LengthViolation ViolationKind = "length"
+	// PatternViolation is a string which does not match the patterns of its type
+	PatternViolation ViolationKind = "pattern"
+	// EnumViolation is a value which is none of the enums, identities or bits of its type
+	EnumViolation ViolationKind = "enum"
+	// UniqueViolation is a list entry with the keys of another one, or a value repeated in a leaf-list
+	UniqueViolation ViolationKind = "unique"
+	// LeafRefViolation is a leafref whose value no instance of its target has
+	LeafRefViolation ViolationKind = "leafref"
+	// MustViolation is a must statement which does not hold
+	MustViolation ViolationKind = "must"
+	// MandatoryViolation is a missing mandatory leaf or list key, or a list or leaf-list with fewer entries than
+	// its min-elements
+	MandatoryViolation ViolationKind = "mandatory"
+)
+
+// Violation is a constraint of the model which a node of a config violates
+type Violation struct {
+	// Path is the instance path of the node, with the keys of the list entries it is in
+	Path string
+	Kind ViolationKind
+	// Message details the violation
+	Message string
+	// ErrorMessage and ErrorAppTag are the error-message and the error-app-tag of the violated must statement
+	ErrorMessage string
+	ErrorAppTag  string
+	// Value is the offending value, if the node is a leaf or a leaf-list value
+	Value string
+}
+
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s: %s violation: %s", v.Path, v.Kind, v.Message)
+}
+
+// Violations are the violations of a config, sorted by path
+type Violations []*Violation
+
+// Error lists the violations, one per line
+func (v Violations) Error() string {
+	lines := make([]string, 0, len(v))
+	for _, violation := range v {
+		lines = append(lines, violation.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Model is the generated Golang bindings of a model the configs are validated against
+type Model struct {
+	// Schema returns a new schema of the model. The evaluation of the must statements alters the schema tree,
+	// so every validation gets a new one
+	Schema func() (*ytypes.Schema, error)
+	// Unmarshal unmarshals a JSON config into a struct of the model
+	Unmarshal func(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error
+	// NewRoot returns an empty fake root of the model
+	NewRoot func() ygot.ValidatedGoStruct
+}
+
+// The annotations navigator.NewYangNodeNavigator sets on the schema entries of the nodes of a config
+const (
+	mustAnnotation     = "must"
+	goStructAnnotation = "gostruct"
+)
+
+// Config checks a JSON config against the schema and the must statements of a model, and returns every violation
+// it finds rather than the first one. Nodes of the wrong type are left out of the checks of the leafrefs and the
+// must statements. The error is for configs which are not JSON objects.
+func Config(model *Model, config []byte) (Violations, error) {
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.UseNumber()
+	object := make(map[string]interface{})
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	schema, err := model.Schema()
+	if err != nil {
+		return nil, err
+	}
+
+	// Leaves are only checked one by one when the config as a whole does not pass the schema validation
+	device := model.NewRoot()
+	checkLeaves := model.Unmarshal(config, device) != nil || device.Validate() != nil
+	v := &validator{model: model, values: make(map[*yang.Entry]map[string]bool), checkLeaves: checkLeaves}
+	v.walk(schema.RootSchema(), object, "", func(inner map[string]interface{}) map[string]interface{} {
+		return inner
+	})
+	if checkLeaves {
+		v.checkLeafRefs()
+		// What is left of the config once the nodes of the wrong type are removed
+		content, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		device = model.NewRoot()
+		if err := model.Unmarshal(content, device); err != nil {
+			v.add(&Violation{Path: "/", Kind: TypeViolation, Message: err.Error()})
+			return v.sorted(), nil
+		}
+	}
+	if err := v.checkMust(device); err != nil {
+		return nil, err
+	}
+	return v.sorted(), nil
+}
+
+type validator struct {
+	model      *Model
+	violations Violations
+	// leafRefs are the leafref values of the config, which are checked once the values of their targets are known
+	leafRefs []*leafRefValue
+	// values are the values of each leaf and leaf-list of the config
+	values      map[*yang.Entry]map[string]bool
+	checkLeaves bool
+}
+
+type leafRefValue struct {
+	entry *yang.Entry
+	path  string
+	value string
+}
+
+// wrapper nests a node of a config in its ancestors, along with the keys of the list entries among them, so that it
+// can be unmarshalled and validated on its own
+type wrapper func(inner map[string]interface{}) map[string]interface{}
+
+func (v *validator) add(violation *Violation) {
+	v.violations = append(v.violations, violation)
+}
+
+func (v *validator) sorted() Violations {
+	sort.SliceStable(v.violations, func(i, j int) bool {
+		return v.violations[i].Path < v.violations[j].Path
+	})
+	return v.violations
+}
+
+// walk checks the children of a container or a list entry of the config, and removes those of the wrong type
+func (v *validator) walk(entry *yang.Entry, object map[string]interface{}, nodePath string, wrap wrapper) {
+	children := path.DataChildren(entry)
+	names := jsonNames(object)
+	for _, name := range sortedNames(names) {
+		jsonName := names[name]
+		child, ok := children[name]
+		childPath := nodePath + "/" + name
+		if !ok {
+			v.add(&Violation{Path: childPath, Kind: TypeViolation, Message: "the model has no such node"})
+			delete(object, jsonName)
+			continue
+		}
+		value := object[jsonName]
+		switch {
+		case child.IsLeaf():
+			if !v.checkLeaf(child, childPath, value, wrap(map[string]interface{}{jsonName: value})) {
+				delete(object, jsonName)
+			}
+		case child.IsLeafList():
+			items, ok := value.([]interface{})
+			if !ok {
+				v.add(&Violation{Path: childPath, Kind: TypeViolation, Message: "a leaf-list is expected", Value: valueString(value)})
+				delete(object, jsonName)
+				continue
+			}
+			kept := make([]interface{}, 0, len(items))
+			seen := make(map[string]bool, len(items))
+			for _, item := range items {
+				if seen[valueString(item)] {
+					v.add(&Violation{Path: childPath, Kind: UniqueViolation, Message: "the value is repeated", Value: valueString(item)})
+					continue
+				}
+				if v.checkLeaf(child, childPath, item, wrap(map[string]interface{}{jsonName: []interface{}{item}})) {
+					seen[valueString(item)] = true
+					kept = append(kept, item)
+				}
+			}
+			object[jsonName] = kept
+		case child.IsList():
+			items, ok := value.([]interface{})
+			if !ok {
+				v.add(&Violation{Path: childPath, Kind: TypeViolation, Message: "a list is expected"})
+				delete(object, jsonName)
+				continue
+			}
+			kept := make([]interface{}, 0, len(items))
+			seen := make(map[string]bool, len(items))
+			for _, item := range items {
+				if itemObject, ok := item.(map[string]interface{}); ok && v.walkListEntry(child, itemObject, childPath, jsonName, wrap, seen) {
+					kept = append(kept, itemObject)
+				} else if !ok {
+					v.add(&Violation{Path: childPath, Kind: TypeViolation, Message: "a list entry is expected"})
+				}
+			}
+			object[jsonName] = kept
+		default:
+			childObject, ok := value.(map[string]interface{})
+			if !ok {
+				v.add(&Violation{Path: childPath, Kind: TypeViolation, Message: "a container is expected"})
+				delete(object, jsonName)
+				continue
+			}
+			v.walk(child, childObject, childPath, func(inner map[string]interface{}) map[string]interface{} {
+				return wrap(map[string]interface{}{jsonName: inner})
+			})
+		}
+	}
+	v.checkMandatory(entry, names, object, nodePath)
+}
+
+// walkListEntry checks the keys then the other children of a list entry; it tells whether the entry is kept. seen
+// holds the keys of the entries of the list kept so far
+func (v *validator) walkListEntry(entry *yang.Entry, object map[string]interface{}, nodePath string, jsonName string,
+	wrap wrapper, seen map[string]bool) bool {
+	names := jsonNames(object)
+	keys := make(map[string]interface{})
+	predicates := ""
+	for _, key := range strings.Fields(entry.Key) {
+		value, ok := object[names[key]]
+		if !ok {
+			v.add(&Violation{Path: nodePath + predicates + "/" + key, Kind: MandatoryViolation, Message: "the list key is missing"})
+			return false
+		}
+		keys[names[key]] = value
+		predicates += fmt.Sprintf("[%s=%s]", key, valueString(value))
+	}
+	nodePath += predicates
+	if seen[predicates] {
+		v.add(&Violation{Path: nodePath, Kind: UniqueViolation, Message: "another entry of the list has the same keys"})
+		return false
+	}
+	wrapEntry := func(inner map[string]interface{}) map[string]interface{} {
+		item := make(map[string]interface{}, len(keys)+len(inner))
+		for name, value := range keys {
+			item[name] = value
+		}
+		for name, value := range inner {
+			item[name] = value
+		}
+		return wrap(map[string]interface{}{jsonName: []interface{}{item}})
+	}
+	if v.checkLeaves {
+		if err := v.check(wrapEntry(nil)); err != nil {
+			v.add(&Violation{Path: nodePath, Kind: keysKind(entry, names, keys), Message: err.Error()})
+			return false
+		}
+	}
+	seen[predicates] = true
+	v.walk(entry, object, nodePath, wrapEntry)
+	return true
+}
+
+// checkLeaf checks a leaf or a leaf-list value along with its ancestors; it tells whether the value is kept
+func (v *validator) checkLeaf(entry *yang.Entry, nodePath string, value interface{}, doc map[string]interface{}) bool {
+	if v.checkLeaves {
+		if err := v.check(doc); err != nil {
+			v.add(&Violation{Path: nodePath, Kind: leafKind(entry, entry.Type, value), Message: err.Error(), Value: valueString(value)})
+			return false
+		}
+	}
+	if v.values[entry] == nil {
+		v.values[entry] = make(map[string]bool)
+	}
+	v.values[entry][valueString(value)] = true
+	if entry.Type != nil && entry.Type.Kind == yang.Yleafref && !entry.Type.OptionalInstance {
+		v.leafRefs = append(v.leafRefs, &leafRefValue{entry: entry, path: nodePath, value: valueString(value)})
+	}
+	return true
+}
+
+// check unmarshals and validates a config, whose leafrefs may lack their targets
+func (v *validator) check(doc map[string]interface{}) error {
+	content, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	device := v.model.NewRoot()
+	if err := v.model.Unmarshal(content, device); err != nil {
+		return err
+	}
+	return device.Validate(&ytypes.LeafrefOptions{IgnoreMissingData: true})
+}
+
+// keysKind returns the kind of violation of the keys of a list entry which fails the schema validation. With
+// several keys, the one at fault is not known, so they only get a kind other than type if they all agree
+func keysKind(entry *yang.Entry, names map[string]string, keys map[string]interface{}) ViolationKind {
+	kind := ViolationKind("")
+	for _, key := range strings.Fields(entry.Key) {
+		keyEntry := entry.Dir[key]
+		if keyEntry == nil {
+			return TypeViolation
+		}
+		keyKind := leafKind(keyEntry, keyEntry.Type, keys[names[key]])
+		if kind != "" && keyKind != kind {
+			return TypeViolation
+		}
+		kind = keyKind
+	}
+	if kind == "" {
+		return TypeViolation
+	}
+	return kind
+}
+
+// leafKind returns the kind of violation of a leaf or leaf-list value which fails the schema validation, from the
+// type of the leaf: values of the right JSON type break the range of numbers, the length or the patterns of
+// strings, and the enums of enumerations, identityrefs and bits. A union only gets a kind other than type if its
+// member types all agree
+func leafKind(entry *yang.Entry, yangType *yang.YangType, value interface{}) ViolationKind {
+	if yangType == nil {
+		return TypeViolation
+	}
+	switch yangType.Kind {
+	case yang.Yleafref:
+		if target := path.ResolveLeafRef(entry, yangType.Path); target != nil && target != entry {
+			return leafKind(target, target.Type, value)
+		}
+	case yang.Yunion:
+		kind := ViolationKind("")
+		for _, member := range yangType.Type {
+			memberKind := leafKind(entry, member, value)
+			if kind != "" && memberKind != kind {
+				return TypeViolation
+			}
+			kind = memberKind
+		}
+		if kind != "" {
+			return kind
+		}
+	case yang.Ystring:
+		if s, ok := value.(string); ok {
+			if !path.LengthAllowed(yangType.Length, utf8.RuneCountInString(s)) {
+				return LengthViolation
+			}
+			if len(yangType.Pattern) > 0 || len(yangType.POSIXPattern) > 0 {
+				return PatternViolation
+			}
+		}
+	case yang.Ybinary:
+		if s, ok := value.(string); ok {
+			if decoded, err := base64.StdEncoding.DecodeString(s); err == nil && !path.LengthAllowed(yangType.Length, len(decoded)) {
+				return LengthViolation
+			}
+		}
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Ydecimal64:
+		if number, ok := value.(json.Number); ok && isNumber(string(number)) {
+			return RangeViolation
+		}
+	case yang.Yint64, yang.Yuint64:
+		// The 64-bit integers are strings, as in RFC 7951
+		if s, ok := value.(string); ok && isNumber(s) {
+			return RangeViolation
+		}
+	case yang.Yenum, yang.Yidentityref, yang.Ybits:
+		if _, ok := value.(string); ok {
+			return EnumViolation
+		}
+	}
+	return TypeViolation
+}
+
+func isNumber(s string) bool {
+	_, ok := new(big.Float).SetString(s)
+	return ok
+}
+
+// checkMandatory checks that the mandatory leaves of a container or a list entry of the config are present, and
+// that its lists and leaf-lists have their min-elements. Nodes with a when statement, which is not evaluated, and
+// the containers the config lacks are not checked, nor are the identityrefs with no identity to take, which no config
+// could satisfy.
+func (v *validator) checkMandatory(entry *yang.Entry, names map[string]string, object map[string]interface{}, nodePath string) {
+	for _, name := range path.SortedDirNames(entry) {
+		child := entry.Dir[name]
+		if child.IsChoice() || child.IsCase() || child.ReadOnly() || len(child.Extra["when"]) > 0 {
+			continue
+		}
+		jsonName, present := names[name]
+		switch {
+		case child.IsLeaf():
+			if !present && child.Mandatory == yang.TSTrue && !hasNoIdentity(child.Type) {
+				v.add(&Violation{Path: nodePath + "/" + name, Kind: MandatoryViolation, Message: "the mandatory leaf is missing"})
+			}
+		case child.IsList() || child.IsLeafList():
+			items, _ := object[jsonName].([]interface{})
+			if child.ListAttr != nil && uint64(len(items)) < child.ListAttr.MinElements {
+				v.add(&Violation{Path: nodePath + "/" + name, Kind: MandatoryViolation,
+					Message: fmt.Sprintf("%d entries, fewer than min-elements %d", len(items), child.ListAttr.MinElements)})
+			}
+		}
+	}
+}
+
+func hasNoIdentity(yangType *yang.YangType) bool {
+	return yangType != nil && yangType.Kind == yang.Yidentityref &&
+		(yangType.IdentityBase == nil || len(yangType.IdentityBase.Values) == 0)
+}
+
+// checkLeafRefs checks that the value of every leafref of the config is a value of its target. Since the predicates
+// of the paths of the leafrefs are not evaluated, any instance of the target will do.
+func (v *validator) checkLeafRefs() {
+	for _, leafRef := range v.leafRefs {
+		target := path.ResolveLeafRef(leafRef.entry, leafRef.entry.Type.Path)
+		if target == nil || v.values[target][leafRef.value] {
+			continue
+		}
+		v.add(&Violation{Path: leafRef.path, Kind: LeafRefViolation, Value: leafRef.value,
+			Message: fmt.Sprintf("no %s has the value %s", leafRef.entry.Type.Path, leafRef.value)})
+	}
+}
+
+// checkMust evaluates the must statements of the nodes of a config in a single walk, and reports one violation per
+// node whose must statement does not hold
+func (v *validator) checkMust(device ygot.ValidatedGoStruct) error {
+	schema, err := v.model.Schema()
+	if err != nil {
+		return err
+	}
+	nn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
+	ynn, ok := nn.(*navigator.YangNodeNavigator)
+	if !ok {
+		return fmt.Errorf("cannot cast NodeNavigator to YangNodeNavigator")
+	}
+
+	ynn.MoveToRoot()
+	ynn.WalkMust(func(node *yang.Entry, err error) bool {
+		violation := &Violation{Path: instancePath(node), Kind: MustViolation, Message: err.Error()}
+		if must, ok := node.Annotation[mustAnnotation].(*yang.Must); ok {
+			if must.ErrorMessage != nil {
+				violation.ErrorMessage = must.ErrorMessage.Name
+			}
+			if must.ErrorAppTag != nil {
+				violation.ErrorAppTag = must.ErrorAppTag.Name
+			}
+		}
+		if node.IsLeaf() || node.IsLeafList() {
+			violation.Value = goStructValue(node)
+		}
+		v.add(violation)
+		return true
+	})
+	return nil
+}
+
+// instancePath returns the path of the node of an entry annotated by the navigator
+func instancePath(entry *yang.Entry) string {
+	nodePath := ""
+	for ; entry != nil && entry.Parent != nil; entry = entry.Parent {
+		if entry.IsChoice() || entry.IsCase() {
+			continue
+		}
+		predicates := ""
+		if entry.IsList() {
+			for _, key := range strings.Fields(entry.Key) {
+				if keyEntry, ok := entry.Dir[key]; ok {
+					predicates += fmt.Sprintf("[%s=%s]", key, goStructValue(keyEntry))
+				}
+			}
+		}
+		nodePath = "/" + entry.Name + predicates + nodePath
+	}
+	return nodePath
+}
+
+func goStructValue(entry *yang.Entry) string {
+	value := reflect.ValueOf(entry.Annotation[goStructAnnotation])
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// jsonNames maps the names of the members of a JSON object, without their module prefix, to the members
+func jsonNames(object map[string]interface{}) map[string]string {
+	names := make(map[string]string, len(object))
+	for jsonName := range object {
+		name := jsonName
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[i+1:]
+		}
+		names[name] = jsonName
+	}
+	return names
+}
+
+func sortedNames(names map[string]string) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// valueString returns a value of a JSON config as a string, strings and numbers unquoted
+func valueString(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"github.com/onosproject/config-models/pkg/internal/testdevice"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var model = &Model{
+	Schema:    testdevice.Schema,
+	Unmarshal: testdevice.Unmarshal,
+	NewRoot: func() ygot.ValidatedGoStruct {
+		return &testdevice.Device{}
+	},
+}
+
+func TestConfig_Valid(t *testing.T) {
+	violations, err := Config(model, []byte(`{"cont1a": {"leaf1a": "leaf1a", "cont2a": {"leaf2a": 2, "leaf2b": 0.5, "leaf2e": [1, 2]},
+		"list2a": [{"name": "first", "tx-power": 5, "range-min": 1, "range-max": 2}]}}`))
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestConfig_NotAnObject(t *testing.T) {
+	_, err := Config(model, []byte(`[]`))
+	assert.Error(t, err)
+}
+
+func TestConfig_Kinds(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		path   string
+		kind   ViolationKind
+	}{
+		{name: "range", config: `{"cont1a": {"cont2a": {"leaf2a": 5, "leaf2b": 0.5}}}`,
+			path: "/cont1a/cont2a/leaf2a", kind: RangeViolation},
+		{name: "leaf-list range", config: `{"cont1a": {"cont2a": {"leaf2b": 0.5, "leaf2e": [1, 300]}}}`,
+			path: "/cont1a/cont2a/leaf2e", kind: RangeViolation},
+		{name: "not a number", config: `{"cont1a": {"cont2a": {"leaf2a": "two", "leaf2b": 0.5}}}`,
+			path: "/cont1a/cont2a/leaf2a", kind: TypeViolation},
+		{name: "length", config: `{"cont1a": {"leaf1a": "abc"}}`,
+			path: "/cont1a/leaf1a", kind: LengthViolation},
+		{name: "key length", config: `{"cont1a": {"list2a": [{"name": "ab", "range-min": 1, "range-max": 2}]}}`,
+			path: "/cont1a/list2a[name=ab]", kind: LengthViolation},
+		{name: "pattern", config: `{"leaf-at-top-level": "bad"}`,
+			path: "/leaf-at-top-level", kind: PatternViolation},
+		{name: "enum", config: `{"vehicle": [{"id": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", "engine-position": "sideways"}]}`,
+			path: "/vehicle[id=aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa]/engine-position", kind: EnumViolation},
+		{name: "unique keys", config: `{"cont1a": {"list2a": [{"name": "first", "range-min": 1, "range-max": 2}, {"name": "first", "range-min": 2, "range-max": 2}]}}`,
+			path: "/cont1a/list2a[name=first]", kind: UniqueViolation},
+		{name: "unique values", config: `{"cont1a": {"cont2a": {"leaf2b": 0.5, "leaf2e": [1, 1]}}}`,
+			path: "/cont1a/cont2a/leaf2e", kind: UniqueViolation},
+		{name: "unknown node", config: `{"cont1a": {"leaf1x": 1}}`,
+			path: "/cont1a/leaf1x", kind: TypeViolation},
+		{name: "mandatory", config: `{"cont1a": {"cont2a": {"leaf2a": 2}}}`,
+			path: "/cont1a/cont2a/leaf2b", kind: MandatoryViolation},
+		{name: "leafref", config: `{"cont1a": {"list2a": [{"name": "first", "range-min": 1, "range-max": 2, "ref2d": 1}]}}`,
+			path: "/cont1a/list2a[name=first]/ref2d", kind: LeafRefViolation},
+		{name: "must", config: `{"cont1a": {"list2a": [{"name": "first", "range-min": 2, "range-max": 1}]}}`,
+			path: "/cont1a/list2a[name=first]", kind: MustViolation},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			violations, err := Config(model, []byte(test.config))
+			if !assert.NoError(t, err) || !assert.Len(t, violations, 1, violations.Error()) {
+				return
+			}
+			assert.Equal(t, test.path, violations[0].Path)
+			assert.Equal(t, test.kind, violations[0].Kind, violations[0].Message)
+		})
+	}
+}
+
+func TestConfig_Multiple(t *testing.T) {
+	violations, err := Config(model, []byte(`{"cont1a": {"leaf1a": "abc", "cont2a": {"leaf2a": 5}}, "leaf-at-top-level": "bad"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "/cont1a/cont2a/leaf2a: range violation: /device/cont1a: /device/cont1a/cont2a: /device/cont1a/cont2a/leaf2a: "+
+		"schema \"leaf2a\": unsigned integer value 5 is outside specified ranges\n"+
+		"/cont1a/cont2a/leaf2b: mandatory violation: the mandatory leaf is missing\n"+
+		"/cont1a/leaf1a: length violation: /device/cont1a: /device/cont1a/leaf1a: schema \"leaf1a\": length 3 is outside range 5..10\n"+
+		"/leaf-at-top-level: pattern violation: /device/leaf-at-top-level: schema \"leaf-at-top-level\": "+
+		"\"bad\" does not match regular expression pattern \"^([A-Z]{3}-[0-9]*)$\"", violations.Error())
+}
+
+func TestConfig_MustPerInstance(t *testing.T) {
+	violations, err := Config(model, []byte(`{"cont1a": {"list2a": [{"name": "first", "range-min": 2, "range-max": 1},
+		{"name": "second", "range-min": 1, "range-max": 2}, {"name": "third", "range-min": 3, "range-max": 1}]}}`))
+	assert.NoError(t, err)
+	if assert.Len(t, violations, 2, violations.Error()) {
+		assert.Equal(t, "/cont1a/list2a[name=first]", violations[0].Path)
+		assert.Equal(t, "/cont1a/list2a[name=third]", violations[1].Path)
+		for _, violation := range violations {
+			assert.Equal(t, MustViolation, violation.Kind)
+			assert.Contains(t, violation.Message, "range-min")
+		}
+	}
+}
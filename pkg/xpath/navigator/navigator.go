@@ -63,6 +63,12 @@ func NewYangNodeNavigator(root *yang.Entry, device ygot.ValidatedGoStruct, ignor
 // Tree and matches up the GoStruct
 // Also extracts the "must" statements in to XPath queries
 func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]*yang.Entry {
+	return addGoStructFieldsToYangEntry(dir, yangStruct, "")
+}
+
+// addGoStructFieldsToYangEntry - matches up the fields of the GoStruct whose path
+// starts with prefix, which is only set for the containers removed by path compression
+func addGoStructFieldsToYangEntry(dir *yang.Entry, yangStruct interface{}, prefix string) map[string]*yang.Entry {
 	resultMap := make(map[string]*yang.Entry)
 
 	if dir.Annotation == nil {
@@ -122,7 +128,7 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 		structVal := reflect.ValueOf(yangStruct)
 		switch structVal.Kind() {
 		case reflect.Ptr:
-			for childKey, childValue := range processStruct(structVal, k, v) {
+			for childKey, childValue := range processStructPath(structVal, prefix, k, v) {
 				childMap[childKey] = childValue
 			}
 		default:
@@ -145,17 +151,36 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 
 // processStruct - part of the recursive function addGoStructToYangEntry
 func processStruct(structVal reflect.Value, dirName string, dirValue *yang.Entry) map[string]*yang.Entry {
+	return processStructPath(structVal, "", dirName, dirValue)
+}
+
+// processStructPath - matches up dirName, under the prefix of the compressed
+// containers it is in, with a field of the struct.
+// With compressed paths the config and state containers, and the containers
+// of lists, have no struct of their own: the parent struct holds their fields
+// with a relative path such as "config/name", and may list several paths
+// separated by "|". Such containers are matched up with the parent struct.
+func processStructPath(structVal reflect.Value, prefix string, dirName string, dirValue *yang.Entry) map[string]*yang.Entry {
+	schemaPath := prefix + dirName
+	compressed := false
 	for i := 0; i < structVal.Elem().Type().NumField(); i++ {
-		fieldPathName := structVal.Elem().Type().Field(i).Tag.Get("path")
-		if fieldPathName != dirName {
-			continue
-		}
-		fieldName := structVal.Elem().Type().Field(i).Name
-		val := structVal.Elem().FieldByName(fieldName)
-		if !val.IsZero() {
-			return addGoStructToYangEntry(dirValue, val.Interface()) //Recursive
+		for _, fieldPathName := range strings.Split(structVal.Elem().Type().Field(i).Tag.Get("path"), "|") {
+			if strings.HasPrefix(fieldPathName, schemaPath+"/") {
+				compressed = true
+			}
+			if fieldPathName != schemaPath {
+				continue
+			}
+			fieldName := structVal.Elem().Type().Field(i).Name
+			val := structVal.Elem().FieldByName(fieldName)
+			if !val.IsZero() {
+				return addGoStructToYangEntry(dirValue, val.Interface()) //Recursive
+			}
+			return nil
 		}
-		return nil
+	}
+	if compressed {
+		return addGoStructFieldsToYangEntry(dirValue, structVal.Interface(), schemaPath+"/") //Recursive
 	}
 	return nil
 }
@@ -253,8 +278,20 @@ func deepCopyDir(dir *yang.Entry) *yang.Entry {
 }
 
 // WalkAndValidateMust - walk through the YNN and validate any Must statements
-// This goes down first and then across
+// returning the error of the first one which does not hold
 func (x *YangNodeNavigator) WalkAndValidateMust() error {
+	var mustErr error
+	x.WalkMust(func(node *yang.Entry, err error) bool {
+		mustErr = err
+		return false
+	})
+	return mustErr
+}
+
+// WalkMust - walk through the YNN and validate every Must statement in a
+// single pass, calling failed with the node and the error of each one which
+// does not hold or cannot be evaluated; the walk stops when failed returns false
+func (x *YangNodeNavigator) WalkMust(failed func(node *yang.Entry, err error) bool) {
 	for {
 		if x.MoveToChild() ||
 			x.MoveToNext() ||
@@ -268,33 +305,44 @@ func (x *YangNodeNavigator) WalkAndValidateMust() error {
 			if ok {
 				mustStruct, okMustStruct := mustIf.(*yang.Must)
 				if okMustStruct {
-					mustExpr, err := xpath.Compile(mustStruct.Name)
-					if err != nil {
-						return err
+					if err := x.validateMust(mustStruct); err != nil && !failed(x.curr, err) {
+						return
 					}
-					x1 := x.Copy().(*YangNodeNavigator)
-					result := mustExpr.Evaluate(x1)
-					resultBool, resultOk := result.(bool)
-					if !resultOk {
-						return fmt.Errorf("result of %s cannot be evaluated as bool %v",
-							mustExpr.String(), result)
-					}
-					if !resultBool {
-						items := x1.generateMustError("@*")
-						if len(items) == 0 {
-							items = x1.generateMustError("*")
-						}
-						return fmt.Errorf("%s. Must statement '%v' to true. Container(s): %v",
-							mustStruct.ErrorMessage.Name,
-							mustStruct.Name, items)
-					}
-					log.Infof("Checking Must rule %s: %v", mustExpr.String(), resultBool)
 				}
 			}
 			continue
 		}
-		return nil
+		return
+	}
+}
+
+// validateMust - evaluate a Must statement of the current node
+func (x *YangNodeNavigator) validateMust(mustStruct *yang.Must) error {
+	mustExpr, err := xpath.Compile(mustStruct.Name)
+	if err != nil {
+		return err
+	}
+	x1 := x.Copy().(*YangNodeNavigator)
+	result := mustExpr.Evaluate(x1)
+	resultBool, resultOk := result.(bool)
+	if !resultOk {
+		return fmt.Errorf("result of %s cannot be evaluated as bool %v",
+			mustExpr.String(), result)
+	}
+	if !resultBool {
+		items := x1.generateMustError("@*")
+		if len(items) == 0 {
+			items = x1.generateMustError("*")
+		}
+		errorMessage := ""
+		if mustStruct.ErrorMessage != nil {
+			errorMessage = mustStruct.ErrorMessage.Name
+		}
+		return fmt.Errorf("%s. Must statement '%v' to true. Container(s): %v",
+			errorMessage, mustStruct.Name, items)
 	}
+	log.Infof("Checking Must rule %s: %v", mustExpr.String(), resultBool)
+	return nil
 }
 
 func (x *YangNodeNavigator) generateMustError(expr string) []string {
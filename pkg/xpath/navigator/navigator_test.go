@@ -587,3 +587,93 @@ func TestYangNodeNavigator_NavigateTo(t *testing.T) {
 		}
 	}
 }
+
+type compressedDevice struct {
+	System    *compressedSystem               `path:"system"`
+	Interface map[string]*compressedInterface `path:"interfaces/interface"`
+}
+
+func (td *compressedDevice) IsYANGGoStruct() {
+}
+
+func (td *compressedDevice) Validate(...ygot.ValidationOption) error {
+	return nil
+}
+
+func (td *compressedDevice) ΛEnumTypeMap() map[string][]reflect.Type {
+	return nil
+}
+
+func (td *compressedDevice) ΛBelongingModule() string {
+	return ""
+}
+
+type compressedSystem struct {
+	Hostname *string `path:"config/hostname" shadow-path:"state/hostname"`
+}
+
+type compressedInterface struct {
+	Name *string `path:"config/name|name" shadow-path:"state/name|name"`
+	Mtu  *uint16 `path:"config/mtu" shadow-path:"state/mtu"`
+}
+
+func Test_compressedPaths(t *testing.T) {
+	hostname := "router1"
+	name := "eth0"
+	mtu := uint16(1500)
+	td := compressedDevice{
+		System:    &compressedSystem{Hostname: &hostname},
+		Interface: map[string]*compressedInterface{name: {Name: &name, Mtu: &mtu}},
+	}
+
+	leaf := func(name string) *yang.Entry {
+		return &yang.Entry{Name: name, Kind: yang.LeafEntry}
+	}
+	container := func(name string, children ...*yang.Entry) *yang.Entry {
+		entry := &yang.Entry{Name: name, Kind: yang.DirectoryEntry, Dir: make(map[string]*yang.Entry)}
+		for _, child := range children {
+			child.Parent = entry
+			entry.Dir[child.Name] = child
+		}
+		return entry
+	}
+	list := container("interface", leaf("name"), container("config", leaf("name"), leaf("mtu")),
+		container("state", leaf("name"), leaf("mtu")))
+	list.ListAttr = &yang.ListAttr{}
+	list.Key = "name"
+	entry := container("device",
+		container("system", container("config", leaf("hostname")), container("state", leaf("hostname"))),
+		container("interfaces", list))
+
+	// The config and state containers and the list container have no struct of their own
+	nn := NewYangNodeNavigator(entry, &td, false)
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "interfaces", nn.LocalName())
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "interface", nn.LocalName())
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "config", nn.LocalName())
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "mtu", nn.LocalName())
+	assert.Equal(t, "1500", nn.Value())
+	assert.True(t, nn.MoveToNext())
+	assert.Equal(t, "name", nn.LocalName())
+	assert.Equal(t, "eth0", nn.Value())
+	assert.True(t, nn.MoveToParent())
+	assert.True(t, nn.MoveToNext())
+	assert.Equal(t, "name", nn.LocalName())
+	assert.Equal(t, "eth0", nn.Value())
+	// The state container is only held in shadow paths
+	assert.False(t, nn.MoveToNext())
+
+	nn.MoveToRoot()
+	assert.True(t, nn.MoveToChild())
+	assert.True(t, nn.MoveToNext())
+	assert.Equal(t, "system", nn.LocalName())
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "config", nn.LocalName())
+	assert.False(t, nn.MoveToNext())
+	assert.True(t, nn.MoveToChild())
+	assert.Equal(t, "hostname", nn.LocalName())
+	assert.Equal(t, "router1", nn.Value())
+}
@@ -63,14 +63,6 @@ image: mod-update # @HELP Build the docker image (available parameters: DOCKER_R
 	docker build --platform ${PLATFORM} $(DOCKER_BUILD_ARGS) -t ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${VERSION} .
 	docker tag ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${VERSION} ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${LATEST_VERSION}
 
-.PHONY: build-openapi
-build-openapi: mod-update # @HELP Generate OpenApi specs
-	go build -o _bin/openapi-gen openapi/openapi-gen.go
-
-.PHONY: openapi
-openapi: build-openapi # @HELP Generate OpenApi specs
-	_bin/openapi-gen -o openapi.yaml
-
 {{- /* the gNMI client generator is on hold at the moment, disabling it for now */}}
 {{- /*.PHONY: gnmi-gen*/}}
 {{- /*gnmi-gen: mod-update # @HELP Generate gNMI Client*/}}
@@ -119,5 +111,5 @@ kind: # @HELP build the docker image and loads it into the currently configured
 kind: image kind-only
 
 clean:
-	rm -rf Makefile go.mod go.sum _bin vendor Dockerfile openapi.yaml {{ .Name }}.tree \
-		openapi/openapi-gen.go plugin/main.go api/model.go api/generated.go
\ No newline at end of file
+	rm -rf Makefile go.mod go.sum _bin vendor Dockerfile openapi.yaml {{ .Name }}.tree {{ .Name }}.schema.json \
+		plugin/main.go api/model.go api/validate.go api/generated.go api/generated_test.go api/manifest.json{{ if .GenProto }} proto{{ end }}{{ if .GenDocs }} docs{{ end }}
\ No newline at end of file
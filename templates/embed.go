@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import "embed"
+
+// Templates are the default model plugin templates used by the model compiler
+//
+//go:embed *.tpl
+var Templates embed.FS
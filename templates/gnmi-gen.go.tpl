@@ -36,7 +36,7 @@ func main() {
 		os.Exit(-1)
 	}
 
-	topEntry := schemaMap.SchemaTree["Device"]
+	topEntry := schemaMap.RootSchema()
 	res, err := gnmi_client_gen.BuildGnmiStruct(debug, "{{ capitalize (sanitize .Name) }}", topEntry, []string{})
 	if err != nil {
 		log.Errorw("failed to generate gNMI Endpoint list", "err", err)
@@ -8,6 +8,7 @@
 package api
 
 import (
+	_ "embed" // embed
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
@@ -17,12 +18,47 @@ var modelData = []*gnmi.ModelData{
 	{{- end }}
 }
 
-var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
+var encodings = []gnmi.Encoding{gnmi.Encoding_JSON_IETF{{ if .GenProto }}, gnmi.Encoding_PROTO{{ end }}}
+
+// features are the enabled YANG features, as module:feature
+var features = []string{
+	{{- range .Features }}
+	{{ . | quote }},
+	{{- end }}
+	{{- if .Features }}
+{{ end -}}
+}
+
+// deviations are the names of the deviation modules applied to the model
+var deviations = []string{
+	{{- range .Deviations }}
+	{{ . | quote }},
+	{{- end }}
+	{{- if .Deviations }}
+{{ end -}}
+}
+
+// manifest records the compiler, the inputs and the artifacts the model plugin was generated from
+//
+//go:embed manifest.json
+var manifest []byte
 
 func ModelData() []*gnmi.ModelData {
 	return modelData
 }
 
+func Features() []string {
+	return features
+}
+
+func Deviations() []string {
+	return deviations
+}
+
 func Encodings() []gnmi.Encoding {
 	return encodings
 }
+
+func Manifest() []byte {
+	return manifest
+}
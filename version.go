@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configmodels
+
+import (
+	_ "embed" // embed
+	"strings"
+)
+
+//go:embed VERSION
+var version string
+
+// Version returns the version of the config-models module the model compiler is built from
+func Version() string {
+	return strings.TrimSpace(version)
+}